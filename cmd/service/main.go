@@ -15,27 +15,75 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/loloneme/potential-waffle/internal"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/webhook"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/worker"
 	mw "github.com/loloneme/potential-waffle/internal/middleware"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_create_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_history_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_list_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_merge_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_overdue_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_reassign_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_rebalance_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_review_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_set_status_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/service/adapter"
 	"github.com/loloneme/potential-waffle/internal/rpc/statistics/statistics_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/team/team_add_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_archive_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_code_owners_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_code_owners_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/team/team_get_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_history_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_move_member_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_rename_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_settings_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_bulk_deactivate_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_get_review_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_history_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_is_active_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_max_open_reviews_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_skills_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_skills_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_add_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_delete_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_list_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_delete_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_list_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_subscribe_post"
+	"github.com/loloneme/potential-waffle/internal/usecase/archive_team"
 	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
 	"github.com/loloneme/potential-waffle/internal/usecase/create_pr"
 	"github.com/loloneme/potential-waffle/internal/usecase/create_team"
+	"github.com/loloneme/potential-waffle/internal/usecase/create_unavailability"
+	"github.com/loloneme/potential-waffle/internal/usecase/create_webhook"
+	"github.com/loloneme/potential-waffle/internal/usecase/dispatch_webhooks"
+	"github.com/loloneme/potential-waffle/internal/usecase/escalate_overdue"
+	"github.com/loloneme/potential-waffle/internal/usecase/get_statistics"
+	"github.com/loloneme/potential-waffle/internal/usecase/list_prs"
 	"github.com/loloneme/potential-waffle/internal/usecase/merge_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/move_team_member"
 	"github.com/loloneme/potential-waffle/internal/usecase/reassign_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/reassign_unavailable"
+	"github.com/loloneme/potential-waffle/internal/usecase/rebalance_prs"
+	"github.com/loloneme/potential-waffle/internal/usecase/rename_team"
+	"github.com/loloneme/potential-waffle/internal/usecase/review_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_code_owners"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_is_active"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_max_open_reviews"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_pr_status"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_user_skills"
+	"github.com/loloneme/potential-waffle/internal/usecase/update_team_settings"
 )
 
+const metricsPath = "/metrics"
+
 func main() {
 	ctx := context.Background()
 
@@ -53,46 +101,168 @@ func main() {
 		}
 	}()
 
+	if err := metrics.RegisterDB(db.DB, "postgres"); err != nil {
+		logger.Error(fmt.Sprintf("error registering database metrics: %v", err))
+		panic(err)
+	}
+
 	userRepo := user.NewRepository(db)
 	teamRepo := team.NewRepository(db)
 	prRepo := pull_request.NewRepository(db)
+	webhookRepo := webhook.NewRepository(db)
+
+	reviewerSelectionService := reviewer_selection.New(teamRepo, prRepo)
 
 	createTeamService := create_team.New(teamRepo, userRepo)
-	createPullRequestService := create_pr.New(userRepo, prRepo)
-	mergePullRequestService := merge_pr.New(prRepo)
-	reassignPullRequestService := reassign_pr.New(userRepo, prRepo)
-	bulkDeactivateTeamService := bulk_deactivate_team.New(userRepo, prRepo)
+	updateTeamSettingsService := update_team_settings.New(teamRepo, userRepo)
+	renameTeamService := rename_team.New(teamRepo, userRepo)
+	archiveTeamService := archive_team.New(teamRepo, userRepo)
+	moveTeamMemberService := move_team_member.New(userRepo, teamRepo, prRepo, reviewerSelectionService)
+	setCodeOwnersService := set_code_owners.New(teamRepo, userRepo)
+	createPullRequestService := create_pr.New(userRepo, prRepo, reviewerSelectionService)
+	mergePullRequestService := merge_pr.New(prRepo, userRepo, teamRepo)
+	reassignPullRequestService := reassign_pr.New(userRepo, prRepo, reviewerSelectionService)
+	bulkDeactivateTeamService := bulk_deactivate_team.New(userRepo, prRepo, reviewerSelectionService)
+	setIsActiveService := set_is_active.New(userRepo, bulkDeactivateTeamService)
+	setUserSkillsService := set_user_skills.New(userRepo)
+	setMaxOpenReviewsService := set_max_open_reviews.New(userRepo)
+	rebalanceService := rebalance_prs.New(prRepo, reviewerSelectionService)
+	reviewPullRequestService := review_pr.New(prRepo)
+	setPullRequestStatusService := set_pr_status.New(prRepo, userRepo, reviewerSelectionService)
+	createWebhookService := create_webhook.New(webhookRepo, teamRepo)
+	listPullRequestsService := list_prs.New(prRepo)
+	getStatisticsService := get_statistics.New(prRepo, teamRepo)
+	createUnavailabilityService := create_unavailability.New(userRepo)
+	reassignUnavailableService := reassign_unavailable.New(userRepo, prRepo, reviewerSelectionService)
+
+	escalationConfig, err := escalate_overdue.LoadConfig()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error loading overdue escalation config: %v", err))
+		panic(err)
+	}
+	escalateOverdueService := escalate_overdue.New(prRepo, reviewerSelectionService, reassignPullRequestService, escalationConfig.Action)
 
 	createTeamHandler := team_add_post.New(createTeamService)
 	getTeamHandler := team_get_get.New(userRepo, teamRepo)
+	updateTeamSettingsHandler := team_settings_post.New(updateTeamSettingsService)
+	renameTeamHandler := team_rename_post.New(renameTeamService)
+	archiveTeamHandler := team_archive_post.New(archiveTeamService)
+	moveTeamMemberHandler := team_move_member_post.New(moveTeamMemberService)
+	teamHistoryHandler := team_history_get.New(teamRepo)
+	getCodeOwnersHandler := team_code_owners_get.New(teamRepo)
+	setCodeOwnersHandler := team_code_owners_post.New(setCodeOwnersService)
 	createPullRequestHandler := pr_create_post.New(createPullRequestService)
 	mergePullRequestHandler := pr_merge_post.New(mergePullRequestService)
 	reassignPullRequestHandler := pr_reassign_post.New(reassignPullRequestService)
+	rebalanceHandler := pr_rebalance_post.New(rebalanceService)
+	reviewPullRequestHandler := pr_review_post.New(reviewPullRequestService)
+	setPullRequestStatusHandler := pr_set_status_post.New(setPullRequestStatusService)
+	prHistoryHandler := pr_history_get.New(prRepo)
+	listPullRequestsHandler := pr_list_get.New(listPullRequestsService)
+	overdueReviewsHandler := pr_overdue_get.New(escalateOverdueService)
 	getUsersReviewHandler := users_get_review_get.New(prRepo)
-	setIsActiveHandler := users_set_is_active_post.New(userRepo)
+	getUsersHistoryHandler := users_history_get.New(prRepo)
+	setIsActiveHandler := users_set_is_active_post.New(setIsActiveService, userRepo)
 	bulkDeactivateHandler := users_bulk_deactivate_post.New(bulkDeactivateTeamService)
-	getStatisticsHandler := statistics_get.New(prRepo)
+	getSkillsHandler := users_skills_get.New(userRepo)
+	setSkillsHandler := users_set_skills_post.New(setUserSkillsService, userRepo)
+	setMaxOpenReviewsHandler := users_set_max_open_reviews_post.New(setMaxOpenReviewsService, userRepo)
+	addUnavailabilityHandler := users_unavailability_add_post.New(createUnavailabilityService, userRepo)
+	listUnavailabilityHandler := users_unavailability_list_get.New(userRepo)
+	deleteUnavailabilityHandler := users_unavailability_delete_post.New(userRepo)
+	getStatisticsHandler := statistics_get.New(getStatisticsService)
+	subscribeWebhookHandler := webhooks_subscribe_post.New(createWebhookService)
+	listWebhooksHandler := webhooks_list_get.New(webhookRepo)
+	deleteWebhookHandler := webhooks_delete_post.New(webhookRepo)
 
 	serviceAdapter := adapter.NewAdapter(
 		createTeamHandler,
 		getTeamHandler,
+		updateTeamSettingsHandler,
+		renameTeamHandler,
+		archiveTeamHandler,
+		moveTeamMemberHandler,
+		teamHistoryHandler,
+		getCodeOwnersHandler,
+		setCodeOwnersHandler,
 		createPullRequestHandler,
 		mergePullRequestHandler,
 		reassignPullRequestHandler,
+		rebalanceHandler,
+		reviewPullRequestHandler,
+		setPullRequestStatusHandler,
+		prHistoryHandler,
+		listPullRequestsHandler,
+		overdueReviewsHandler,
 		getUsersReviewHandler,
+		getUsersHistoryHandler,
 		setIsActiveHandler,
 		bulkDeactivateHandler,
+		getSkillsHandler,
+		setSkillsHandler,
+		setMaxOpenReviewsHandler,
+		addUnavailabilityHandler,
+		listUnavailabilityHandler,
+		deleteUnavailabilityHandler,
 		getStatisticsHandler,
+		subscribeWebhookHandler,
+		listWebhooksHandler,
+		deleteWebhookHandler,
 	)
 
+	rebalanceConfig, err := rebalance_prs.LoadConfig()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error loading rebalance config: %v", err))
+		panic(err)
+	}
+
+	webhookConfig, err := dispatch_webhooks.LoadConfig()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error loading webhook config: %v", err))
+		panic(err)
+	}
+	unavailabilityConfig, err := reassign_unavailable.LoadConfig()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error loading unavailability config: %v", err))
+		panic(err)
+	}
+
+	dispatchWebhooksService := dispatch_webhooks.New(webhookRepo, &http.Client{Timeout: webhookConfig.Timeout}, *webhookConfig)
+
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	go worker.NewPeriodic(rebalanceService.Job(), rebalanceConfig.Interval, logger).Run(workersCtx)
+	go worker.NewPeriodic(dispatchWebhooksService.Job(), webhookConfig.Interval, logger).Run(workersCtx)
+	go worker.NewPeriodic(reassignUnavailableService.Job(), unavailabilityConfig.Interval, logger).Run(workersCtx)
+	go worker.NewPeriodic(escalateOverdueService.Job(), escalationConfig.Interval, logger).Run(workersCtx)
+
+	authConfig, err := auth.LoadConfig()
+	if err != nil {
+		logger.Error(fmt.Sprintf("error loading auth config: %v", err))
+		panic(err)
+	}
+
+	var authenticator *auth.Authenticator
+	if authConfig.Enabled {
+		authenticator, err = auth.NewAuthenticator(*authConfig)
+		if err != nil {
+			logger.Error(fmt.Sprintf("error creating authenticator: %v", err))
+			panic(err)
+		}
+	} else {
+		logger.Warn("authentication is disabled, every request is allowed")
+	}
+
 	e := echo.New()
 	e.Use(middleware.Recover())
 	e.Use(middleware.CORS())
+	e.Use(mw.MetricsMiddleware())
 
-	e.Use(mw.NewOpenAPIMiddleware(getOpenAPIPath()))
+	e.Use(mw.NewOpenAPIMiddleware(getOpenAPIPath(), authenticator, metricsPath))
 	e.Use(mw.SlogMiddleware(logger))
 
 	generated.RegisterHandlers(e, serviceAdapter)
+	e.GET(metricsPath, echo.WrapHandler(metrics.Handler()))
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -113,6 +283,7 @@ func main() {
 	<-quit
 
 	logger.Info("Shutting down server...")
+	stopWorkers()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
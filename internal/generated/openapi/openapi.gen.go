@@ -19,28 +19,163 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	BearerAuthScopes = "bearerAuth.Scopes"
+)
+
+// Defines values for AssignmentEventType.
+const (
+	ASSIGN               AssignmentEventType = "ASSIGN"
+	DEACTIVATIONREASSIGN AssignmentEventType = "DEACTIVATION_REASSIGN"
+	MERGE                AssignmentEventType = "MERGE"
+	REASSIGN             AssignmentEventType = "REASSIGN"
+	UNASSIGN             AssignmentEventType = "UNASSIGN"
+)
+
+// Defines values for AssignmentStrategy.
+const (
+	LeastLoaded AssignmentStrategy = "least_loaded"
+	Random      AssignmentStrategy = "random"
+	RoundRobin  AssignmentStrategy = "round_robin"
+	Weighted    AssignmentStrategy = "weighted"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	FORBIDDEN         ErrorResponseErrorCode = "FORBIDDEN"
+	INVALIDTRANSITION ErrorResponseErrorCode = "INVALID_TRANSITION"
+	NOCANDIDATE       ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTAPPROVED       ErrorResponseErrorCode = "NOT_APPROVED"
+	NOTASSIGNED       ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND          ErrorResponseErrorCode = "NOT_FOUND"
+	PRCLOSED          ErrorResponseErrorCode = "PR_CLOSED"
+	PREXISTS          ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED          ErrorResponseErrorCode = "PR_MERGED"
+	TEAMARCHIVED      ErrorResponseErrorCode = "TEAM_ARCHIVED"
+	TEAMEXISTS        ErrorResponseErrorCode = "TEAM_EXISTS"
+	TEAMNOTEMPTY      ErrorResponseErrorCode = "TEAM_NOT_EMPTY"
+	UNAUTHORIZED      ErrorResponseErrorCode = "UNAUTHORIZED"
 )
 
 // Defines values for PullRequestStatus.
 const (
-	PullRequestStatusMERGED PullRequestStatus = "MERGED"
-	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+	PullRequestStatusCLOSED         PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT          PullRequestStatus = "DRAFT"
+	PullRequestStatusMERGED         PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN           PullRequestStatus = "OPEN"
+	PullRequestStatusREADYFORREVIEW PullRequestStatus = "READY_FOR_REVIEW"
+	PullRequestStatusREOPENED       PullRequestStatus = "REOPENED"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	PullRequestShortStatusCLOSED         PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT          PullRequestShortStatus = "DRAFT"
+	PullRequestShortStatusMERGED         PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN           PullRequestShortStatus = "OPEN"
+	PullRequestShortStatusREADYFORREVIEW PullRequestShortStatus = "READY_FOR_REVIEW"
+	PullRequestShortStatusREOPENED       PullRequestShortStatus = "REOPENED"
+)
+
+// Defines values for ReviewState.
+const (
+	ReviewStateAPPROVED         ReviewState = "APPROVED"
+	ReviewStateCHANGESREQUESTED ReviewState = "CHANGES_REQUESTED"
+	ReviewStateCOMMENTED        ReviewState = "COMMENTED"
+	ReviewStatePENDING          ReviewState = "PENDING"
+)
+
+// Defines values for TeamEventEventType.
+const (
+	ARCHIVED     TeamEventEventType = "ARCHIVED"
+	MEMBERJOINED TeamEventEventType = "MEMBER_JOINED"
+	MEMBERLEFT   TeamEventEventType = "MEMBER_LEFT"
+	RENAMED      TeamEventEventType = "RENAMED"
+)
+
+// Defines values for UnresolvedSlotReason.
+const (
+	ALLCANDIDATESATCAPACITY UnresolvedSlotReason = "ALL_CANDIDATES_AT_CAPACITY"
+	ALLCANDIDATESONPR       UnresolvedSlotReason = "ALL_CANDIDATES_ON_PR"
+	NOACTIVECANDIDATES      UnresolvedSlotReason = "NO_ACTIVE_CANDIDATES"
+)
+
+// Defines values for PostPullRequestCreateJSONBodySkillMode.
+const (
+	Prefer  PostPullRequestCreateJSONBodySkillMode = "prefer"
+	Require PostPullRequestCreateJSONBodySkillMode = "require"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusCLOSED         GetPullRequestListParamsStatus = "CLOSED"
+	GetPullRequestListParamsStatusDRAFT          GetPullRequestListParamsStatus = "DRAFT"
+	GetPullRequestListParamsStatusMERGED         GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN           GetPullRequestListParamsStatus = "OPEN"
+	GetPullRequestListParamsStatusREADYFORREVIEW GetPullRequestListParamsStatus = "READY_FOR_REVIEW"
+	GetPullRequestListParamsStatusREOPENED       GetPullRequestListParamsStatus = "REOPENED"
+)
+
+// Defines values for PostPullRequestReviewJSONBodyState.
+const (
+	PostPullRequestReviewJSONBodyStateAPPROVED         PostPullRequestReviewJSONBodyState = "APPROVED"
+	PostPullRequestReviewJSONBodyStateCHANGESREQUESTED PostPullRequestReviewJSONBodyState = "CHANGES_REQUESTED"
+	PostPullRequestReviewJSONBodyStateCOMMENTED        PostPullRequestReviewJSONBodyState = "COMMENTED"
+)
+
+// Defines values for PostPullRequestSetStatusJSONBodyStatus.
+const (
+	PostPullRequestSetStatusJSONBodyStatusCLOSED         PostPullRequestSetStatusJSONBodyStatus = "CLOSED"
+	PostPullRequestSetStatusJSONBodyStatusDRAFT          PostPullRequestSetStatusJSONBodyStatus = "DRAFT"
+	PostPullRequestSetStatusJSONBodyStatusREADYFORREVIEW PostPullRequestSetStatusJSONBodyStatus = "READY_FOR_REVIEW"
+	PostPullRequestSetStatusJSONBodyStatusREOPENED       PostPullRequestSetStatusJSONBodyStatus = "REOPENED"
 )
 
+// AssignmentEvent defines model for AssignmentEvent.
+type AssignmentEvent struct {
+	// Actor Субъект токена инициатора изменения (отсутствует при выключенной аутентификации) или фоновая задача
+	// сервиса: system:rebalance, system:escalation, system:unavailability
+	Actor         *string             `json:"actor,omitempty"`
+	CreatedAt     time.Time           `json:"created_at"`
+	EventType     AssignmentEventType `json:"event_type"`
+	NewReviewerId *string             `json:"new_reviewer_id,omitempty"`
+	OldReviewerId *string             `json:"old_reviewer_id,omitempty"`
+	PullRequestId string              `json:"pull_request_id"`
+	Reason        string              `json:"reason"`
+}
+
+// AssignmentEventType defines model for AssignmentEventType.
+type AssignmentEventType string
+
+// AssignmentStrategy Стратегия выбора ревьюверов команды
+type AssignmentStrategy string
+
+// CodeOwnerRule defines model for CodeOwnerRule.
+type CodeOwnerRule struct {
+	// Line Номер строки документа
+	Line    int      `json:"line"`
+	Pattern string   `json:"pattern"`
+	Teams   []string `json:"teams"`
+	Users   []string `json:"users"`
+}
+
+// CodeOwners defines model for CodeOwners.
+type CodeOwners struct {
+	// Document Документ в формате CODEOWNERS
+	Document  string          `json:"document"`
+	Rules     []CodeOwnerRule `json:"rules"`
+	TeamName  string          `json:"team_name"`
+	UpdatedAt *time.Time      `json:"updated_at,omitempty"`
+}
+
+// DurationPercentiles Перцентили длительности в секундах; отсутствуют, если count = 0
+type DurationPercentiles struct {
+	Count      int      `json:"count"`
+	P50Seconds *float64 `json:"p50_seconds,omitempty"`
+	P90Seconds *float64 `json:"p90_seconds,omitempty"`
+	P95Seconds *float64 `json:"p95_seconds,omitempty"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -52,16 +187,38 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// OverdueReview defines model for OverdueReview.
+type OverdueReview struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	AuthorId   string    `json:"author_id"`
+	DueAt      time.Time `json:"due_at"`
+
+	// EscalatedAt Когда фоновая задача эскалировала просрочку
+	EscalatedAt     *time.Time `json:"escalated_at"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+	ReviewerId      string     `json:"reviewer_id"`
+	SlaHours        int        `json:"sla_hours"`
+
+	// TeamName Команда автора, чей SLA нарушен
+	TeamName string `json:"team_name"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..max_reviewers команды)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+
+	// MergedBy user_id пользователя или субъект токена (например, bot:ci-bot), выполнившего слияние
+	MergedBy            *string               `json:"mergedBy"`
+	PullRequestId       string                `json:"pull_request_id"`
+	PullRequestName     string                `json:"pull_request_name"`
+	ReviewerAssignments *[]ReviewerAssignment `json:"reviewer_assignments,omitempty"`
+	Status              PullRequestStatus     `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -83,14 +240,77 @@ type Reassignment struct {
 	NewUserId     string `json:"new_user_id"`
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
+
+	// SourceTeam Команда, из которой назначен новый ревьювер
+	SourceTeam *string `json:"source_team,omitempty"`
+}
+
+// RebalancedPullRequest defines model for RebalancedPullRequest.
+type RebalancedPullRequest struct {
+	AddedReviewers []ReviewerAssignment `json:"added_reviewers"`
+	PullRequestId  string               `json:"pull_request_id"`
+}
+
+// ReviewEvent defines model for ReviewEvent.
+type ReviewEvent struct {
+	Comment    *string     `json:"comment,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+	ReviewerId string      `json:"reviewer_id"`
+	State      ReviewState `json:"state"`
+}
+
+// ReviewState defines model for ReviewState.
+type ReviewState string
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	// AssignedAt Когда ревьювер был назначен
+	AssignedAt *time.Time `json:"assigned_at,omitempty"`
+
+	// EscalatedAt Когда назначение было помечено как просроченное
+	EscalatedAt *time.Time `json:"escalated_at"`
+
+	// MatchedSkills Навыки из required_skills, которыми обладает ревьювер (только в ответе на создание PR)
+	MatchedSkills *[]string    `json:"matched_skills,omitempty"`
+	ReviewState   *ReviewState `json:"review_state,omitempty"`
+	ReviewedAt    *time.Time   `json:"reviewed_at"`
+
+	// SourceTeam Команда, из которой назначен ревьювер
+	SourceTeam string `json:"source_team"`
+	UserId     string `json:"user_id"`
 }
 
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	// ArchivedAt Когда команда была архивирована; отсутствует у действующих команд
+	ArchivedAt *time.Time    `json:"archived_at,omitempty"`
+	Members    []TeamMember  `json:"members"`
+	Settings   *TeamSettings `json:"settings,omitempty"`
+	TeamName   string        `json:"team_name"`
+}
+
+// TeamEvent defines model for TeamEvent.
+type TeamEvent struct {
+	// Actor Инициатор изменения, отсутствует при выключенной аутентификации
+	Actor     *string            `json:"actor,omitempty"`
+	CreatedAt time.Time          `json:"created_at"`
+	EventId   int64              `json:"event_id"`
+	EventType TeamEventEventType `json:"event_type"`
+
+	// NewValue Команда, в которую ушёл пользователь (MEMBER_LEFT)
+	NewValue *string `json:"new_value,omitempty"`
+
+	// OldValue Прежнее имя команды (RENAMED) или команда, из которой пришёл пользователь (MEMBER_JOINED)
+	OldValue *string `json:"old_value,omitempty"`
+	TeamName string  `json:"team_name"`
+
+	// UserId Перемещённый пользователь (MEMBER_JOINED, MEMBER_LEFT)
+	UserId *string `json:"user_id,omitempty"`
 }
 
+// TeamEventEventType defines model for TeamEvent.EventType.
+type TeamEventEventType string
+
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
@@ -98,30 +318,181 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// AssignmentStrategy Стратегия выбора ревьюверов команды
+	AssignmentStrategy *AssignmentStrategy `json:"assignment_strategy,omitempty"`
+
+	// FallbackTeams Команды, из которых по порядку добираются ревьюверы, если в своей команде не хватает кандидатов
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MaxOpenReviews Сколько открытых ревью может быть у участника по умолчанию (0 - без ограничения)
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// MaxReviewers Максимальное число ревьюверов, назначаемых на PR
+	MaxReviewers *int `json:"max_reviewers,omitempty"`
+
+	// RequiredApprovals Сколько одобрений нужно для merge (0 - проверка выключена)
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// RequiredReviewers Минимальное число ревьюверов, без которого PR не будет создан
+	RequiredReviewers *int `json:"required_reviewers,omitempty"`
+
+	// ReviewSlaHours За сколько часов ревьювер должен отреагировать на назначение (0 - SLA выключен)
+	ReviewSlaHours *int `json:"review_sla_hours,omitempty"`
+}
+
+// Unavailability defines model for Unavailability.
+type Unavailability struct {
+	// EndsAt Не включая границу
+	EndsAt time.Time `json:"ends_at"`
+	Reason string    `json:"reason"`
+
+	// ReassignReviews Переназначить открытые ревью пользователя, когда период начнётся
+	ReassignReviews bool `json:"reassign_reviews"`
+
+	// ReassignedAt Когда ревью были переназначены; отсутствует, пока этого не произошло
+	ReassignedAt     *time.Time `json:"reassigned_at,omitempty"`
+	StartsAt         time.Time  `json:"starts_at"`
+	UnavailabilityId int64      `json:"unavailability_id"`
+	UserId           string     `json:"user_id"`
+}
+
+// UnresolvedSlot defines model for UnresolvedSlot.
+type UnresolvedSlot struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// Reason NO_ACTIVE_CANDIDATES - в пуле кандидатов нет ни одного активного пользователя, ALL_CANDIDATES_ON_PR - все кандидаты уже автор или ревьюверы этого PR, ALL_CANDIDATES_AT_CAPACITY - остальные кандидаты достигли лимита открытых ревью
+	Reason UnresolvedSlotReason `json:"reason"`
+
+	// Removed Ревьювер снят с PR без замены (remove_unresolved), иначе остаётся назначенным
+	Removed bool `json:"removed"`
+
+	// UserId Деактивируемый ревьювер, для которого не нашлось замены
+	UserId string `json:"user_id"`
+}
+
+// UnresolvedSlotReason NO_ACTIVE_CANDIDATES - в пуле кандидатов нет ни одного активного пользователя, ALL_CANDIDATES_ON_PR - все кандидаты уже автор или ревьюверы этого PR, ALL_CANDIDATES_AT_CAPACITY - остальные кандидаты достигли лимита открытых ревью
+type UnresolvedSlotReason string
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Личный лимит открытых ревью (0 - без ограничения), отсутствует - действует лимит команды
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	TeamName       string `json:"team_name"`
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
+}
+
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	// Skills Теги навыков в нижнем регистре, по алфавиту
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
 }
 
+// WebhookSubscription defines model for WebhookSubscription.
+type WebhookSubscription struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventTypes Типы событий; пустой список означает все типы
+	EventTypes     []AssignmentEventType `json:"event_types"`
+	SubscriptionId int64                 `json:"subscription_id"`
+
+	// TeamName Команда автора PR; отсутствует, если подписка на все команды
+	TeamName *string `json:"team_name,omitempty"`
+	Url      string  `json:"url"`
+}
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedPaths Изменённые файлы; по ним из правил CODEOWNERS команды автора назначаются владельцы кода
+	ChangedPaths *[]string `json:"changed_paths,omitempty"`
+
+	// Draft Создать PR в статусе DRAFT, ревьюверы назначаются при переводе в READY_FOR_REVIEW
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
 	PullRequestName string `json:"pull_request_name"`
+
+	// RequestedReviewers Ревьюверы, выбранные автором; назначаются в первую очередь (и для черновика), автоподбор добирает оставшиеся места
+	RequestedReviewers *[]string `json:"requested_reviewers,omitempty"`
+
+	// RequiredSkills Навыки, которые должны покрыть ревьюверы (например go, sql, frontend)
+	RequiredSkills *[]string `json:"required_skills,omitempty"`
+
+	// SkillMode prefer — по возможности назначить владеющих навыками, require — отказать, если навык покрыть некем
+	SkillMode *PostPullRequestCreateJSONBodySkillMode `json:"skill_mode,omitempty"`
+}
+
+// PostPullRequestCreateJSONBodySkillMode defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBodySkillMode string
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
 }
 
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status     *[]GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId   *string                           `form:"author_id,omitempty" json:"author_id,omitempty"`
+	ReviewerId *string                           `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// Name Подстрока названия PR (без учёта регистра)
+	Name        *string    `form:"name,omitempty" json:"name,omitempty"`
+	CreatedFrom *time.Time `form:"created_from,omitempty" json:"created_from,omitempty"`
+
+	// CreatedTo Не включая границу
+	CreatedTo  *time.Time `form:"created_to,omitempty" json:"created_to,omitempty"`
+	MergedFrom *time.Time `form:"merged_from,omitempty" json:"merged_from,omitempty"`
+
+	// MergedTo Не включая границу
+	MergedTo *time.Time `form:"merged_to,omitempty" json:"merged_to,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+	// MergedBy user_id пользователя, выполнившего слияние. При включённой аутентификации по умолчанию берётся
+	// субъект токена (он может быть не пользователем, например bot:ci-bot); указать другого
+	// пользователя может только admin
+	MergedBy      *string `json:"merged_by,omitempty"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// GetPullRequestOverdueParams defines parameters for GetPullRequestOverdue.
+type GetPullRequestOverdueParams struct {
+	// TeamName Команда автора PR
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
 }
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
@@ -130,15 +501,102 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestRebalanceJSONBody defines parameters for PostPullRequestRebalance.
+type PostPullRequestRebalanceJSONBody struct {
+	// TeamName Ограничить пересчёт PR авторов из этой команды
+	TeamName *string `json:"team_name,omitempty"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	Comment       *string                            `json:"comment,omitempty"`
+	PullRequestId string                             `json:"pull_request_id"`
+	ReviewerId    string                             `json:"reviewer_id"`
+	State         PostPullRequestReviewJSONBodyState `json:"state"`
+}
+
+// PostPullRequestReviewJSONBodyState defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBodyState string
+
+// PostPullRequestSetStatusJSONBody defines parameters for PostPullRequestSetStatus.
+type PostPullRequestSetStatusJSONBody struct {
+	PullRequestId string                                 `json:"pull_request_id"`
+	Status        PostPullRequestSetStatusJSONBodyStatus `json:"status"`
+}
+
+// PostPullRequestSetStatusJSONBodyStatus defines parameters for PostPullRequestSetStatus.
+type PostPullRequestSetStatusJSONBodyStatus string
+
+// GetStatisticsParams defines parameters for GetStatistics.
+type GetStatisticsParams struct {
+	// From Начало окна (по умолчанию to минус 30 дней)
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна, не включая границу (по умолчанию текущее время)
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	TeamName *string    `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
+// PostTeamArchiveJSONBody defines parameters for PostTeamArchive.
+type PostTeamArchiveJSONBody struct {
+	TeamName string `json:"team_name"`
+}
+
+// GetTeamCodeOwnersParams defines parameters for GetTeamCodeOwners.
+type GetTeamCodeOwnersParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamCodeOwnersJSONBody defines parameters for PostTeamCodeOwners.
+type PostTeamCodeOwnersJSONBody struct {
+	// Document Строки вида `<шаблон пути> @user_id @org/team_name`, пустой документ удаляет правила
+	Document string `json:"document"`
+	TeamName string `json:"team_name"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamHistoryParams defines parameters for GetTeamHistory.
+type GetTeamHistoryParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamMoveMemberJSONBody defines parameters for PostTeamMoveMember.
+type PostTeamMoveMemberJSONBody struct {
+	// TeamName Команда, в которую переводится пользователь
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
+// PostTeamRenameJSONBody defines parameters for PostTeamRename.
+type PostTeamRenameJSONBody struct {
+	NewTeamName string `json:"new_team_name"`
+	TeamName    string `json:"team_name"`
+}
+
+// PostTeamSettingsJSONBody defines parameters for PostTeamSettings.
+type PostTeamSettingsJSONBody struct {
+	Settings TeamSettings `json:"settings"`
+	TeamName string       `json:"team_name"`
+}
+
 // PostUsersBulkDeactivateJSONBody defines parameters for PostUsersBulkDeactivate.
 type PostUsersBulkDeactivateJSONBody struct {
-	TeamName string `json:"team_name"`
+	// DryRun Только рассчитать план деактивации и переназначений, ничего не меняя
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// FallbackToOtherTeams Искать замену во всех остальных командах, если в команде и её резервных командах никого нет
+	FallbackToOtherTeams *bool `json:"fallback_to_other_teams,omitempty"`
+
+	// RemoveUnresolved Снимать с PR деактивируемых ревьюверов, для которых не нашлось замены
+	RemoveUnresolved *bool  `json:"remove_unresolved,omitempty"`
+	TeamName         string `json:"team_name"`
 
 	// UserIds Массив ID пользователей для деактивации
 	UserIds []string `json:"user_ids"`
@@ -150,12 +608,73 @@ type GetUsersGetReviewParams struct {
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// GetUsersHistoryParams defines parameters for GetUsersHistory.
+type GetUsersHistoryParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
+	// DryRun Только рассчитать результат и переназначения, ничего не меняя
+	DryRun   *bool  `json:"dry_run,omitempty"`
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetMaxOpenReviewsJSONBody defines parameters for PostUsersSetMaxOpenReviews.
+type PostUsersSetMaxOpenReviewsJSONBody struct {
+	// MaxOpenReviews 0 - без ограничения, null или отсутствие - действует лимит команды
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetSkillsJSONBody defines parameters for PostUsersSetSkills.
+type PostUsersSetSkillsJSONBody struct {
+	// Skills Теги из букв, цифр и символов +#._- (до 32 символов), пустой список удаляет навыки
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// GetUsersSkillsParams defines parameters for GetUsersSkills.
+type GetUsersSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersUnavailabilityAddJSONBody defines parameters for PostUsersUnavailabilityAdd.
+type PostUsersUnavailabilityAddJSONBody struct {
+	EndsAt          time.Time `json:"ends_at"`
+	Reason          *string   `json:"reason,omitempty"`
+	ReassignReviews *bool     `json:"reassign_reviews,omitempty"`
+	StartsAt        time.Time `json:"starts_at"`
+	UserId          string    `json:"user_id"`
+}
+
+// PostUsersUnavailabilityDeleteJSONBody defines parameters for PostUsersUnavailabilityDelete.
+type PostUsersUnavailabilityDeleteJSONBody struct {
+	UnavailabilityId int64 `json:"unavailability_id"`
+}
+
+// GetUsersUnavailabilityListParams defines parameters for GetUsersUnavailabilityList.
+type GetUsersUnavailabilityListParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostWebhooksDeleteJSONBody defines parameters for PostWebhooksDelete.
+type PostWebhooksDeleteJSONBody struct {
+	SubscriptionId int64 `json:"subscription_id"`
+}
+
+// PostWebhooksSubscribeJSONBody defines parameters for PostWebhooksSubscribe.
+type PostWebhooksSubscribeJSONBody struct {
+	EventTypes *[]AssignmentEventType `json:"event_types,omitempty"`
+	Secret     string                 `json:"secret"`
+	TeamName   *string                `json:"team_name,omitempty"`
+	Url        string                 `json:"url"`
+}
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -165,44 +684,155 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRebalanceJSONRequestBody defines body for PostPullRequestRebalance for application/json ContentType.
+type PostPullRequestRebalanceJSONRequestBody PostPullRequestRebalanceJSONBody
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
+// PostPullRequestSetStatusJSONRequestBody defines body for PostPullRequestSetStatus for application/json ContentType.
+type PostPullRequestSetStatusJSONRequestBody PostPullRequestSetStatusJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamArchiveJSONRequestBody defines body for PostTeamArchive for application/json ContentType.
+type PostTeamArchiveJSONRequestBody PostTeamArchiveJSONBody
+
+// PostTeamCodeOwnersJSONRequestBody defines body for PostTeamCodeOwners for application/json ContentType.
+type PostTeamCodeOwnersJSONRequestBody PostTeamCodeOwnersJSONBody
+
+// PostTeamMoveMemberJSONRequestBody defines body for PostTeamMoveMember for application/json ContentType.
+type PostTeamMoveMemberJSONRequestBody PostTeamMoveMemberJSONBody
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody PostTeamRenameJSONBody
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody PostTeamSettingsJSONBody
+
 // PostUsersBulkDeactivateJSONRequestBody defines body for PostUsersBulkDeactivate for application/json ContentType.
 type PostUsersBulkDeactivateJSONRequestBody PostUsersBulkDeactivateJSONBody
 
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetMaxOpenReviewsJSONRequestBody defines body for PostUsersSetMaxOpenReviews for application/json ContentType.
+type PostUsersSetMaxOpenReviewsJSONRequestBody PostUsersSetMaxOpenReviewsJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody PostUsersSetSkillsJSONBody
+
+// PostUsersUnavailabilityAddJSONRequestBody defines body for PostUsersUnavailabilityAdd for application/json ContentType.
+type PostUsersUnavailabilityAddJSONRequestBody PostUsersUnavailabilityAddJSONBody
+
+// PostUsersUnavailabilityDeleteJSONRequestBody defines body for PostUsersUnavailabilityDelete for application/json ContentType.
+type PostUsersUnavailabilityDeleteJSONRequestBody PostUsersUnavailabilityDeleteJSONBody
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody PostWebhooksDeleteJSONBody
+
+// PostWebhooksSubscribeJSONRequestBody defines body for PostWebhooksSubscribe for application/json ContentType.
+type PostWebhooksSubscribeJSONRequestBody PostWebhooksSubscribeJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Создать PR и назначить до max_reviewers ревьюверов из команды автора - запрошенных автором и подобранных автоматически (черновику назначаются только запрошенные)
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// Получить историю назначений ревьюверов PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error
+	// Получить список PR с фильтрами и курсорной пагинацией (сначала новые)
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
+	// Получить назначения ревьюверов, просроченные по SLA команды автора (сначала самые старые)
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(ctx echo.Context, params GetPullRequestOverdueParams) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
-	// Получить статистику назначений ревьюверов
+	// Доназначить ревьюверов на открытые PR, у которых их меньше max_reviewers команды автора
+	// (POST /pullRequest/rebalance)
+	PostPullRequestRebalance(ctx echo.Context) error
+	// Оставить вердикт ревьювера по PR
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx echo.Context) error
+	// Перевести PR в другой статус жизненного цикла (MERGED - через /pullRequest/merge)
+	// (POST /pullRequest/setStatus)
+	PostPullRequestSetStatus(ctx echo.Context) error
+	// Получить статистику ревью за период
 	// (GET /statistics)
-	GetStatistics(ctx echo.Context) error
+	GetStatistics(ctx echo.Context, params GetStatisticsParams) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
+	// Архивировать команду вместо удаления (история PR и назначений сохраняется)
+	// (POST /team/archive)
+	PostTeamArchive(ctx echo.Context) error
+	// Получить правила владения кодом команды
+	// (GET /team/codeOwners)
+	GetTeamCodeOwners(ctx echo.Context, params GetTeamCodeOwnersParams) error
+	// Загрузить правила владения кодом команды в формате CODEOWNERS (заменяет предыдущие)
+	// (POST /team/codeOwners)
+	PostTeamCodeOwners(ctx echo.Context) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Получить журнал изменений команды
+	// (GET /team/history)
+	GetTeamHistory(ctx echo.Context, params GetTeamHistoryParams) error
+	// Перевести пользователя в другую команду с переназначением его ревью в прежней команде
+	// (POST /team/moveMember)
+	PostTeamMoveMember(ctx echo.Context) error
+	// Переименовать команду (участники, резервные команды, подписки и история переходят на новое имя)
+	// (POST /team/rename)
+	PostTeamRename(ctx echo.Context) error
+	// Обновить настройки команды (переданные поля перезаписываются)
+	// (POST /team/settings)
+	PostTeamSettings(ctx echo.Context) error
 	// Массовая деактивация пользователей команды с безопасным переназначением открытых PR
 	// (POST /users/bulkDeactivate)
 	PostUsersBulkDeactivate(ctx echo.Context) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
-	// Установить флаг активности пользователя
+	// Получить историю назначений пользователя (как ревьювера или инициатора)
+	// (GET /users/history)
+	GetUsersHistory(ctx echo.Context, params GetUsersHistoryParams) error
+	// Установить флаг активности пользователя (при деактивации открытые ревью переназначаются)
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// Задать личный лимит открытых ревью пользователя
+	// (POST /users/setMaxOpenReviews)
+	PostUsersSetMaxOpenReviews(ctx echo.Context) error
+	// Задать навыки пользователя (заменяет предыдущие)
+	// (POST /users/setSkills)
+	PostUsersSetSkills(ctx echo.Context) error
+	// Навыки пользователя
+	// (GET /users/skills)
+	GetUsersSkills(ctx echo.Context, params GetUsersSkillsParams) error
+	// Запланировать период недоступности пользователя (отпуск, отсутствие)
+	// (POST /users/unavailability/add)
+	PostUsersUnavailabilityAdd(ctx echo.Context) error
+	// Удалить период недоступности
+	// (POST /users/unavailability/delete)
+	PostUsersUnavailabilityDelete(ctx echo.Context) error
+	// Текущие и будущие периоды недоступности пользователя
+	// (GET /users/unavailability/list)
+	GetUsersUnavailabilityList(ctx echo.Context, params GetUsersUnavailabilityListParams) error
+	// Удалить подписку вместе с недоставленными событиями
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(ctx echo.Context) error
+	// Получить список подписок
+	// (GET /webhooks/list)
+	GetWebhooksList(ctx echo.Context) error
+	// Подписаться на события назначений
+	// (POST /webhooks/subscribe)
+	PostWebhooksSubscribe(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -214,35 +844,229 @@ type ServerInterfaceWrapper struct {
 func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPullRequestCreate(ctx)
 	return err
 }
 
+// GetPullRequestHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestHistory(ctx, params)
+	return err
+}
+
+// GetPullRequestList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "author_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "author_id", ctx.QueryParams(), &params.AuthorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "reviewer_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", ctx.QueryParams(), &params.ReviewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewer_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_from", ctx.QueryParams(), &params.CreatedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_to", ctx.QueryParams(), &params.CreatedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "merged_from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_from", ctx.QueryParams(), &params.MergedFrom)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merged_from: %s", err))
+	}
+
+	// ------------- Optional query parameter "merged_to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "merged_to", ctx.QueryParams(), &params.MergedTo)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter merged_to: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestList(ctx, params)
+	return err
+}
+
 // PostPullRequestMerge converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPullRequestMerge(ctx)
 	return err
 }
 
+// GetPullRequestOverdue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestOverdue(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestOverdueParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestOverdue(ctx, params)
+	return err
+}
+
 // PostPullRequestReassign converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPullRequestReassign(ctx)
 	return err
 }
 
+// PostPullRequestRebalance converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestRebalance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestRebalance(ctx)
+	return err
+}
+
+// PostPullRequestReview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReview(ctx)
+	return err
+}
+
+// PostPullRequestSetStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestSetStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestSetStatus(ctx)
+	return err
+}
+
 // GetStatistics converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStatistics(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticsParams
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetStatistics(ctx)
+	err = w.Handler.GetStatistics(ctx, params)
 	return err
 }
 
@@ -250,15 +1074,61 @@ func (w *ServerInterfaceWrapper) GetStatistics(ctx echo.Context) error {
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostTeamAdd(ctx)
 	return err
 }
 
+// PostTeamArchive converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamArchive(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamArchive(ctx)
+	return err
+}
+
+// GetTeamCodeOwners converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamCodeOwners(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamCodeOwnersParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamCodeOwners(ctx, params)
+	return err
+}
+
+// PostTeamCodeOwners converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamCodeOwners(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamCodeOwners(ctx)
+	return err
+}
+
 // GetTeamGet converts echo context to params.
 func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetTeamGetParams
 	// ------------- Required query parameter "team_name" -------------
@@ -273,10 +1143,65 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// GetTeamHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamHistoryParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamHistory(ctx, params)
+	return err
+}
+
+// PostTeamMoveMember converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamMoveMember(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamMoveMember(ctx)
+	return err
+}
+
+// PostTeamRename converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRename(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRename(ctx)
+	return err
+}
+
+// PostTeamSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSettings(ctx)
+	return err
+}
+
 // PostUsersBulkDeactivate converts echo context to params.
 func (w *ServerInterfaceWrapper) PostUsersBulkDeactivate(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostUsersBulkDeactivate(ctx)
 	return err
@@ -286,6 +1211,8 @@ func (w *ServerInterfaceWrapper) PostUsersBulkDeactivate(ctx echo.Context) error
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetUsersGetReviewParams
 	// ------------- Required query parameter "user_id" -------------
@@ -300,15 +1227,154 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	return err
 }
 
+// GetUsersHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersHistoryParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersHistory(ctx, params)
+	return err
+}
+
 // PostUsersSetIsActive converts echo context to params.
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(BearerAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostUsersSetIsActive(ctx)
 	return err
 }
 
+// PostUsersSetMaxOpenReviews converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetMaxOpenReviews(ctx)
+	return err
+}
+
+// PostUsersSetSkills converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetSkills(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetSkills(ctx)
+	return err
+}
+
+// GetUsersSkills converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersSkills(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersSkillsParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersSkills(ctx, params)
+	return err
+}
+
+// PostUsersUnavailabilityAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUnavailabilityAdd(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUnavailabilityAdd(ctx)
+	return err
+}
+
+// PostUsersUnavailabilityDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUnavailabilityDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUnavailabilityDelete(ctx)
+	return err
+}
+
+// GetUsersUnavailabilityList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUnavailabilityList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersUnavailabilityListParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUnavailabilityList(ctx, params)
+	return err
+}
+
+// PostWebhooksDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksDelete(ctx)
+	return err
+}
+
+// GetWebhooksList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooksList(ctx)
+	return err
+}
+
+// PostWebhooksSubscribe converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksSubscribe(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksSubscribe(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -338,63 +1404,220 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	}
 
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(baseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	router.GET(baseURL+"/pullRequest/list", wrapper.GetPullRequestList)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.GET(baseURL+"/pullRequest/overdue", wrapper.GetPullRequestOverdue)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/rebalance", wrapper.PostPullRequestRebalance)
+	router.POST(baseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	router.POST(baseURL+"/pullRequest/setStatus", wrapper.PostPullRequestSetStatus)
 	router.GET(baseURL+"/statistics", wrapper.GetStatistics)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(baseURL+"/team/archive", wrapper.PostTeamArchive)
+	router.GET(baseURL+"/team/codeOwners", wrapper.GetTeamCodeOwners)
+	router.POST(baseURL+"/team/codeOwners", wrapper.PostTeamCodeOwners)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(baseURL+"/team/history", wrapper.GetTeamHistory)
+	router.POST(baseURL+"/team/moveMember", wrapper.PostTeamMoveMember)
+	router.POST(baseURL+"/team/rename", wrapper.PostTeamRename)
+	router.POST(baseURL+"/team/settings", wrapper.PostTeamSettings)
 	router.POST(baseURL+"/users/bulkDeactivate", wrapper.PostUsersBulkDeactivate)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(baseURL+"/users/history", wrapper.GetUsersHistory)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setMaxOpenReviews", wrapper.PostUsersSetMaxOpenReviews)
+	router.POST(baseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+	router.GET(baseURL+"/users/skills", wrapper.GetUsersSkills)
+	router.POST(baseURL+"/users/unavailability/add", wrapper.PostUsersUnavailabilityAdd)
+	router.POST(baseURL+"/users/unavailability/delete", wrapper.PostUsersUnavailabilityDelete)
+	router.GET(baseURL+"/users/unavailability/list", wrapper.GetUsersUnavailabilityList)
+	router.POST(baseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+	router.GET(baseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	router.POST(baseURL+"/webhooks/subscribe", wrapper.PostWebhooksSubscribe)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xb3W4bxxV+lcG0QByAFinKLlDe0bZi6MKySslAUUEgVtyxtDG5S++PE8EgIFFp01ZG",
-	"1NwVRhPDyAvQshjT+qFe4cwbFWdm/zm7XImyHOTGoHZnZ86c3+98M35JW1ana5nMdB1ae0m7mq11mMts",
-	"8dca0zrLWof9xWP2Dj7QmdOyja5rWCatUfgFzmEEJzCAU/4KzmEMQwIjOOOHBE5gDGcwgHM45ge0RA38",
-	"4rmYqERNrcNojbpM6zTF7xK12XPPsJlOa67tsRJ1Wtuso+Gi7k4XBzuubZhbtNcr0ScOs5f0LKn+C8cw",
-	"hHPehxH/TsrH+zDmuwQuYCxE/QBjOBKPh3DKDzPE8xxmNw39UsL1gpdCgYu2bdkN5nQt02H4gH2rdbpt",
-	"+RPf4Y+WpeMUy4/Xml89frL8gJZohzmOtoVPbeZYnt1ixLRc8tTyTF1ooGtbXWa7BnMSUyUfy4lfUmZ6",
-	"HVpbp2uL9UfNxb8ura6t0hJdaSR+P1psPFzEtVGO+urq0sNl/8/m/fryg6UH9bVF/62UcqOU3nxMbpXV",
-	"IiWuS9Gi8dFc1ubXrOVOjJc7nBxWoiteu91gzz3muJMa0BzH2DKZ3rTZC4N947t10l98KxM4hwF8wH/5",
-	"9+g/cM4P+N8J34UhHPFX/Ac4giHfRc8htypzc9Uv0W1c1nEU2w0F1Wxb28G/Nc/dtoQ7qUa3bKa5TK+L",
-	"PTy17I7m0hrVNZfddg0RH6bXbmub6DrSBRW6t7dmm6HrtdtNW+oyS9DEGBknilGOq7meE/e9xyuLy7RE",
-	"fS+b9J2UvdOiqBaO6zRcsqSy+RS/Wd22bJXz5Frs96AslV4aTCqww0yFTkz2TTNIi6q9WG099/10rU3d",
-	"W3yJUkIg1XawhE1uo8M6m34yCCP4jzZ7Smv0D+WoIpb9VF7GWR6Jb1ShHZWxqduJV7xAiCyx/QUnhDec",
-	"ptZyjRfx5TYtq800Ez/NUz++KyZopOHwm1JsZZXMWJUvLW2e7j7pXuKWyNsXTmaYTy2xjOFi+qQrDdLw",
-	"kwuph9FCVpn9wmgxcmuNOS5Z05xnJfKV1m6TaqV6F6vFC2Y7sujMz1XmKiJguszUugat0YW5ytwCxq7m",
-	"bgvNlbtRiirLAiHUa8lKh0rWsIYt6SiS5bixlHZfDpd6YI57z9J3JCQwXT+wtW63bbTEDOWvHZQqAU9i",
-	"2Y9681QRurRr356vVOaV+aZG67pOHKbZrW3aiyOmz5FkZ0yYaq9IgkLxQAI9sbFqZf5yCu/aWYhlnXpV",
-	"dN4FFOQ67RLVHllyejmGkuLlJck4Euv1lCpLIq+VBuF7MIYPcIyNAhrzTuVOAa1FMubJkwTfivXhP3Ak",
-	"O4NyvF2BAWLBoQSEH/1m4kBK9+fL2TSN8eOYO8L4Kw1i6ERr20zTdwj71nBcJ2WLmfaJet6HX2FI+B7f",
-	"5/+CId/jfTji+zDkfbmS1+lo2E1ReBtYhPf5K7LSIDAiMJCaQhWJvup7nANO8FUcNo/EN3AMY1JVI2cY",
-	"wYdUcxjOzndhgJlZ2xJOH/Mnh26glImMKPBu4YT4SIyeIR9mh1le0MwKd66WeSo3k3mijoNihbs9X7ld",
-	"vbM2X60t3Knd/dPfri03+Tj45rMTHIkEJaJlzA8F2zEigTg3nK1QnnRaSsfuGxFXQxGiQfSewABOfKHJ",
-	"LRiJL8/gAsaC/jjnfRG8hwTGcCHidMD/ASN++GXxWLT9pqFwOAZdxiwRiY1A4Ku+V1ZzfS7Hf264b/n8",
-	"YY1I07v7yQEF7qHb1lpMb26ih3p36fVFcWryHGJnDEcwhvcwnixKouLkm9KmyZU2CmQPeCNmH06wSiMM",
-	"4SN+ILlIjGiU77NkkxGcYgFXk6KvVNnmkhDI77WxSgjRo0T1k1wDPmDeORNp6FBiB8xLe3AKQxIyki+0",
-	"tpcFp8JBEZxqaaZpuSTIScQyiZSBrDSkKkzrvmbqhu53VEm5eF8AGEz6fB8ufBpQpFEEhyMJjVBXeaKl",
-	"aNNIOtMistckvkuJ1rEVyEMMk2BrGgjq1v34TQn6Jtdo7/gBnE4wmipEdpa/iQQVHGel/e7XcAQxHSQZ",
-	"4lrE3TYcX9PXB2HhJxjwXb7P/xkF0bEsdiFTCxcYznCEfk38UqaIP344WTUnh/pIFoHqOZzga1Ens5KI",
-	"0DWBY5QRh4hhEusO5e/0eUhOZcUcajiu0RLxs8UU5fQhc1ejUbNWiojxc5qbO01Mw+v4vYdfV/NLajhu",
-	"PntclfY2SulVPEEXRevcjdE9WMljUy8kXi3Q3kYeozC5mYkjotci9/ptDLZCKqOKduVC2m4Av0p/4/vo",
-	"2zHiP33k4kmN+wXCMF22JVnDa4AQcnYV9zZx2qDSdSE1KArWR6UaMurGD1dSTjb2yuTyiiojNYFKMyWF",
-	"0xQq8m9532+IUX3iqFGpQBVOP+X7YZ4RnycmQgWrLKHy0lg2waQQpBEsI2VN1/NB+RrTOnVdnwWIh2z6",
-	"eoLuladMscCdjzOwNVpvGy0mwjzvo2ryo3vWpkgmMd6YdrUdYTlauN6shRX2mkk81z9u+Nwq2dRaz5h/",
-	"VpyVKANZCyiqSCy8TjBocWIPBhI5VmYjz5LH1xEYCff9CSm09O6uSqclYMA+4XtEZIGBmCG4THEGI3Ir",
-	"UiD/kffLMIZ3fiNzyg8lSlUmYBjCx3jnjhZMZAQfT2TBChz/kLniICK6C7Ku1l80pJy8K4L+OBsm+c1E",
-	"0OVzSsp1foZ3/N8whBO0WRIG3jjj/Tqf5sZIza9URR04wwNR6U5502s/e8CEdaYeaj3BL+4lP5ihVqlM",
-	"HLpGkvGckjqnnloq7prA/4Sm9mAER2TpQU4IY3tzis0x2mWAvoPfSGpOaLfozZOcA+hQzhuho/TQfiEP",
-	"lmaY7djdAxn5ifsG1LuT4tGmU355VlRLNGGzt3CBuAzGcJI2x0gCMBEOsgmN8Gnxq0GpbecLkNnXZoPD",
-	"QlccEvc+pnmRUnPpjRSkyVQBMMpRND8oCV47QxG/0axalDm7Pr4kg8hSt7l+srkEaRJkMmk2daKSU2Zm",
-	"uOQpIN8j8A6GYuAFTo4Cw1mexw/x9Zj34YTv8gPeFxsU7XlQe0T1SNSeLebKuxt5IEh89jAceVksFL+f",
-	"OjsSimc3ufwnpew3JniYQsebxS9TTdy8U6TEK/ABSWGKte+xxLrS+CJkfJR3hKcAo5XGF5iZ4D0GQi6p",
-	"XoiTzXZgh7lLTj28UjUFOa3GRs8Am2Jg+qnWdlhxH7nybbVMQ0+7rXXNuCXgziZVkIsls9qMHFUFK+UF",
-	"j7hkV6wh/znWMf4oAYpP5ak88+ar5pvi507J0PtF0mX+5nwG7Ts4hQG8J7EadO4f3Y/yLv5PBFovfPYy",
-	"+I8AsoPBptJ/IAfHHiRY/Nhzycf1Nnr/DwAA//9UcH6gaTEAAA==",
+	"H4sIAAAAAAAC/+x9a3Pc1pXgX0FhtmrIXVBsipImoStVoSVaZkoiOd1UPInEaoHdV2SPugEaQMvialjF",
+	"h2U7S484nkptUrOx5SRV+7lFsaUmRbb+wsVf2F+ydc994F7gAo1+kJIf+eBQaDzOPffc8348MStuY8N1",
+	"kBP45swTc8P27AYKkAf/WmrW60X0aRP5wXz1n5vI2yRXq8iveLWNoOY65oyJ/4yPcBufhbu4E36OO/gE",
+	"t8Jd3A23jaWiaZk1ctOn8KxlOnYDmTPmRrNeL3v0xeVa1bRM8o+ah6rmTOA1kWX6lXXUsMnXgs0N8ogf",
+	"eDVnzdzassxlZDcW7AZKA+jv+IyCgd+EX+Mz3MVtA3fwaXhg4BPcxae4hc/wUbifAl2A7EYZ/u4Prjs+",
+	"8gZBE36LuwDqa9zFh3C5jd+EByngNX3k9Yu0LXKzv+E6PoKN/cj1VmvVKnLIPyquEyAnIH/aGxv1WsUm",
+	"ME/+q+/Cz9Fb/5uHHpgz5j9MRjQzSX/1J+c8z/WK7Bv0izEEfE9XaeAz3KZrfo0P4doBboe7Bj4M9ykq",
+	"yP6Fu+TWLn6L2+E2boVf4E74zCRYduxmsO56tf+JqhcI/d9wF5+QDTRwN9wNd8I9+O8uPgz3KPgd/AZ3",
+	"YHWw08f0V9yh20keBSphnyQQzfp+bc1pICeYe8SWsOG5G8gLanSb7Ergehpa+mu4h1+E/wu38Um4a4S7",
+	"HDbcImAQ7H2BO5y+6MXX+BTuaMPPB8ZY2jLehtu4QzfjBL8Jn4VfwjPkGB0buEUeSNAx+VpnnKMg/Bx3",
+	"8Rmj5QMDv8YtfIRb4Ze4dc8Jd2BDCV52cGvG8Df9ADVmPLRq122ngix+BfkVuw57KS41HfuRXavbq7V6",
+	"Ldi855hWnNAts+IhO0DVsg3ofOB6DfKXWbUDNBHUGkj3DCLYL9PL2XQS27Fl8siWZTros7KHHtXQZ/Ro",
+	"Jk+gZbr1as974nxRd4+HbEbaSQ4UMYS7Gh4rLVO8RkHYisCNu/qvqBKQz+lWPPPERE6zQb4yWyrN31ww",
+	"LfPOgvizOCf+vDE3e315/rezy/OLC2Xp+u254s056XvR8qLvlQLPDtDappb+d4EpEEp8CeQM9PqCkXu4",
+	"jdv4MPw6fIYPgdq6+DDJ+vkSPNupug2CErfpVMueu1ojeKkj2w/KddeuIoK8z1BtbT1AVS3Q190qWvzM",
+	"QV6xWUfJY1yvOUizim8BoHa4bZATCGCekKN3RP4I9+iBDXdxK6LZmhOgNeQBrdhBgDxHSyNEgsGXawFq",
+	"+Ppb6AXb8+xN8m8iVfp6JEZusMYIKv5CDouOtATW/CTKqm6l2WA8MYa2P6roMfAhcJxwG59SkjCuL96Y",
+	"W/xkYa5Y0p12r1lH6kqzTry6tRrERdqCDmfNjWqf7CiGV1kZEUjhi9Bh9UbTA665hLwKcoIaW2wMic8J",
+	"3YVfcE5ORdcR+T+mfYDiBJKhAxjeAWGzR04PboVPP9CIwfBZuGsZuB3uwOsqbtMJjF8ZBdOKbS78IqFL",
+	"puqrhbKPKq5T9VWEuc3VuoQtp9lYZU/8sv8nrvb1RGxHKPQ61KtaBOGSj+3GBuUIiPxGF18lTy0sLpc/",
+	"WryzcMO0zAbyfXuNXPWQ7za9CjIcNzAeEH4En1fRJ14Vx2pVYc3Lc7O3y3P/Ml9aJsdgqaj8DQyYfJvA",
+	"Qdky+2f5+uzCjfkbs8tzpqVACXcuLRUXfwt3zi/8dvbW/I3ycnF2oTRPGDx98/VbiyW44c7C7J3ljxeL",
+	"87+Hf360WPxw/saNOXIbgDZbvP7xPH0X/Ju8f+720vLvtCxW4KiX3AM0RPcn9yl2P8WmbjsXHyGv2kRF",
+	"ENoa5QwkVZ+qBtVd02R7tYn6ehvTkQQMsUP+X7iLX5LzmqWSGeG/hzvUWsIdKivh7xZVBQkPIP/9kpx+",
+	"09ID5jTrdZucHWaIDKTYKPekMtReKpRft8vrbtPz9fxF4dYadDEFgay+hQ+5Am0ZoAUfG6Vbs0TDb4Xb",
+	"4V74Fej0Vt96WHKhMl1YMfszWq6lkJygFnnNOjKWjPgMIuYf0ggLZnDCwvFr8l9uE4T74VO9tjVWuHSp",
+	"YT+OXhvTv8ZNqw/lJPvUVOquj6qz6eemJ3kyHXiYVzSQtzaKN3y4mbEBKY4CYXjtpNuFY+S/1LijSqdl",
+	"rLrBTKU2seoG41bM+saHQN0vcdeg0jw8gMvtd3TYbWEV5NfciuzhyKLQkZYf2EHTl+XmjeLsR8umZS4u",
+	"zTFr5sbvyh8tFsvFud/Oz30Cl8hvILmEtGMCdWX07IBBaOnOao/zXlp3Pd2hzzxOo9u+HwxudWgsoojo",
+	"kigkFj87lqnWftbveZBMVcEyEQe9pJUFLh7gsUxmgbcmxrANqgKE+/g4wbUHkGPyGi0FI3p8Mg9PNVsg",
+	"VatxaTTC094b7T1XHQdQv1bya4pTr+I2OE2NxHvVUyMK7ADlQ18Jbo3jQNVB6Ot6+o3kF0oMYGlu4cb8",
+	"wk3TMiVT4vrHsws350rl4tw/35krLdNri7dvzy0sa4+9ZWp2u5d+nq4bx06CgV+E+/hN4vSkKb+Da+Xx",
+	"LxABy76Ou1TaE1FNf+saoKSfxNVy7pxtD6ycN+ygso6qZf9hrV73tY6qFvMHdyif4dTBHrEkxhPu41Ny",
+	"Wxe/IEYEWSf4lRNYHgt3mTZzQtZ2CA4F+I24cEBnCXcgSHCEWww3S8X+9EZKueX+TwB/NPso9sTs6Dl4",
+	"b7ZtmelyJ3awI+YtA6o7zctsBbET5lXWa49y0PmJallRCicmVrgdPiXapmR5nuHWB2kxlnDPkGMr4HL6",
+	"A+6ET5VP5D6oDUQcPPllDMHCbcTdSAlNEgVBzVnL9ZYSv7eHAzHDGciBT9uuPkNKf46HjTRBI8sYedBo",
+	"tCGcWlV5ouYE165onedqxIcLp+LcwuxtkD6Sb+r23O0P54rl3yzOL8j/vjX30bJWNBE96JFdb6IcJ/5Q",
+	"Zpx74TODeBXCb/AbyvyTpt7Xxpj0/XEdJohWlvb958A+XsGOpsTEjTGGBRHOwyc5+BQQQF7YKS610Pfw",
+	"pkecTefPxm2Ql38Iv6HOCXycFxbLyEZr3GfIqU111igBth76kcRLEme05pftSlB7JCNh1XXryHay+Tv9",
+	"LR8viZi/eMaSvpwGc0nicjp9i+hiZV8K3eULp4pg35ZlPrDr9VW78rAswljpxyjcTxAkOKXIvsN/wu3w",
+	"AB/hEyo6iFbSgdDhM2BhBwmBGu5LIQwa+Dgk2hU+Vg9Cm+YxhE8ZVVEtB5+wnzvgXiUazmFfGgvxmbkb",
+	"yGEGhm71f8UnjKZBcSKrPoFV78bccQY+xV38igL2Am74mojQcI/4fYGBs0QZii6IqZE3f0kVrvCZMVYw",
+	"Jgz8ArcJhrv4ZbjNfvqSywRyWBo1p9YgLLSg47WKG1Cznr8QrTbcAb+UnLITfgk5AkQV1rkYLVU5apHD",
+	"T7eeqI5LRRmuKR1cQom1NzY895Fdz4NsSkMAD8Ed0dDCPeCoXYifhQcG+PEY5t5SWAFmwHNcQrZ640/A",
+	"mY1EyPvoG4Vsa2VuTpx+S0VK3vhFuIePqO4lKeM5YKaKt+yMj0H8J6rgy+ilZAn+46RRRhD/Br+ifowu",
+	"xMvbuAXh/23O22l2UYphBTsCDvzYJvTagi0NK7yjpKIkmSFyqr5eLf6WoPVQfB/CMdG5+iI9yJI/E4T+",
+	"RFhrBhvhElNGFc+6klkKISOJpaS4oKkJyPV9mrTVIcfFYK8+C7+hHNe0NCKNw9uPtc7tiA7/Xju+7eF+",
+	"miVh0YWc0PDXLqN6lpkG9ESU3274FTk7uTfED2wv8PvSW9WUpvwKbH4bL/EBS7b7BMSWIFkpNyhBRiva",
+	"o0Ci1vVHqFqquxqLo7+kJnXjFxbLkEA0F0WlS4SvHpJt2iNZdVqRCztJpN4ZdUUc4TO+xUTW7ILJKS6l",
+	"kvTsrVvSd8uLC+WlInw93NF8ONw3QBS0pdChiMwktAyZ7paKiW/NLpevzy7NXp9f/h35Is3EYKw93Nd/",
+	"HR+x+zr4JXyV/Id4Y8ijWYoCZNJxI0iHcmIRaXCRvCyBrTWOPNRwHyGdAv99jN+HO/gsPAC5A9KICSoS",
+	"uKaJP/vGGH1buSkIcNyi+Y9w/gXWOOfRBjDxqZYhpVsaf8RtiYg6xHCjqofGqW5xnSAhYCmrIZAAiwl3",
+	"wq+VxQ3gjpfzgsX5pejWHlt/ANMjh3r6f0A7ZNaXIMBMPTWHlpnqepiIeYTgovzdeNpfitMuLUegD+/a",
+	"0NaXbEtmW2Jk80rCXSulGXEf7l1zzTUt0/+0bq7QbwDMZvOymcgnSvX7/o2mV9JzQ5UmYK7AXzvMj3BK",
+	"d5LoYTSLsW0x64uwq8/Jk6BX7PVlBw3iw6TL0KHrE7S67roPS81VaX2JuMxQmcN6/HXwW8Lqd8Bo2Aee",
+	"cfwByC6gV+I6CXfwW4I7oo4YoGILgybc5bIGnnwb7ss4HCBPOeGylBCSX/cYLIfGWCpmaGPC4n4L4ppi",
+	"5ISp8pLEVc9y8gB69d4kE181fUzdzB7+G4I7VGl6tWCzRFBOt38V2R7yZpvBevSvjzg+f/MJCXwnE5lB",
+	"4QBWBysmNuXs0vxElLzBdYjffLJsjH1cunz12mSR/HeciMZK3a41fOO+31y9bxn3PbeO7hu4Y9wnm3T/",
+	"0j2Hll3gzoxx3642as59i/5WriO7et8YC/dA4SWnlBdiKIGZNP9HFx+PW8b9VTcgb6TOaPY94lr4Cswy",
+	"bnrdfzxBIPPvgwdfKeqQ1HdurTIw4CP8pHTwKTdFYDkfaF7DBMgZTVy553B9iCwRFAZicpEkcXwa7mm8",
+	"0uQ9UhjijN+o1w+fXQK1CU4cSEnY7ogo14Ngg1aS1JwHLlBlLSBM2lwqGjx+aUQH1Sgh71GtgoyxZeQH",
+	"xrLtP7SMj+x63bhcuHyV2KiPkOdTupm6VLhUAGfvBnLsjZo5Y05fKlyapgnY60CMkxtRmH2SEjO5vOHS",
+	"qDvhfJAnPE/EwpLrB1JY/jq9nR4b5AcfutXNHLU2khySUkzM5pSpibybG97EVKEwpc3SmDFnq1XDRyTC",
+	"ZG7JlU39ZLJU1m1nDVXLAieJmAcLcAiHcdsAiXWM34Dt+JZqah18ypyM/Kh08BspyzzuQlfYnuqlEn5H",
+	"fMjCo+CODr8I9+lbjnBLZvI9BWXVsx8EWscV89aASb9UpL5Mym7ImcJtA5JxLJ1tkgYzC/Nwc/sQ4CXu",
+	"DEOTyZPUHkeZGAY39HCKfa9x7UKdCFMw6Y5Lu9XFpx+kbxhfOMQdDRp8BzwckXgCS6AnLmV6nabbgn91",
+	"3BJfYQLuBbUOJY805Xnc0CP5dx3cpl8+hT92+6SMWJA+O64fC+G3I38bME5wljDl/WsdxSTSC4011zL8",
+	"T+uW8cADrlHtL3QPQJcbLKe9ih7YzXoAXAM9ADarroVeNv7f9h/ZqT0E+qcOcKmSQePtEgcxCiZH+i4x",
+	"yDoWT3ig7wdLBt4Dh0vWXsSDcZSBVCLi/FQytcVa2OtHn+Gm0Vy24nWj8drQy4Wp/nj9hpeWSXyX2BuW",
+	"2Zw2V2SohhcJUW4hTSXcypARG14vbVmSfDpvb7IadKmousO3LPNKoXCB1ajfAjWRs7pNk325wS0RINUa",
+	"o3NkUbP4Be5STwNNI/pKOEKONU53Uap7FD4l/wWTeizimBCEkf0hbXxmscd4VC5VMFIAcTv8hn75NeWu",
+	"LK1cfhQCFaCEEXgNJaI0TrE/lYZUQduTSuUwPDTd+6GoUhqeuHKBm/wfHFmT8QQahrm+dvEM9Joj5l6C",
+	"xfwy/zmnSa/uddup1qpMlfSbjYbtbTJ6ZBp7pHAzT1ccHAj7qC4FwhNFykKyUEqpSIpqpRzXYK7tOjI4",
+	"ORif1YJ1SvT+jPHwFz7F7IY397jmB74K9lKRe2zBJv0DbstWaRZQcjVVBNFS0ahVDbvuIbu6aSD6xa0t",
+	"WV+ZDW7VGrUghr4/5dtIsNW+CbeFPpLLvTYQcgXAEWab00bNN+zACNZRzTOI6cF+NOpkTcbY1cmr42S9",
+	"W9aozkD2DlnGWMTefsWk2rjCBKnYlQw5SST3f4oUAhcF/dE+JpVuvcpxhLsqE0spWe7FQie00IdP5bu6",
+	"YLxwx0pXVn6lG/Fp3A9hjMX12HBPXYyURKGkcuoQ2oakGnsNlAJJ3oK/jnkHyE/go+CuUOKiEJlupmWu",
+	"uoG5QlCu2LbrNT9wacuNNaSxbW8i2bT9mN1tKa1O7urpNLplUtMKZWsloTkV+tOcwONEP8/y8qhmJLsi",
+	"TWL+T0wVJi5fWZ66PFMozBQKv1eTjWaiMvxEJwKqgWWpWjz2BjcZ7CaDgWBuWU/SwZlOAac4lwXQVVPT",
+	"DaEPOBu207QJpFTlhH1IfzBDM+TofzKQU/Wc6gsYUCs51FD8Z+pFhty7A13Ww/EQytEFqjoi3URSUuK8",
+	"9TmwmL2IiXakxT/TLl7PVZeKqawowVvqNT/IyVhukVsTXAU93qiDqKXBJl0/H1HfFSFTkOM51U3pyuA2",
+	"wTdJ3P9w5nWQKpVT6Y2Q9A/HqkiyHu8nppCjh1M/33oOtk7UjkP47w5ZSPKAiPUx5msm5Eiiy7gVD4K1",
+	"xlMAywOT7jnOgx94bkN5Pl9PiYHSkLLgCNyBoNC9kha+vvOVMTACdwRAOOhxUK40Pd/1JM8x8RTu4yOq",
+	"yNI0gKjmpZWGcHjLICQDWrnyoHCjXS5ALJ9lRxYK2bmSAyo6aWJXQo7GKfmdvsLgjGWpgqetDRk9bYie",
+	"hrvR3kIRVKZLOb+0V5xCPZrfqF/IJbr/qsANnOz98SKdhHvEymQeHsnKFHXmIo4woIKRLduVmDg42wwo",
+	"GyEOIIo2qDHrKJDyapO3NCsUn7HIICGTsXCHqQastQUvhM2wSxLKADCI3DG023D3ECE0xo9WN3v7SzN0",
+	"XOkt/bYVyN0U4JIBlSUdie3SiFrP8p/UxHMiYMNtnrl1z8lubdAlUXJNsnvkv0wsj7jiWQq5HLWQeiJ8",
+	"QOCS/PwGPoJkr5fUsXXPSW/HEIGiWMZg3Orb1g1tOAzm6S9cjKc/ao4Rs2anr8xcvfZ7U25+McrYAFOA",
+	"Lz46QAtHusxCocekY1BwjDEgHK7EMpZLTtprll5FW2NCasMfWGBQSoGMleXh4x+tBxwcaFFakK6MS7A3",
+	"nQE5iI+75jyy67Xqsmc7fo0niikuY0A8wEI8XzuUceBXwiwldsEu7ZYm9Dwl8t7K8shq22pFftmK7ZDW",
+	"YCStk9AE0ZlpHN8IXEOQ+5ZlOm4wC3UtqKquAH+rKVtK1LVkO42VRmCK/3vd9o2C4T4wpkSRuBHV14zU",
+	"N5xvISntieQSr3hdlxKkYp2y8JmRrBcSJZLp6RWTzBjXKDyEbnYZ1ZAX0Op+wSM6QMWnoPNy6XlGrRm1",
+	"K+7B+blWXdoBLacHhPVLSzpBLsyeH9ohKxZ8N9Y/Is0LmxB1vIVb9MBV5QG1OUT8rqvsrqHEn8avKhVi",
+	"Xb6iZHCapNISOVVzayVDSkqEkMt4Ulvn9TKf+NtzGU7Pk90vwn3O+hUHYHhwLsaJ7kNp5XVv04Alai/U",
+	"omVEd+JGCxEdPA2SsprtPi0Y4TLPa8TwJkjD2DF9u/qz6HDotkp9dTV690o1SelsXj339Bmyho26XRH2",
+	"5lVzdDpz7OUZ7QW7oPvqswZ6V8R4pvqlfOxEXzpIW/HI5i+52P0Ja9oj0q9ps8akSio0apaRe8Cr1UED",
+	"Y1F7US/XIzdDREASijPngIbrGBQU8jbACDUl8kIW+SIN0Us3CyRxUyZIFAYBUn8JNzxtSteBYLD0GihA",
+	"MtiZgiT1CofHqDkGUSMia4MxsBigzzPJSd9pSydNT3taJFETYzmNhSWv1Hxoqcy5LLGWgvWazzA9UqNE",
+	"ag5LucgRVfajnAs5izytdpmqL3FNJLVk+wT6+56Qn8FOSM29oo50xZPFIhQsIpGosRnKstDpIKwPYB9K",
+	"CH9iCC1Eq/Kmi7isGqfvlArFDmcHsDfhDo0IgkUnJ+GwbB5W9Xvcs5ZJ52gaWtUQuK9SCyfea/HuE7Vl",
+	"mcCUUkI43SvjIsuSkEHI3dpR1zmyl1EhfSiXIvBH3FUO1nugBKinPw5gR5uLz8vfW8k2DqTMnAjUWKcc",
+	"yHinok2TXptloQzKHXRMQfRYz8cR4PYh2EF2olHSfqYdBKOmlRkUntXfM18vhJz9PMUMlsE7afa0hnS9",
+	"P8/PKhqhwZFWnh5LmaJn5gjSG1nriLdcw+yj72xKQpjeQklrpaFhSv8pg0dDCk+p7CEBth+zNTK4tTHy",
+	"1GOqujNPbh92Uk+NNsbgv+O1X1FlkrL7GmVO0OqotTQfBSXRvZvzZI3EjNfnCj0IykXC/RkWnZi41ywU",
+	"plGiTtD4N4Pi9QODeCKMf9PdwXPbxFvoO8Wj9xy2N9FX6ANRYFoBS1+yyHdWfG2pSHV3uUBPL26T+dwZ",
+	"UpPWDWfKt5LA/jmJOOH+iSMh0+2Tp2d5asv3YjJnUZjpHOWDSKjUFu7vmVDaypkVxCNItHxArlB+fxKE",
+	"RpAA9IMUQvKQx0ToMemL0TGLvr1mIwoSs7hi4Ar+Zo7S7fFc5q+5guBRnQjr2MqRO0RNT5rD5JDVDfFI",
+	"reT/UInZIHF8/JpZesKNQnKVTiAGM8bwOGGI6u/XRjJLbHxEIpkwt5of1Cq+FIPN0GijLiJt0eEOv+ba",
+	"CU3d+5I2BIsK21+DsYhPyHqNu4RaLCNwx2f0kS6mc1hqd3bopAWopS9qW/ccSGFp0x7DR7GkMXJNGpYa",
+	"9TWL30S+xpI87MDi8XiacXES6wdI7pX6tluQopXqXeOF4rxvMLmqtOoJDy4ZUCP/Eqjldex7SqGWlKCj",
+	"v6PLEhdfUczfc5Tj0U6mC7UvGcIJFe+KRSptW9HQXDr3ktRnqV4nliZ5EmuXy3LaxarCPWPinpPsCqt5",
+	"Wqe73KTKCiPTXsH/b0VAs8tppUUzonRpgIFLXBMd0mQ13DGmCwZP/E1Lrx9VEvl/gbOlHX4hgORlzVmZ",
+	"5ekLie12dDTSFjLC/PoLyp2QZjSVVzfLRD26K2Y9Xs52GFrxp5s+Up6/Gm9itmLRvY5SKArXliHLgqVQ",
+	"ANPwyxvIK3+G0EP5ZdNknCt6WIbul2lv2LLE/VMp909Ny/evWKbUGg8mx8JH1X55BBHIqdactejalM69",
+	"6vllUsvsNgPFQ7sSdeVMIktqo0qRM6W0Vg1c6G+bQCSH2A6QU9mUJnReiw3knL5WKMQmbk5duVIoxGZq",
+	"Tv2iUCgQfbfWQOXALYvEafbaK7HXThV+kXjvL64l3/vLAnuvK2fOFJRNS28VlCRP7al/w8UoVGZ0U9j5",
+	"Wz6P5RUN7wDvlX1G+SeeDp+ykD6RNDFIT0c4udCgra/ToSGtXdZAyBmgDWB+ZNAj8iT3sJAYP3kywHpk",
+	"NqKtPVMUIiKGJR2JNKIBOjfuLF/P2ZQ4hibp+/1gKsnXUhffozvot5oOrG1dqEI/8zrOOXUtvaBjF4lp",
+	"gF2R1MpMazSEpqw0CVsevKYw+VTk9mh3NkADy/MY9nduAxB7jNRINsBKYXcp2E1Izx7TAEDze63pSN5N",
+	"j+p3oflJSuGIqW+lr8jvc4FJVNflhmqA8xJHb3xp+bY0rqlkuS10o9B1askg73AHnOfOlg7qvY48LY2i",
+	"Egc5gYekdEqjfg0vT2NDK304LFkxfidmIl+4xxJcXdQ7R6NkNKIduD+MPgjx1Pi4/xG3eldOxjck3JM2",
+	"hDl7pPkMkqOKWPE8a5jYjJN2tZodkicDgWar1eGqHNkMtrtK83HaLkGyVKbkVtkz5my9VkG0UUHGQ5fV",
+	"hz50V8HekXOBNuxNOCFmbmfossh6G3EfPT4m8F2jRKRH9ciPyomoPGxEpXvFs9jKz0QyPObLc7O3dS27",
+	"Ihdbom2XdU6nOqvl2EXGUn45AoxKc/IS4Qe7WjUYKRM/nu0YfF4kTxw9LwzLQzdEU0Hqros10ksZQJnd",
+	"10t+CeGuO0aK73QsImSSEzgJsWSa5S6aXKcVJx/LIYRlGISmix3I3JpiNwfHZjeOKJuyjtbsymbuZMq8",
+	"Ey4vPqDbF0+LA51PXfoPLb3paPNH29Uyh4ozKu5E0sLnbi8t/y7B8qFOlaW3Mx41YzSnLIN4REfIl/4z",
+	"UVwqJvmAhva1MpCIBsDivKQT50YaItLxJXwoGkdDSOIIvsqDX2NK/6wDXbNCUUErp50B2yJxw775E9mZ",
+	"xc8c5MmxzEQoibzqenRnv236yOMLdgMN1aEvPbOzisquWEIWaUhLiHML+S25M5NPwj2WCLKrNJ7vMItf",
+	"bezIx5ixIgMaYaOZWuRHuWP1Uezl9EC+FvHBV3RC3k/EfIphTWrST08Na9EPPTXTCiXYYdiyeohhhcoH",
+	"lsRVt9Kk6cbmfzfE/35tVxpokiny95xJbuVMGr9u/hP7lV+755hWv+UR0Vef6Bsbdemw+ENahmTch8S8",
+	"SvgVbsFceGjY8hb6wnRYzt6veR3gr11vbVLAc99Sx+UkCJZzNqFQyTvY74jhjHnbYskXr5i8I77zXDkL",
+	"shSQm0hfZD7adzAV4QX3MhG+94I3aojRRWQGALgdaOFHpWH2ZOjJn/WwfvWwcwIpp3H2JymXZUguDl08",
+	"PgeKov2Q27KwHZMG9EnMRu7q11HL8dP1omQ5DPn3JNOKspSjmyh4N1rRe+S000qofD67OEeRmorFJPpP",
+	"ReXJ6c3QaDiCbHO04CbPDNp7+1yV+j6bQBNYUts/D6ha9NPy+X+Thoeww280rcB+qjT8qi+sZFAyyZm+",
+	"Ddyttx/tdnTviFxpG3U7gA7MyZGdgxQqK3i1eKsrVvu5RzNm5UleHTHnS68aaScu9h8Ifpc+vodoIyjH",
+	"UhA0eSRLRS7bX7FGs4ks2vhgX1pM22Osr5FZORZNed4JD9KaIPQzAEyKAPdRbR09lTaZtdc7YLawjgDi",
+	"cWnTStmRfAZKGiIjsibK2TspnUmDTUR/eLo3bax7qO8MgNs/WvsjdfOY1XaSLRT6mWE0KpD/L4vNHA4T",
+	"S0rWpKT3kY0qVSi7Tqhq6fUNMJmZF95I8f/DLMaG20nZmO3U9RCXPdmysoiYrjOwnCSDRGRZWXE91L/j",
+	"KvaWJyNzD6kv/lEGrzjldph21Tt89Y5rFXGXzqGDCiQtWyHWDpG4J3zGK9cbT9+bMP3PPqEhmKxCqjpz",
+	"dywZbrMS0wgTo8et+Jxy2oxejajFSuEPxBQD3ueO02bfkTQfBUHNWfN7s90Sv3MIxit/LVLdyn7g2QFa",
+	"I+j23KZTLXvuKji2Htj1OuHEwA5hAcKsWYGpE3Kq87Q0p1a6PLXVN2eXwezFBAVaBuf34nMX0iNyNKlh",
+	"K9b7tZkXl1/2nZR48837LLJEK1sxg+iYDuNLRjFgCew2qjOS8UMHbCQrNRnZ+8QED2BdZ7gdl2ow+rgV",
+	"HsRYnwZL4z8Lv4sD6ftRJbBF9B/1MI5RWCwSMiZMiyMlqwBMlUi40SJ+kHz7+DCqKh80CkKYlj+52qw/",
+	"vIGAu7FeV+lijvga/A/VB0bcJ1CwVnXQRVZ83Nsse01H45P7W6xYI9yRSvJZJ0ESrTozgOyi7CAxMaWT",
+	"ZfUdWwarEaemHyVj3rj0QDuWP+LxbtkN1pHHmb2u1QA+4XBGbi3INYJS+h2i7siOrK8105xxK3wqp6Ak",
+	"O/DnmwpN3mMwra0rVhvuahfpIeLaLTcdD/lunU1FSJTSnAGjbLFUetYBSN6FDpjjpHI7rcHHoK5BLdTZ",
+	"pmpElYml/IVSFoHZmL+RkWYqoNVSWx/+xgxdScB5IbpSVfCBall7bq3oeD6w6z5KeErvUj+BpE9diTUG",
+	"793DfMUyZVq7m96P6bI823T21q2oH2+pvLhQhlJISr1VAXCsXDyLE2mxoSH9aOBWkuQjUx9IOXLl53dG",
+	"SxxRdzhjnuos6DKYn/ZA5u/k18PxncU6/sJ0qVYaT5CYAeUD7SgnU2IGYtgWYUF5Ib8jICvV3d5tCLUk",
+	"kXTMS+uNtm9wvzzuZBAW0Ush5qLfW9BHRN89Jh5hbJfBAPtZLb1YuyV/wyshYPpodM2lV5e72jXCKTzI",
+	"lGqqNhvusDpwmJ3TCnd4w6Ie/vP4VH2l1yJonhm+GqrLriHerjYjOwJedVPc2W+CBHl8dFPJY/My7z45",
+	"39kTK/mD3UNP8iytu15qVLPPMPYgQz+VsZb/KFqJaMuuz2N2zVLxH8EF8JLq2Rk9THM15I+dBInqc2QE",
+	"wUODpgSNgOIvbir8AMT1XkyBH34Ae2poc4yNO9M1tWWCvsNm43Zwi32mNZ5Jcz4K5v1Z5hLt6TIoSXcP",
+	"4S+QvLBJ3TyTmQ3hI6CGcbhHB+KSi9lugfBgALeAtDKdzj4AUUdvvBhD8H2y8qaHs/IuCzmpJ7pMt1Va",
+	"Vm0+4uzfXHue0XRRP45RGZPLNHu9R2L8Qsy57zmQWstN7j4qfKRwMMd6JnfxkcY0FghJPx1QLMdHZe2N",
+	"PkVrcEswEXfJLL3oxcQMeWq+rJbHO478lNKlko3yVbH9d0p3ivs//ByM6JdK9Smj0E6W0M46mckuXmpv",
+	"1uS2aqMF6VZVWrTAR8Ft+/HiBnKKUV+w3tI/9swwPUzsx2W179nV/GpA8uE4NyoYE2L4s9oHVgh3p1mv",
+	"C8WpC0jdC3dZ8z7SDHiCbtqxnMaiTPaPZ0g3ak6t0WxAw0jydnu1LgLNI2gHdfEJUsMxxXPgdu+ifG4X",
+	"lPUvIiA4jIISfmaguvoyLt9ZW8w40rIkUmZftWF4Xom0Sc/J69i9w2QBsa/dNdegM/GndbMPx40vYE2Y",
+	"OpC2wEbQvQj38Alxl4OD7/Nw26AtuQmaDwkWQVv8H/9wqTxhjEFP8enLid/HYxXD4Y7kdYlXC4u28n3F",
+	"ugayfhgOLp7zRcjvxfsYmcTXkAm5psEnQ+h7UTKcOsICEmZ+5naZ3E46HVlaYb9lsQOwO0HCmd5Ewebe",
+	"G2fiOzt7I3Yr/2DoNxcOsryITcd+ZNfq9mqtXgs24z0QE/19yDvlaZFSa8X0nqq6KWEt3lhH62y/ZOC/",
+	"q4nKBswYeEWeIsMbRM37Gz46WXaphDsgHzt0pIMCI7lkGXSEF2g0R8aE5pZkVTARxey9yYhb2pArwPcd",
+	"BcVDNpBETtWHlsesI/zUxNQ/KYMAhNftkU3fInk4IqOLWjbQGjv2usK08rq8Ko+AK28rZg7nE/1PKriE",
+	"Eh/YzXoQeQATbjppMXlhGEStEV+xxJoH03GmhrHuFJLq7UJT7k4sTv25j+IYODBK/8r3R+PhXRFpXQsI",
+	"bWAv0FPlEP57zMt/xVp+VpB0ChLLQYn3X5Pwxtv2SxkSefxrxIwE2wWfWFpHTv9qVGyilFbIVVEd5cqz",
+	"VQ/ODfpYv8w73xlmbEjwrZoTXLui6UWeeXYHdzVdSY1rsDPODckf8RxW/DxG0NmOZooQdSB6r8NwLuRc",
+	"r/lBT1tBJeVb5JH3yG5IyrOcsSFVsI3EcTGkOAz3s/Z/FJkJfxOztTose5w4ko6iK29zQtOvxfAZWl13",
+	"3Yd+Lgb6Cbt5xFzTb64KzA/IM+OvGCXHlKskW2ob0taPWrmQl52jpU2Ce0pvUJu5tiGbUaJhavaJcTqn",
+	"iTGGsfZNnBAzUhcFYfdipfxdjH+O0nMiEWX+bCwGT0l6uGc+tPql3BnOUvXvhRFyr+EPap58BGMXnwxI",
+	"AAw5qyjDC/JXmdgMlSzDAzFodGmxtDwhpr52AaxTQsy/KS0uTFBWy3wd0NOPO/RhOf8ywaCeKNXWHDto",
+	"euieA6/g00BJHMZfty9fvfYr2m10HT02Pr49e32i9PHs5avXDPYFqPoXQxwJCD6qeCiAhxCM/GyDCk6k",
+	"xle88E1e1AnuwGjRLp+0KS2SdBT4d3wCj3eZ2xty2NhIL+jwog4x7eLjNHcJ36mS2IVhPCUkp7BMiBu2",
+	"e7ZUmr+5QCdz0z+hPpigwpwx/Wn4IzWzyKubM+Z6EGz4M5OT7COXKm5jkpINd1RkOUhkcAZLtlwmB1Wj",
+	"5PBV9Nnegy2rp2bk1U3xjYt3dcjsaiB2uNU/hxt8Jsd5ezXuFG8l433khm0aisjsAQtez7cxaflTriRJ",
+	"yhdOA+DikBLXdlSer0s6zi9w6JFtemDq3H1iriLbQ95sM1gnE0+3VsSbnvCZtrSmeMsSF6haLl1Qxm5L",
+	"1+mUI+mCAG5rZev/DwAY8QILD/MAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+var ErrUnauthenticated = errors.New("invalid or missing credentials")
+
+type Authenticator struct {
+	apiTokens map[string]Principal
+	jwtSecret []byte
+	jwtKey    *rsa.PublicKey
+}
+
+func NewAuthenticator(cfg Config) (*Authenticator, error) {
+	a := &Authenticator{
+		apiTokens: make(map[string]Principal, len(cfg.APITokens)),
+		jwtSecret: []byte(cfg.JWTSecret),
+	}
+
+	for token, value := range cfg.APITokens {
+		p, err := parseTokenPrincipal(value)
+		if err != nil {
+			return nil, fmt.Errorf("api token %q: %w", mask(token), err)
+		}
+		a.apiTokens[token] = p
+	}
+
+	if cfg.JWTPublicKeyFile != "" {
+		data, err := os.ReadFile(cfg.JWTPublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("read jwt public key: %w", err)
+		}
+		if a.jwtKey, err = parseRSAPublicKey(data); err != nil {
+			return nil, fmt.Errorf("parse jwt public key: %w", err)
+		}
+	}
+
+	return a, nil
+}
+
+// Authenticate resolves a bearer token, either a static API token or a signed JWT.
+func (a *Authenticator) Authenticate(token string) (Principal, error) {
+	if token == "" {
+		return Principal{}, ErrUnauthenticated
+	}
+	if p, ok := a.apiTokens[token]; ok {
+		return p, nil
+	}
+	if strings.Count(token, ".") != 2 {
+		return Principal{}, ErrUnauthenticated
+	}
+
+	claims, err := a.verifyJWT(token)
+	if err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+	}
+
+	p := Principal{Subject: claims.Subject, Role: claims.Role, TeamName: claims.Team}
+	if err := validatePrincipal(p); err != nil {
+		return Principal{}, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+	}
+
+	return p, nil
+}
+
+func parseTokenPrincipal(value string) (Principal, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return Principal{}, errors.New(`expected "role:subject[:team]"`)
+	}
+
+	p := Principal{Role: parts[0], Subject: parts[1]}
+	if len(parts) == 3 {
+		p.TeamName = parts[2]
+	}
+
+	return p, validatePrincipal(p)
+}
+
+func validatePrincipal(p Principal) error {
+	if p.Subject == "" {
+		return errors.New("empty subject")
+	}
+	if !slices.Contains(Roles, p.Role) {
+		return fmt.Errorf("unknown role %q", p.Role)
+	}
+	if p.Role == RoleTeamLead && p.TeamName == "" {
+		return errors.New("team lead without team")
+	}
+
+	return nil
+}
+
+func parseRSAPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PublicKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+
+	return rsaKey, nil
+}
+
+func mask(token string) string {
+	if len(token) <= 4 {
+		return "****"
+	}
+	return token[:4] + "****"
+}
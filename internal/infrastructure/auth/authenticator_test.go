@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeSegment(t *testing.T, v interface{}) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	require.NoError(t, err)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func signHS256(t *testing.T, secret string, claims map[string]interface{}) string {
+	t.Helper()
+
+	input := encodeSegment(t, map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(input))
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	t.Helper()
+
+	input := encodeSegment(t, map[string]string{"alg": "RS256", "typ": "JWT"}) + "." + encodeSegment(t, claims)
+	digest := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestAuthenticator_APITokens(t *testing.T) {
+	a, err := NewAuthenticator(Config{APITokens: map[string]string{
+		"admin-token": "admin:alice",
+		"lead-token":  "team_lead:bob:backend",
+	}})
+	require.NoError(t, err)
+
+	p, err := a.Authenticate("lead-token")
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "bob", Role: RoleTeamLead, TeamName: "backend"}, p)
+
+	_, err = a.Authenticate("unknown-token")
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	_, err = a.Authenticate("")
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+func TestNewAuthenticator_InvalidAPIToken(t *testing.T) {
+	for name, value := range map[string]string{
+		"unknown role":       "root:alice",
+		"missing subject":    "admin",
+		"team lead w/o team": "team_lead:bob",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewAuthenticator(Config{APITokens: map[string]string{"token": value}})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestAuthenticator_HS256(t *testing.T) {
+	a, err := NewAuthenticator(Config{JWTSecret: "secret"})
+	require.NoError(t, err)
+
+	token := signHS256(t, "secret", map[string]interface{}{
+		"sub": "ci-bot", "role": RoleBot, "exp": time.Now().Add(time.Hour).Unix(),
+	})
+	p, err := a.Authenticate(token)
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "ci-bot", Role: RoleBot}, p)
+
+	_, err = a.Authenticate(signHS256(t, "other", map[string]interface{}{"sub": "ci-bot", "role": RoleBot}))
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	_, err = a.Authenticate(signHS256(t, "secret", map[string]interface{}{
+		"sub": "ci-bot", "role": RoleBot, "exp": time.Now().Add(-time.Minute).Unix(),
+	}))
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	_, err = a.Authenticate(signHS256(t, "secret", map[string]interface{}{"sub": "ci-bot", "role": "root"}))
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+
+	unsigned := encodeSegment(t, map[string]string{"alg": "none"}) + "." +
+		encodeSegment(t, map[string]interface{}{"sub": "ci-bot", "role": RoleAdmin}) + "."
+	_, err = a.Authenticate(unsigned)
+	assert.ErrorIs(t, err, ErrUnauthenticated)
+}
+
+func TestAuthenticator_RS256(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	keyFile := filepath.Join(t.TempDir(), "jwt.pub")
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600))
+
+	a, err := NewAuthenticator(Config{JWTPublicKeyFile: keyFile})
+	require.NoError(t, err)
+
+	p, err := a.Authenticate(signRS256(t, key, map[string]interface{}{
+		"sub": "bob", "role": RoleTeamLead, "team": "backend",
+	}))
+	require.NoError(t, err)
+	assert.Equal(t, Principal{Subject: "bob", Role: RoleTeamLead, TeamName: "backend"}, p)
+
+	_, err = a.Authenticate(signHS256(t, "", map[string]interface{}{"sub": "bob", "role": RoleAdmin}))
+	assert.ErrorIs(t, err, ErrUnauthenticated, "HS256 is rejected without a configured secret")
+}
+
+func TestPrincipal_CanManageTeam(t *testing.T) {
+	assert.True(t, Principal{Role: RoleAdmin}.CanManageTeam("backend"))
+	assert.True(t, Principal{Role: RoleTeamLead, TeamName: "backend"}.CanManageTeam("backend"))
+	assert.False(t, Principal{Role: RoleTeamLead, TeamName: "backend"}.CanManageTeam("frontend"))
+	assert.False(t, Principal{Role: RoleMember, TeamName: "backend"}.CanManageTeam("backend"))
+}
+
+func TestPrincipal_CanManageUser(t *testing.T) {
+	assert.True(t, Principal{Role: RoleAdmin, Subject: "root"}.CanManageUser("u1", "backend"))
+	assert.True(t, Principal{Role: RoleTeamLead, Subject: "lead", TeamName: "backend"}.CanManageUser("u1", "backend"))
+	assert.False(t, Principal{Role: RoleTeamLead, Subject: "lead", TeamName: "backend"}.CanManageUser("u2", "frontend"))
+	assert.True(t, Principal{Role: RoleMember, Subject: "u1"}.CanManageUser("u1", "backend"))
+	assert.False(t, Principal{Role: RoleMember, Subject: "u1"}.CanManageUser("u2", "backend"))
+}
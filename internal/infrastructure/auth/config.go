@@ -0,0 +1,20 @@
+package auth
+
+import "github.com/caarlos0/env/v11"
+
+type Config struct {
+	Enabled bool `env:"AUTH_ENABLED" envDefault:"true"`
+	// APITokens maps a static token to "role:subject[:team]".
+	APITokens        map[string]string `env:"AUTH_API_TOKENS" envKeyValSeparator:"="`
+	JWTSecret        string            `env:"AUTH_JWT_HS256_SECRET"`
+	JWTPublicKeyFile string            `env:"AUTH_JWT_RS256_PUBLIC_KEY_FILE"`
+}
+
+func LoadConfig() (*Config, error) {
+	cfg := &Config{}
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type jwtHeader struct {
+	Alg string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	Role      string `json:"role"`
+	Team      string `json:"team"`
+	ExpiresAt *int64 `json:"exp"`
+	NotBefore *int64 `json:"nbf"`
+}
+
+// verifyJWT checks the signature with the key configured for the token's
+// algorithm (HS256 or RS256) and the exp/nbf claims.
+func (a *Authenticator) verifyJWT(token string) (jwtClaims, error) {
+	var claims jwtClaims
+
+	parts := strings.Split(token, ".")
+	signingInput := parts[0] + "." + parts[1]
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return claims, fmt.Errorf("decode signature: %w", err)
+	}
+
+	var header jwtHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return claims, fmt.Errorf("decode header: %w", err)
+	}
+
+	digest := sha256.Sum256([]byte(signingInput))
+	switch header.Alg {
+	case "HS256":
+		if len(a.jwtSecret) == 0 {
+			return claims, errors.New("HS256 tokens are not accepted")
+		}
+		mac := hmac.New(sha256.New, a.jwtSecret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return claims, errors.New("invalid signature")
+		}
+	case "RS256":
+		if a.jwtKey == nil {
+			return claims, errors.New("RS256 tokens are not accepted")
+		}
+		if err := rsa.VerifyPKCS1v15(a.jwtKey, crypto.SHA256, digest[:], signature); err != nil {
+			return claims, errors.New("invalid signature")
+		}
+	default:
+		return claims, fmt.Errorf("unsupported alg %q", header.Alg)
+	}
+
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return claims, fmt.Errorf("decode claims: %w", err)
+	}
+
+	now := time.Now().Unix()
+	if claims.ExpiresAt != nil && now >= *claims.ExpiresAt {
+		return claims, errors.New("token expired")
+	}
+	if claims.NotBefore != nil && now < *claims.NotBefore {
+		return claims, errors.New("token not valid yet")
+	}
+
+	return claims, nil
+}
+
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"context"
+	"slices"
+)
+
+const (
+	RoleAdmin    = "admin"
+	RoleTeamLead = "team_lead"
+	RoleBot      = "bot"
+	RoleMember   = "member"
+)
+
+var Roles = []string{RoleAdmin, RoleTeamLead, RoleBot, RoleMember}
+
+// Principal is the authenticated caller. TeamName is the team a team lead manages.
+type Principal struct {
+	Subject  string
+	Role     string
+	TeamName string
+}
+
+// CanManageTeam reports whether the principal may change settings and members of teamName.
+func (p Principal) CanManageTeam(teamName string) bool {
+	switch p.Role {
+	case RoleAdmin:
+		return true
+	case RoleTeamLead:
+		return p.TeamName != "" && p.TeamName == teamName
+	default:
+		return false
+	}
+}
+
+// CanManageUser reports whether the principal may manage the availability of
+// userID, a member of teamName: everyone may manage their own.
+func (p Principal) CanManageUser(userID, teamName string) bool {
+	return p.Subject == userID || p.CanManageTeam(teamName)
+}
+
+func (p Principal) HasRole(roles ...string) bool {
+	return slices.Contains(roles, p.Role)
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+// PrincipalFromContext returns the caller of the request. It is absent when
+// authentication is disabled.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
@@ -0,0 +1,186 @@
+// Package codeowners parses CODEOWNERS documents and matches changed paths
+// against their rules.
+//
+// The supported syntax is the GitHub one without sections: every non-empty,
+// non-comment line is a path pattern followed by owners. An owner is either a
+// user (@user_id) or a team (@org/team_name, the part before the slash is
+// ignored). A line without owners clears ownership for its pattern. As in
+// GitHub, the last rule matching a path wins.
+package codeowners
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type Rule struct {
+	Line    int
+	Pattern string
+	Users   []string
+	Teams   []string
+
+	re *regexp.Regexp
+}
+
+// HasOwners reports whether the rule names at least one owner.
+func (r Rule) HasOwners() bool {
+	return len(r.Users) > 0 || len(r.Teams) > 0
+}
+
+// Owns reports whether the user, a member of teamName, is an owner of the rule.
+func (r Rule) Owns(userID, teamName string) bool {
+	for _, u := range r.Users {
+		if u == userID {
+			return true
+		}
+	}
+	for _, t := range r.Teams {
+		if t == teamName {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseError points at the line of the document that could not be parsed.
+type ParseError struct {
+	Line    int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+func Parse(document string) ([]Rule, error) {
+	var rules []Rule
+
+	for i, line := range strings.Split(document, "\n") {
+		lineNo := i + 1
+
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		pattern := fields[0]
+		if strings.HasPrefix(pattern, "[") || strings.HasPrefix(pattern, "^[") {
+			return nil, &ParseError{Line: lineNo, Message: "sections are not supported"}
+		}
+		if strings.HasPrefix(pattern, "!") {
+			return nil, &ParseError{Line: lineNo, Message: "negated patterns are not supported"}
+		}
+
+		re, err := compile(pattern)
+		if err != nil {
+			return nil, &ParseError{Line: lineNo, Message: fmt.Sprintf("invalid pattern %q", pattern)}
+		}
+
+		rule := Rule{Line: lineNo, Pattern: pattern, re: re}
+		for _, owner := range fields[1:] {
+			name, ok := strings.CutPrefix(owner, "@")
+			if !ok || name == "" {
+				return nil, &ParseError{Line: lineNo, Message: fmt.Sprintf("owner %q must be @user or @org/team", owner)}
+			}
+			if _, team, isTeam := strings.Cut(name, "/"); isTeam {
+				if team == "" {
+					return nil, &ParseError{Line: lineNo, Message: fmt.Sprintf("owner %q has an empty team name", owner)}
+				}
+				rule.Teams = appendUnique(rule.Teams, team)
+				continue
+			}
+			rule.Users = appendUnique(rule.Users, name)
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Match returns, in document order, the rules with owners that are the last
+// matching rule for at least one of the paths.
+func Match(rules []Rule, paths []string) []Rule {
+	matched := make([]bool, len(rules))
+	for _, path := range paths {
+		path = normalize(path)
+		if path == "" {
+			continue
+		}
+		for i := len(rules) - 1; i >= 0; i-- {
+			if rules[i].re.MatchString(path) {
+				matched[i] = true
+				break
+			}
+		}
+	}
+
+	var res []Rule
+	for i, rule := range rules {
+		if matched[i] && rule.HasOwners() {
+			res = append(res, rule)
+		}
+	}
+	return res
+}
+
+func normalize(path string) string {
+	path = strings.TrimSpace(path)
+	path = strings.TrimPrefix(path, "./")
+	return strings.TrimPrefix(path, "/")
+}
+
+// compile turns a gitignore-style pattern into a regexp over slash-separated
+// paths relative to the repository root. A pattern without a slash matches at
+// any depth; a pattern matching a directory matches everything under it.
+func compile(pattern string) (*regexp.Regexp, error) {
+	dirOnly := strings.HasSuffix(pattern, "/")
+	p := strings.TrimSuffix(pattern, "/")
+	anchored := strings.HasPrefix(p, "/") || strings.Contains(strings.TrimPrefix(p, "/"), "/")
+	p = strings.TrimPrefix(p, "/")
+
+	var b strings.Builder
+	if anchored {
+		b.WriteString("^")
+	} else {
+		b.WriteString("^(?:.*/)?")
+	}
+
+	for i := 0; i < len(p); i++ {
+		switch {
+		case strings.HasPrefix(p[i:], "**/"):
+			b.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(p[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case p[i] == '*':
+			b.WriteString("[^/]*")
+		case p[i] == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(p[i])))
+		}
+	}
+
+	if dirOnly {
+		b.WriteString("/.*$")
+	} else {
+		b.WriteString("(?:/.*)?$")
+	}
+
+	return regexp.Compile(b.String())
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, v := range values {
+		if v == value {
+			return values
+		}
+	}
+	return append(values, value)
+}
@@ -0,0 +1,113 @@
+package codeowners
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func patterns(rules []Rule) []string {
+	res := make([]string, len(rules))
+	for i, r := range rules {
+		res[i] = r.Pattern
+	}
+	return res
+}
+
+func TestParse(t *testing.T) {
+	rules, err := Parse(`
+# default owners
+*            @acme/backend
+
+/payments/   @alice @bob @alice   # duplicates are dropped
+docs/**/*.md @acme/docs
+/generated/
+`)
+	require.NoError(t, err)
+	require.Len(t, rules, 4)
+
+	assert.Equal(t, Rule{Line: 3, Pattern: "*", Teams: []string{"backend"}}, withoutRegexp(rules[0]))
+	assert.Equal(t, Rule{Line: 5, Pattern: "/payments/", Users: []string{"alice", "bob"}}, withoutRegexp(rules[1]))
+	assert.Equal(t, []string{"docs"}, rules[2].Teams)
+	assert.False(t, rules[3].HasOwners())
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+		line     int
+	}{
+		{name: "email owner", document: "*.go alice@example.com", line: 1},
+		{name: "empty team", document: "\n*.go @acme/", line: 2},
+		{name: "section", document: "[Payments]\n/payments/ @alice", line: 1},
+		{name: "negation", document: "!*.md @alice", line: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.document)
+			var parseErr *ParseError
+			require.ErrorAs(t, err, &parseErr)
+			assert.Equal(t, tt.line, parseErr.Line)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	rules, err := Parse(`
+*                @acme/backend
+*.md             @writer
+/payments/       @alice
+payments/api/**  @bob
+/generated/
+build            @ci
+`)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		paths []string
+		want  []string
+	}{
+		{name: "catch-all", paths: []string{"cmd/main.go"}, want: []string{"*"}},
+		{name: "extension at any depth", paths: []string{"docs/guide/intro.md"}, want: []string{"*.md"}},
+		{name: "anchored directory", paths: []string{"payments/ledger.go"}, want: []string{"/payments/"}},
+		{name: "anchored directory is not matched deeper", paths: []string{"internal/payments/ledger.go"}, want: []string{"*"}},
+		{name: "double star", paths: []string{"payments/api/v1/handler.go"}, want: []string{"payments/api/**"}},
+		{name: "last match wins", paths: []string{"payments/README.md"}, want: []string{"/payments/"}},
+		{name: "rule without owners", paths: []string{"generated/api.go"}, want: nil},
+		{name: "unanchored name matches directory", paths: []string{"tools/build/run.sh"}, want: []string{"build"}},
+		{name: "leading slash and dot are ignored", paths: []string{"/payments/a.go", "./README.md"}, want: []string{"*.md", "/payments/"}},
+		{
+			name:  "rules come back in document order once",
+			paths: []string{"payments/b.go", "cmd/main.go", "payments/a.go"},
+			want:  []string{"*", "/payments/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched := Match(rules, tt.paths)
+			if tt.want == nil {
+				assert.Empty(t, matched)
+				return
+			}
+			assert.Equal(t, tt.want, patterns(matched))
+		})
+	}
+}
+
+func TestRule_Owns(t *testing.T) {
+	rule := Rule{Users: []string{"alice"}, Teams: []string{"payments"}}
+
+	assert.True(t, rule.Owns("alice", "backend"))
+	assert.True(t, rule.Owns("carol", "payments"))
+	assert.False(t, rule.Owns("bob", "backend"))
+}
+
+func withoutRegexp(r Rule) Rule {
+	r.re = nil
+	return r
+}
@@ -0,0 +1,25 @@
+package converter
+
+import (
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+func ToOpenAPICodeOwners(doc models.CodeOwners, rules []codeowners.Rule) generated.CodeOwners {
+	res := generated.CodeOwners{
+		TeamName:  doc.TeamName,
+		Document:  doc.Document,
+		UpdatedAt: doc.UpdatedAt,
+		Rules:     make([]generated.CodeOwnerRule, len(rules)),
+	}
+	for i, r := range rules {
+		res.Rules[i] = generated.CodeOwnerRule{
+			Line:    r.Line,
+			Pattern: r.Pattern,
+			Users:   append([]string{}, r.Users...),
+			Teams:   append([]string{}, r.Teams...),
+		}
+	}
+	return res
+}
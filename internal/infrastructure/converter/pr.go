@@ -3,6 +3,7 @@ package converter
 import (
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
 )
 
 func ToOpenAPIPullRequest(pr models.PullRequest) *generated.PullRequest {
@@ -21,6 +22,73 @@ func ToOpenAPIPullRequest(pr models.PullRequest) *generated.PullRequest {
 	if pr.MergedAt != nil {
 		res.MergedAt = pr.MergedAt
 	}
+
+	if pr.MergedBy != nil {
+		res.MergedBy = pr.MergedBy
+	}
+
+	if pr.ClosedAt != nil {
+		res.ClosedAt = pr.ClosedAt
+	}
+
+	if len(pr.Assignments) > 0 {
+		assignments := make([]generated.ReviewerAssignment, len(pr.Assignments))
+		for i, a := range pr.Assignments {
+			assignments[i] = generated.ReviewerAssignment{
+				UserId:      a.ReviewerID,
+				SourceTeam:  a.SourceTeam,
+				ReviewedAt:  a.ReviewedAt,
+				AssignedAt:  a.AssignedAt,
+				EscalatedAt: a.EscalatedAt,
+			}
+			if a.ReviewState != "" {
+				state := generated.ReviewState(a.ReviewState)
+				assignments[i].ReviewState = &state
+			}
+			if len(a.MatchedSkills) > 0 {
+				assignments[i].MatchedSkills = ptr.To(a.MatchedSkills)
+			}
+		}
+		res.ReviewerAssignments = &assignments
+	}
+	return res
+}
+
+func ToOpenAPIReviewEvents(events []models.ReviewEvent) []generated.ReviewEvent {
+	res := make([]generated.ReviewEvent, len(events))
+	for i, e := range events {
+		res[i] = generated.ReviewEvent{
+			ReviewerId: e.ReviewerID,
+			State:      generated.ReviewState(e.State),
+			CreatedAt:  e.CreatedAt,
+		}
+		if e.Comment != "" {
+			comment := e.Comment
+			res[i].Comment = &comment
+		}
+	}
+	return res
+}
+
+func ToOpenAPIAssignmentEvents(events []models.AssignmentEvent) []generated.AssignmentEvent {
+	res := make([]generated.AssignmentEvent, len(events))
+	for i, e := range events {
+		res[i] = generated.AssignmentEvent{
+			PullRequestId: e.PullRequestID,
+			EventType:     generated.AssignmentEventType(e.Type),
+			Reason:        e.Reason,
+			CreatedAt:     e.CreatedAt,
+		}
+		if e.OldReviewerID != "" {
+			res[i].OldReviewerId = ptr.To(e.OldReviewerID)
+		}
+		if e.NewReviewerID != "" {
+			res[i].NewReviewerId = ptr.To(e.NewReviewerID)
+		}
+		if e.Actor != "" {
+			res[i].Actor = ptr.To(e.Actor)
+		}
+	}
 	return res
 }
 
@@ -34,7 +102,7 @@ func ToOpenAPIPullRequestShort(pr models.PullRequest) *generated.PullRequestShor
 }
 
 func FromOpenAPIPullRequestCreate(pr *generated.PostPullRequestCreateJSONBody, status generated.PullRequestStatus) *models.PullRequest {
-	return &models.PullRequest{
+	res := &models.PullRequest{
 		ID:       pr.PullRequestId,
 		Name:     pr.PullRequestName,
 		AuthorID: pr.AuthorId,
@@ -42,14 +110,35 @@ func FromOpenAPIPullRequestCreate(pr *generated.PostPullRequestCreateJSONBody, s
 			Name: string(status),
 		},
 	}
+	if pr.ChangedPaths != nil {
+		res.ChangedPaths = *pr.ChangedPaths
+	}
+	if pr.RequiredSkills != nil {
+		res.RequiredSkills = *pr.RequiredSkills
+	}
+	if pr.SkillMode != nil {
+		res.SkillMode = string(*pr.SkillMode)
+	}
+	if pr.RequestedReviewers != nil {
+		res.RequestedReviewers = *pr.RequestedReviewers
+	}
+	return res
 }
 
 func ToStatusEnum(name string) generated.PullRequestStatus {
 	switch name {
-	case "MERGED":
-		return generated.PullRequestStatusMERGED
+	case "DRAFT":
+		return generated.PullRequestStatusDRAFT
 	case "OPEN":
 		return generated.PullRequestStatusOPEN
+	case "READY_FOR_REVIEW":
+		return generated.PullRequestStatusREADYFORREVIEW
+	case "REOPENED":
+		return generated.PullRequestStatusREOPENED
+	case "CLOSED":
+		return generated.PullRequestStatusCLOSED
+	case "MERGED":
+		return generated.PullRequestStatusMERGED
 	default:
 		return ""
 	}
@@ -57,6 +146,14 @@ func ToStatusEnum(name string) generated.PullRequestStatus {
 
 func ToShortStatusEnum(name string) generated.PullRequestShortStatus {
 	switch name {
+	case "DRAFT":
+		return generated.PullRequestShortStatusDRAFT
+	case "READY_FOR_REVIEW":
+		return generated.PullRequestShortStatusREADYFORREVIEW
+	case "REOPENED":
+		return generated.PullRequestShortStatusREOPENED
+	case "CLOSED":
+		return generated.PullRequestShortStatusCLOSED
 	case "MERGED":
 		return generated.PullRequestShortStatusMERGED
 	case "OPEN":
@@ -65,3 +162,21 @@ func ToShortStatusEnum(name string) generated.PullRequestShortStatus {
 		return generated.PullRequestShortStatusOPEN
 	}
 }
+
+func ToOpenAPIOverdueReviews(reviews []models.OverdueReview) []generated.OverdueReview {
+	res := make([]generated.OverdueReview, len(reviews))
+	for i, r := range reviews {
+		res[i] = generated.OverdueReview{
+			PullRequestId:   r.PullRequestID,
+			PullRequestName: r.PullRequestName,
+			AuthorId:        r.AuthorID,
+			TeamName:        r.TeamName,
+			ReviewerId:      r.ReviewerID,
+			AssignedAt:      r.AssignedAt,
+			DueAt:           r.DueAt,
+			SlaHours:        r.SLAHours,
+			EscalatedAt:     r.EscalatedAt,
+		}
+	}
+	return res
+}
@@ -3,6 +3,7 @@ package converter
 import (
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
 )
 
 func ToOpenAPITeam(team models.Team) generated.Team {
@@ -15,9 +16,56 @@ func ToOpenAPITeam(team models.Team) generated.Team {
 		}
 	}
 	return generated.Team{
-		Members:  members,
-		TeamName: team.TeamName,
+		Members:    members,
+		TeamName:   team.TeamName,
+		Settings:   ToOpenAPITeamSettings(team),
+		ArchivedAt: team.ArchivedAt,
+	}
+}
+
+func ToOpenAPITeamEvents(events []models.TeamEvent) []generated.TeamEvent {
+	res := make([]generated.TeamEvent, len(events))
+	for i, e := range events {
+		res[i] = generated.TeamEvent{
+			EventId:   e.ID,
+			TeamName:  e.TeamName,
+			EventType: generated.TeamEventEventType(e.Type),
+			CreatedAt: e.CreatedAt,
+		}
+		if e.UserID != "" {
+			res[i].UserId = ptr.To(e.UserID)
+		}
+		if e.OldValue != "" {
+			res[i].OldValue = ptr.To(e.OldValue)
+		}
+		if e.NewValue != "" {
+			res[i].NewValue = ptr.To(e.NewValue)
+		}
+		if e.Actor != "" {
+			res[i].Actor = ptr.To(e.Actor)
+		}
+	}
+	return res
+}
+
+func ToOpenAPITeamSettings(team models.Team) *generated.TeamSettings {
+	if team.AssignmentStrategy == "" {
+		return nil
 	}
+
+	strategy := generated.AssignmentStrategy(team.AssignmentStrategy)
+	settings := &generated.TeamSettings{
+		AssignmentStrategy: &strategy,
+		RequiredReviewers:  &team.RequiredReviewers,
+		MaxReviewers:       &team.MaxReviewers,
+		RequiredApprovals:  &team.RequiredApprovals,
+		MaxOpenReviews:     &team.MaxOpenReviews,
+		ReviewSlaHours:     &team.ReviewSLAHours,
+	}
+	if len(team.FallbackTeams) > 0 {
+		settings.FallbackTeams = &team.FallbackTeams
+	}
+	return settings
 }
 
 func ToModelTeam(team generated.Team) *models.Team {
@@ -31,8 +79,33 @@ func ToModelTeam(team generated.Team) *models.Team {
 		}
 	}
 
-	return &models.Team{
+	res := &models.Team{
 		TeamName: team.TeamName,
 		Members:  users,
 	}
+
+	if team.Settings != nil && team.Settings.FallbackTeams != nil {
+		res.FallbackTeams = *team.Settings.FallbackTeams
+	}
+
+	return res
+}
+
+func ToModelTeamSettings(settings *generated.TeamSettings) models.TeamSettings {
+	if settings == nil {
+		return models.TeamSettings{}
+	}
+
+	res := models.TeamSettings{
+		RequiredReviewers: settings.RequiredReviewers,
+		MaxReviewers:      settings.MaxReviewers,
+		RequiredApprovals: settings.RequiredApprovals,
+		MaxOpenReviews:    settings.MaxOpenReviews,
+		ReviewSLAHours:    settings.ReviewSlaHours,
+	}
+	if settings.AssignmentStrategy != nil {
+		strategy := string(*settings.AssignmentStrategy)
+		res.AssignmentStrategy = &strategy
+	}
+	return res
 }
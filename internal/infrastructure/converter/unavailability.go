@@ -0,0 +1,26 @@
+package converter
+
+import (
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+func ToOpenAPIUnavailability(u models.Unavailability) generated.Unavailability {
+	return generated.Unavailability{
+		UnavailabilityId: u.ID,
+		UserId:           u.UserID,
+		StartsAt:         u.StartsAt,
+		EndsAt:           u.EndsAt,
+		Reason:           u.Reason,
+		ReassignReviews:  u.ReassignReviews,
+		ReassignedAt:     u.ReassignedAt,
+	}
+}
+
+func ToOpenAPIUnavailabilityList(periods []models.Unavailability) []generated.Unavailability {
+	res := make([]generated.Unavailability, len(periods))
+	for i, u := range periods {
+		res[i] = ToOpenAPIUnavailability(u)
+	}
+	return res
+}
@@ -7,9 +7,17 @@ import (
 
 func ToUser(user models.User) generated.User {
 	return generated.User{
-		UserId:   user.ID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		UserId:         user.ID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		MaxOpenReviews: user.MaxOpenReviews,
+	}
+}
+
+func ToOpenAPIUserSkills(userID string, skills []string) generated.UserSkills {
+	return generated.UserSkills{
+		UserId: userID,
+		Skills: append([]string{}, skills...),
 	}
 }
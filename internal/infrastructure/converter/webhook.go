@@ -0,0 +1,31 @@
+package converter
+
+import (
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+)
+
+func ToOpenAPIWebhookSubscription(sub models.WebhookSubscription) generated.WebhookSubscription {
+	res := generated.WebhookSubscription{
+		SubscriptionId: sub.ID,
+		Url:            sub.URL,
+		EventTypes:     make([]generated.AssignmentEventType, len(sub.EventTypes)),
+		CreatedAt:      sub.CreatedAt,
+	}
+	for i, eventType := range sub.EventTypes {
+		res.EventTypes[i] = generated.AssignmentEventType(eventType)
+	}
+	if sub.TeamName != "" {
+		res.TeamName = ptr.To(sub.TeamName)
+	}
+	return res
+}
+
+func ToOpenAPIWebhookSubscriptions(subs []models.WebhookSubscription) []generated.WebhookSubscription {
+	res := make([]generated.WebhookSubscription, len(subs))
+	for i, sub := range subs {
+		res[i] = ToOpenAPIWebhookSubscription(sub)
+	}
+	return res
+}
@@ -0,0 +1,116 @@
+// Package metrics holds the service's Prometheus collectors. They are
+// registered in Registry rather than the global default registry, so /metrics
+// exposes only what the service records and tests can scrape it in-process.
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "reviewer"
+
+// Reassignment reasons.
+const (
+	ReasonManual         = "manual"
+	ReasonDeactivation   = "deactivation"
+	ReasonUnavailability = "unavailability"
+	ReasonTeamChange     = "team_change"
+	ReasonOverdue        = "overdue"
+)
+
+// Operations rejected with NO_CANDIDATE.
+const (
+	OperationCreatePR  = "create_pr"
+	OperationSetStatus = "set_status"
+	OperationReassign  = "reassign"
+)
+
+var Registry = prometheus.NewRegistry()
+
+var (
+	HTTPRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests by OpenAPI operation and response status.",
+	}, []string{"method", "route", "status"})
+
+	HTTPRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by OpenAPI operation and response status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	PullRequestsCreated = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "pull_requests_created_total",
+		Help:      "Pull requests created.",
+	})
+
+	Reassignments = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "reassignments_total",
+		Help:      "Reviewers replaced on pull requests by reason.",
+	}, []string{"reason"})
+
+	NoCandidate = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "no_candidate_total",
+		Help:      "Requests rejected with NO_CANDIDATE by operation.",
+	}, []string{"operation"})
+
+	BulkDeactivations = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "bulk_deactivations_total",
+		Help:      "Bulk deactivations that had active users to deactivate.",
+	})
+
+	DeactivatedUsers = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deactivated_users_total",
+		Help:      "Users deactivated by bulk deactivation.",
+	})
+
+	OverdueEscalations = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "overdue_escalations_total",
+		Help:      "Review assignments escalated for missing the team's review SLA by action.",
+	}, []string{"action"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		HTTPRequests,
+		HTTPRequestDuration,
+		PullRequestsCreated,
+		Reassignments,
+		NoCandidate,
+		BulkDeactivations,
+		DeactivatedUsers,
+		OverdueEscalations,
+	)
+}
+
+// RegisterDB exposes the connection pool statistics of db (sql.DB.Stats) as
+// go_sql_* metrics labelled with dbName. Registering the same name twice is a
+// no-op.
+func RegisterDB(db *sql.DB, dbName string) error {
+	err := Registry.Register(collectors.NewDBStatsCollector(db, dbName))
+	if are := (prometheus.AlreadyRegisteredError{}); errors.As(err, &are) {
+		return nil
+	}
+	return err
+}
+
+// Handler serves Registry in the Prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+const (
+	AssignmentEventAssign               = "ASSIGN"
+	AssignmentEventUnassign             = "UNASSIGN"
+	AssignmentEventReassign             = "REASSIGN"
+	AssignmentEventDeactivationReassign = "DEACTIVATION_REASSIGN"
+	AssignmentEventMerge                = "MERGE"
+)
+
+// AssignmentEvent is an append-only record of a reviewer assignment change.
+// Empty OldReviewerID, NewReviewerID and Actor are stored as NULL; an empty
+// Actor means the change was made by the service itself.
+type AssignmentEvent struct {
+	ID            int64     `db:"event_id"`
+	PullRequestID string    `db:"pr_id"`
+	Type          string    `db:"event_type"`
+	OldReviewerID string    `db:"old_reviewer_id"`
+	NewReviewerID string    `db:"new_reviewer_id"`
+	Actor         string    `db:"actor"`
+	Reason        string    `db:"reason"`
+	CreatedAt     time.Time `db:"created_at"`
+}
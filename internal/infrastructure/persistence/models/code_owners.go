@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+// CodeOwners is the CODEOWNERS document of a team. A team without a stored
+// document has an empty one.
+type CodeOwners struct {
+	TeamName  string     `db:"team_name"`
+	Document  string     `db:"document"`
+	UpdatedAt *time.Time `db:"updated_at"`
+}
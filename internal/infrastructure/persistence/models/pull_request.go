@@ -2,17 +2,52 @@ package models
 
 import "time"
 
+const (
+	StatusDraft          = "DRAFT"
+	StatusOpen           = "OPEN"
+	StatusReadyForReview = "READY_FOR_REVIEW"
+	StatusReopened       = "REOPENED"
+	StatusClosed         = "CLOSED"
+	StatusMerged         = "MERGED"
+)
+
+// ReviewableStatuses are the statuses in which assigned reviewers are expected
+// to act: only such PRs count towards review load and get reviewers topped up.
+var ReviewableStatuses = []string{StatusOpen, StatusReadyForReview, StatusReopened}
+
 type PullRequest struct {
-	ID        string     `db:"pr_id"`
-	Name      string     `db:"pr_name"`
-	AuthorID  string     `db:"author_id"`
-	StatusID  int64      `db:"status_id"`
-	Status    *Status    `db:"-"`
-	CreatedAt *time.Time `db:"created_at"`
-	MergedAt  *time.Time `db:"merged_at"`
-	Reviewers []string   `db:"-"`
+	ID          string     `db:"pr_id"`
+	Name        string     `db:"pr_name"`
+	AuthorID    string     `db:"author_id"`
+	StatusID    int64      `db:"status_id"`
+	Status      *Status    `db:"-"`
+	CreatedAt   *time.Time `db:"created_at"`
+	MergedAt    *time.Time `db:"merged_at"`
+	MergedBy    *string    `db:"merged_by"`
+	ClosedAt    *time.Time `db:"closed_at"`
+	Reviewers   []string   `db:"-"`
+	Assignments []Reviewer `db:"-"`
+	// ChangedPaths are the files touched by the PR, used to pick code owners
+	// whenever reviewers are assigned. They are stored in a separate table.
+	ChangedPaths []string `db:"-"`
+	// RequiredSkills are the skills the reviewers should cover, see
+	// SkillMode. They are stored in a separate table.
+	RequiredSkills []string `db:"-"`
+	SkillMode      string   `db:"skill_mode"`
+	// RequestedReviewers are the reviewers picked by the author, assigned
+	// before the automatically selected ones. They are not stored.
+	RequestedReviewers []string `db:"-"`
 }
 
+const (
+	// SkillModePrefer picks reviewers covering the required skills when
+	// available and fills the remaining slots as usual.
+	SkillModePrefer = "prefer"
+	// SkillModeRequire fails PR creation, or moving a draft into review, when
+	// a required skill cannot be covered.
+	SkillModeRequire = "require"
+)
+
 type Status struct {
 	ID   int64  `db:"status_id"`
 	Name string `db:"status_name"`
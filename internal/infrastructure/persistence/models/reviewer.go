@@ -1,6 +1,57 @@
 package models
 
+import "time"
+
+const (
+	ReviewStatePending          = "PENDING"
+	ReviewStateApproved         = "APPROVED"
+	ReviewStateChangesRequested = "CHANGES_REQUESTED"
+	ReviewStateCommented        = "COMMENTED"
+)
+
 type Reviewer struct {
-	PullRequestID string `db:"pr_id"`
-	ReviewerID    string `db:"reviewer_id"`
+	PullRequestID string     `db:"pr_id"`
+	ReviewerID    string     `db:"reviewer_id"`
+	SourceTeam    string     `db:"source_team"`
+	ReviewState   string     `db:"review_state"`
+	ReviewedAt    *time.Time `db:"reviewed_at"`
+	AssignedAt    *time.Time `db:"assigned_at"`
+	// EscalatedAt is set once the assignment has been flagged as overdue.
+	EscalatedAt *time.Time `db:"escalated_at"`
+	// MatchedSkills are the skills required by the PR that the reviewer has.
+	// They are reported when reviewers are picked and not stored.
+	MatchedSkills []string `db:"-"`
+}
+
+type ReviewEvent struct {
+	ID            int64     `db:"event_id"`
+	PullRequestID string    `db:"pr_id"`
+	ReviewerID    string    `db:"reviewer_id"`
+	State         string    `db:"review_state"`
+	Comment       string    `db:"comment"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+type Candidate struct {
+	UserID      string `db:"user_id"`
+	TeamName    string `db:"team_name"`
+	OpenReviews int    `db:"open_reviews"`
+	// MaxOpenReviews is the effective limit of the candidate, 0 means no
+	// limit.
+	MaxOpenReviews int      `db:"max_open_reviews"`
+	Skills         []string `db:"-"`
+}
+
+// OverdueReview is a pending assignment held longer than the review SLA of the
+// author's team.
+type OverdueReview struct {
+	PullRequestID   string     `db:"pr_id"`
+	PullRequestName string     `db:"pr_name"`
+	AuthorID        string     `db:"author_id"`
+	TeamName        string     `db:"team_name"`
+	ReviewerID      string     `db:"reviewer_id"`
+	AssignedAt      time.Time  `db:"assigned_at"`
+	DueAt           time.Time  `db:"due_at"`
+	SLAHours        int        `db:"review_sla_hours"`
+	EscalatedAt     *time.Time `db:"escalated_at"`
 }
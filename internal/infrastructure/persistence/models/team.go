@@ -1,6 +1,31 @@
 package models
 
+import "time"
+
 type Team struct {
-	TeamName string `db:"team_name" json:"team_name"`
-	Members  []User `db:"-" json:"members"`
+	TeamName           string `db:"team_name" json:"team_name"`
+	AssignmentStrategy string `db:"assignment_strategy" json:"assignment_strategy"`
+	RequiredReviewers  int    `db:"required_reviewers" json:"required_reviewers"`
+	MaxReviewers       int    `db:"max_reviewers" json:"max_reviewers"`
+	RequiredApprovals  int    `db:"required_approvals" json:"required_approvals"`
+	// MaxOpenReviews is the default limit of open reviews per member, 0 means
+	// no limit.
+	MaxOpenReviews int `db:"max_open_reviews" json:"max_open_reviews"`
+	// ReviewSLAHours is how long a reviewer may hold a pending assignment on
+	// the team's pull requests, 0 means no SLA.
+	ReviewSLAHours int        `db:"review_sla_hours" json:"review_sla_hours"`
+	ArchivedAt     *time.Time `db:"archived_at" json:"archived_at"`
+	FallbackTeams  []string   `db:"-" json:"fallback_teams"`
+	Members        []User     `db:"-" json:"members"`
+}
+
+// TeamSettings are the settings given when a team is created, nil fields keep
+// the column defaults.
+type TeamSettings struct {
+	AssignmentStrategy *string
+	RequiredReviewers  *int
+	MaxReviewers       *int
+	RequiredApprovals  *int
+	MaxOpenReviews     *int
+	ReviewSLAHours     *int
 }
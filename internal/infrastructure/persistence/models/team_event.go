@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+const (
+	TeamEventRenamed      = "RENAMED"
+	TeamEventArchived     = "ARCHIVED"
+	TeamEventMemberJoined = "MEMBER_JOINED"
+	TeamEventMemberLeft   = "MEMBER_LEFT"
+)
+
+// TeamEvent is an append-only record of a team change. RENAMED keeps the
+// previous name in OldValue, MEMBER_JOINED the team the user came from in
+// OldValue and MEMBER_LEFT the team the user moved to in NewValue. Empty
+// fields are stored as NULL.
+type TeamEvent struct {
+	ID        int64     `db:"event_id"`
+	TeamName  string    `db:"team_name"`
+	Type      string    `db:"event_type"`
+	UserID    string    `db:"user_id"`
+	OldValue  string    `db:"old_value"`
+	NewValue  string    `db:"new_value"`
+	Actor     string    `db:"actor"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Unavailability is a period [StartsAt, EndsAt) during which a user is not
+// picked as a reviewer. With ReassignReviews their open reviews are handed
+// over once the period starts; ReassignedAt records when that happened.
+type Unavailability struct {
+	ID              int64      `db:"unavailability_id"`
+	UserID          string     `db:"user_id"`
+	StartsAt        time.Time  `db:"starts_at"`
+	EndsAt          time.Time  `db:"ends_at"`
+	Reason          string     `db:"reason"`
+	ReassignReviews bool       `db:"reassign_reviews"`
+	ReassignedAt    *time.Time `db:"reassigned_at"`
+	CreatedAt       time.Time  `db:"created_at"`
+}
@@ -5,4 +5,7 @@ type User struct {
 	Username string `db:"username" json:"username"`
 	IsActive bool   `db:"is_active" json:"is_active"`
 	TeamName string `db:"team_name"`
+	// MaxOpenReviews overrides the team's limit of open reviews when set,
+	// 0 means no limit.
+	MaxOpenReviews *int `db:"max_open_reviews"`
 }
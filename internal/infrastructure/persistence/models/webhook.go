@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+// WebhookSubscription receives assignment events of one team (or of all teams
+// when TeamName is empty). Empty EventTypes means every event type.
+type WebhookSubscription struct {
+	ID         int64     `db:"subscription_id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	TeamName   string    `db:"team_name"`
+	EventTypes []string  `db:"-"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+// WebhookDelivery is a pending outbox entry joined with its subscription and event.
+type WebhookDelivery struct {
+	ID       int64  `db:"delivery_id"`
+	Attempts int    `db:"attempts"`
+	URL      string `db:"url"`
+	Secret   string `db:"secret"`
+	TeamName string `db:"author_team"`
+	AssignmentEvent
+}
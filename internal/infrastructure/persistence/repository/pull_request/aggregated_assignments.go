@@ -0,0 +1,78 @@
+package pull_request
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+// assignmentsAggregate selects all reviewer assignments of the outer pr row as
+// one JSON array, ordered like GetPullRequestAssignments. The timestamps are
+// converted to timestamptz so that they are encoded in RFC 3339.
+func (r *Repository) assignmentsAggregate() string {
+	return fmt.Sprintf(`(SELECT array_to_json(array_agg(json_build_object(
+		'pr_id', ra.pr_id,
+		'reviewer_id', ra.reviewer_id,
+		'source_team', COALESCE(ra.source_team, ''),
+		'review_state', ra.review_state,
+		'reviewed_at', ra.reviewed_at AT TIME ZONE 'UTC',
+		'assigned_at', ra.assigned_at AT TIME ZONE 'UTC',
+		'escalated_at', ra.escalated_at AT TIME ZONE 'UTC'
+	) ORDER BY ra.reviewer_id)) FROM %s ra WHERE ra.pr_id = %s.pr_id)`, r.reviewersTableName, alias)
+}
+
+// aggregatedAssignments scans the column produced by assignmentsAggregate.
+type aggregatedAssignments []models.Reviewer
+
+func (a *aggregatedAssignments) Scan(src any) error {
+	var data []byte
+	switch v := src.(type) {
+	case nil:
+		*a = []models.Reviewer{}
+		return nil
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return fmt.Errorf("unsupported assignments type %T", src)
+	}
+
+	var items []struct {
+		PullRequestID string     `json:"pr_id"`
+		ReviewerID    string     `json:"reviewer_id"`
+		SourceTeam    string     `json:"source_team"`
+		ReviewState   string     `json:"review_state"`
+		ReviewedAt    *time.Time `json:"reviewed_at"`
+		AssignedAt    *time.Time `json:"assigned_at"`
+		EscalatedAt   *time.Time `json:"escalated_at"`
+	}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+
+	reviewers := make([]models.Reviewer, len(items))
+	for i, item := range items {
+		reviewers[i] = models.Reviewer{
+			PullRequestID: item.PullRequestID,
+			ReviewerID:    item.ReviewerID,
+			SourceTeam:    item.SourceTeam,
+			ReviewState:   item.ReviewState,
+			ReviewedAt:    inUTC(item.ReviewedAt),
+			AssignedAt:    inUTC(item.AssignedAt),
+			EscalatedAt:   inUTC(item.EscalatedAt),
+		}
+	}
+	*a = reviewers
+	return nil
+}
+
+func inUTC(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
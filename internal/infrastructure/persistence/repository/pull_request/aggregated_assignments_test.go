@@ -0,0 +1,70 @@
+package pull_request
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregatedAssignments_Scan(t *testing.T) {
+	t.Run("no reviewers", func(t *testing.T) {
+		var a aggregatedAssignments
+		require.NoError(t, a.Scan(nil))
+		assert.Empty(t, a)
+		assert.NotNil(t, a)
+	})
+
+	t.Run("reviewers with and without review", func(t *testing.T) {
+		src := []byte(`[
+			{"pr_id":"pr-1","reviewer_id":"u1","source_team":"","review_state":"PENDING","reviewed_at":null},
+			{"pr_id":"pr-1","reviewer_id":"u2","source_team":"backend","review_state":"APPROVED","reviewed_at":"2025-10-24T12:30:00.123456+00:00","assigned_at":"2025-10-24T10:00:00+03:00","escalated_at":null}
+		]`)
+
+		var a aggregatedAssignments
+		require.NoError(t, a.Scan(src))
+		require.Len(t, a, 2)
+
+		assert.Equal(t, models.Reviewer{PullRequestID: "pr-1", ReviewerID: "u1", ReviewState: models.ReviewStatePending}, a[0])
+
+		reviewedAt := time.Date(2025, 10, 24, 12, 30, 0, 123456000, time.UTC)
+		assert.Equal(t, "backend", a[1].SourceTeam)
+		assert.Equal(t, models.ReviewStateApproved, a[1].ReviewState)
+		require.NotNil(t, a[1].ReviewedAt)
+		assert.Equal(t, reviewedAt, *a[1].ReviewedAt)
+		require.NotNil(t, a[1].AssignedAt)
+		assert.Equal(t, time.Date(2025, 10, 24, 7, 0, 0, 0, time.UTC), *a[1].AssignedAt)
+		assert.Nil(t, a[1].EscalatedAt)
+	})
+
+	t.Run("unsupported type", func(t *testing.T) {
+		var a aggregatedAssignments
+		assert.Error(t, a.Scan(42))
+	})
+}
+
+func TestStatusCache(t *testing.T) {
+	c := newStatusCache()
+	byName := func(name string) func(c *statusCache) (models.Status, bool) {
+		return func(c *statusCache) (models.Status, bool) {
+			s, ok := c.byName[name]
+			return s, ok
+		}
+	}
+
+	_, ok := c.get(byName(models.StatusOpen))
+	assert.False(t, ok)
+
+	c.set([]models.Status{{ID: 1, Name: models.StatusOpen}, {ID: 2, Name: models.StatusMerged}})
+
+	found, ok := c.get(byName(models.StatusMerged))
+	require.True(t, ok)
+	assert.Equal(t, models.Status{ID: 2, Name: models.StatusMerged}, *found)
+
+	found.Name = "CHANGED"
+	again, ok := c.get(byName(models.StatusMerged))
+	require.True(t, ok)
+	assert.Equal(t, models.StatusMerged, again.Name)
+}
@@ -0,0 +1,114 @@
+package pull_request
+
+import (
+	"context"
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+var assignmentEventFields = []string{
+	"event_id",
+	"pr_id",
+	"event_type",
+	"COALESCE(old_reviewer_id, '') AS old_reviewer_id",
+	"COALESCE(new_reviewer_id, '') AS new_reviewer_id",
+	"COALESCE(actor, '') AS actor",
+	"reason",
+	"created_at",
+}
+
+func (r *Repository) InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := st.
+		Insert(r.assignmentEventsTableName).
+		Columns("pr_id", "event_type", "old_reviewer_id", "new_reviewer_id", "actor", "reason")
+
+	for _, e := range events {
+		builder = builder.Values(
+			e.PullRequestID,
+			e.Type,
+			sq.Expr("NULLIF(?, '')", e.OldReviewerID),
+			sq.Expr("NULLIF(?, '')", e.NewReviewerID),
+			sq.Expr("NULLIF(?, '')", e.Actor),
+			e.Reason,
+		)
+	}
+
+	query, args, err := builder.Suffix("RETURNING event_id").ToSql()
+	if err != nil {
+		return err
+	}
+
+	var eventIDs []int64
+	if err := tx.SelectContext(ctx, &eventIDs, query, args...); err != nil {
+		return err
+	}
+
+	return r.enqueueWebhooks(ctx, tx, eventIDs)
+}
+
+// enqueueWebhooks puts the events into the webhook outbox for every subscription
+// matching the team of the PR author and the event type. It runs in the same
+// transaction as the events so that no notification is lost or sent for a
+// rolled back change.
+func (r *Repository) enqueueWebhooks(ctx context.Context, tx *sqlx.Tx, eventIDs []int64) error {
+	subscribed := st.
+		Select("s.subscription_id", "e.event_id").
+		From(fmt.Sprintf("%s e", r.assignmentEventsTableName)).
+		Join(fmt.Sprintf("%s pr ON pr.pr_id = e.pr_id", r.tableName)).
+		Join(fmt.Sprintf("%s u ON u.user_id = pr.author_id", r.usersTableName)).
+		Join("webhook_subscriptions s ON s.team_name IS NULL OR s.team_name = u.team_name").
+		Where(sq.Eq{"e.event_id": eventIDs}).
+		Where(`(NOT EXISTS (SELECT 1 FROM webhook_subscription_events f WHERE f.subscription_id = s.subscription_id)
+			OR EXISTS (SELECT 1 FROM webhook_subscription_events f WHERE f.subscription_id = s.subscription_id AND f.event_type = e.event_type))`)
+
+	query, args, err := st.
+		Insert("webhook_outbox").
+		Columns("subscription_id", "event_id").
+		Select(subscribed).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *Repository) GetAssignmentEventsByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error) {
+	return r.findAssignmentEvents(ctx, sq.Eq{"pr_id": prID})
+}
+
+// GetAssignmentEventsByUser returns events where the user was assigned, unassigned
+// or acted on an assignment.
+func (r *Repository) GetAssignmentEventsByUser(ctx context.Context, userID string) ([]models.AssignmentEvent, error) {
+	return r.findAssignmentEvents(ctx, sq.Or{
+		sq.Eq{"old_reviewer_id": userID},
+		sq.Eq{"new_reviewer_id": userID},
+		sq.Eq{"actor": userID},
+	})
+}
+
+func (r *Repository) findAssignmentEvents(ctx context.Context, pred sq.Sqlizer) ([]models.AssignmentEvent, error) {
+	query, args, err := st.
+		Select(assignmentEventFields...).
+		From(r.assignmentEventsTableName).
+		Where(pred).
+		OrderBy("event_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.AssignmentEvent, 0)
+	if err := r.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
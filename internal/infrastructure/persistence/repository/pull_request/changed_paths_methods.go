@@ -0,0 +1,50 @@
+package pull_request
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// InsertChangedPaths stores the files touched by the PR, duplicates are
+// stored once.
+func (r *Repository) InsertChangedPaths(ctx context.Context, tx *sqlx.Tx, prID string, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	builder := st.
+		Insert(r.changedPathsTableName).
+		Columns("pr_id", "path")
+	for _, path := range paths {
+		builder = builder.Values(prID, path)
+	}
+
+	query, args, err := builder.Suffix("ON CONFLICT DO NOTHING").ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// GetChangedPaths returns the files touched by the PR in name order.
+func (r *Repository) GetChangedPaths(ctx context.Context, prID string) ([]string, error) {
+	query, args, err := st.
+		Select("path").
+		From(r.changedPathsTableName).
+		Where(sq.Eq{"pr_id": prID}).
+		OrderBy("path ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	if err := r.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -6,6 +6,7 @@ var (
 		"pr_name",
 		"author_id",
 		"status_id",
+		"skill_mode",
 	}
 
 	readableColumns = []string{
@@ -15,5 +16,8 @@ var (
 		"status_id",
 		"created_at",
 		"merged_at",
+		"merged_by",
+		"closed_at",
+		"skill_mode",
 	}
 )
@@ -13,26 +13,50 @@ type pullRequestRepository interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
 
 	InsertPullRequest(ctx context.Context, tx *sqlx.Tx, pr *models.PullRequest) (models.PullRequest, error)
-	SetPullRequestStatus(ctx context.Context, prID string, statusName string) error
-	UpdatePullRequest(ctx context.Context, spec UpdateSpecification) error
+	SetPullRequestStatus(ctx context.Context, tx *sqlx.Tx, prID string, statusName string) error
+	UpdatePullRequest(ctx context.Context, tx *sqlx.Tx, spec UpdateSpecification) error
 	GetPRByID(ctx context.Context, prID string) (models.PullRequest, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+	MarkMerged(ctx context.Context, tx *sqlx.Tx, prID string, mergedBy string) error
 	PullRequestExists(ctx context.Context, prID string) (bool, error)
+	InsertChangedPaths(ctx context.Context, tx *sqlx.Tx, prID string, paths []string) error
+	GetChangedPaths(ctx context.Context, prID string) ([]string, error)
+	InsertRequiredSkills(ctx context.Context, tx *sqlx.Tx, prID string, tags []string) error
+	GetRequiredSkills(ctx context.Context, prID string) ([]string, error)
+
+	ListPullRequests(ctx context.Context, spec FindSpecification) ([]models.PullRequest, error)
 
 	FindStatus(ctx context.Context, spec FindSpecification) (*models.Status, error)
 
-	InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []string) error
-	ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID, newReviewerID string) error
-	GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string, limit int) ([]string, error)
+	InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []models.Reviewer) error
+	ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID string, newReviewer models.Reviewer) error
+	GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
+	GetReviewersAtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
 	GetPullRequestReviewers(ctx context.Context, prID string) ([]string, error)
+	GetPullRequestAssignments(ctx context.Context, prID string) ([]models.Reviewer, error)
+
+	SetReviewState(ctx context.Context, tx *sqlx.Tx, prID, reviewerID, state string) error
+	InsertReviewEvent(ctx context.Context, tx *sqlx.Tx, event models.ReviewEvent) error
+	GetReviewEvents(ctx context.Context, prID string) ([]models.ReviewEvent, error)
+
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+	GetAssignmentEventsByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error)
+	GetAssignmentEventsByUser(ctx context.Context, userID string) ([]models.AssignmentEvent, error)
 
 	GetOpenPRsWithReviewers(ctx context.Context, reviewerIDs []string) (map[string][]string, error)
 	GetOpenPRsWithFullInfo(ctx context.Context, deactivatedReviewerIDs []string) (map[string]PRFullInfo, error)
 	BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, reassignments []PRReassignments) error
+	GetUnderstaffedOpenPRs(ctx context.Context, teamName string) ([]UnderstaffedPR, error)
+	GetOverdueReviews(ctx context.Context, teamName string, unescalated bool) ([]models.OverdueReview, error)
+	MarkReviewEscalated(ctx context.Context, tx *sqlx.Tx, prID, reviewerID string) (bool, error)
 
-	GetStatistics(ctx context.Context) (*Statistics, error)
+	GetStatistics(ctx context.Context, filter StatisticsFilter) (*Statistics, error)
 }
 
+// PRReassignments maps the replaced reviewers of a PR to their replacements;
+// Removals are reviewers taken off the PR without a replacement.
 type PRReassignments struct {
 	PRID          string
-	Reassignments map[string]string
+	Reassignments map[string]models.Reviewer
+	Removals      []string
 }
@@ -43,6 +43,20 @@ func (m *MockpullRequestRepository) EXPECT() *MockpullRequestRepositoryMockRecor
 	return m.recorder
 }
 
+// BulkReassignReviewers mocks base method.
+func (m *MockpullRequestRepository) BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, reassignments []pull_request.PRReassignments) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkReassignReviewers", ctx, tx, reassignments)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BulkReassignReviewers indicates an expected call of BulkReassignReviewers.
+func (mr *MockpullRequestRepositoryMockRecorder) BulkReassignReviewers(ctx, tx, reassignments any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkReassignReviewers", reflect.TypeOf((*MockpullRequestRepository)(nil).BulkReassignReviewers), ctx, tx, reassignments)
+}
+
 // FindStatus mocks base method.
 func (m *MockpullRequestRepository) FindStatus(ctx context.Context, spec pull_request.FindSpecification) (*models.Status, error) {
 	m.ctrl.T.Helper()
@@ -58,19 +72,109 @@ func (mr *MockpullRequestRepositoryMockRecorder) FindStatus(ctx, spec any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStatus", reflect.TypeOf((*MockpullRequestRepository)(nil).FindStatus), ctx, spec)
 }
 
+// GetAssignmentEventsByPR mocks base method.
+func (m *MockpullRequestRepository) GetAssignmentEventsByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentEventsByPR", ctx, prID)
+	ret0, _ := ret[0].([]models.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentEventsByPR indicates an expected call of GetAssignmentEventsByPR.
+func (mr *MockpullRequestRepositoryMockRecorder) GetAssignmentEventsByPR(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentEventsByPR", reflect.TypeOf((*MockpullRequestRepository)(nil).GetAssignmentEventsByPR), ctx, prID)
+}
+
+// GetAssignmentEventsByUser mocks base method.
+func (m *MockpullRequestRepository) GetAssignmentEventsByUser(ctx context.Context, userID string) ([]models.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentEventsByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentEventsByUser indicates an expected call of GetAssignmentEventsByUser.
+func (mr *MockpullRequestRepositoryMockRecorder) GetAssignmentEventsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentEventsByUser", reflect.TypeOf((*MockpullRequestRepository)(nil).GetAssignmentEventsByUser), ctx, userID)
+}
+
 // GetAvailableReviewers mocks base method.
-func (m *MockpullRequestRepository) GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string, limit int) ([]string, error) {
+func (m *MockpullRequestRepository) GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailableReviewers", ctx, teamName, excludeIDs, limit)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetAvailableReviewers", ctx, teamName, excludeIDs)
+	ret0, _ := ret[0].([]models.Candidate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailableReviewers indicates an expected call of GetAvailableReviewers.
-func (mr *MockpullRequestRepositoryMockRecorder) GetAvailableReviewers(ctx, teamName, excludeIDs, limit any) *gomock.Call {
+func (mr *MockpullRequestRepositoryMockRecorder) GetAvailableReviewers(ctx, teamName, excludeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableReviewers", reflect.TypeOf((*MockpullRequestRepository)(nil).GetAvailableReviewers), ctx, teamName, excludeIDs)
+}
+
+// GetChangedPaths mocks base method.
+func (m *MockpullRequestRepository) GetChangedPaths(ctx context.Context, prID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedPaths", ctx, prID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedPaths indicates an expected call of GetChangedPaths.
+func (mr *MockpullRequestRepositoryMockRecorder) GetChangedPaths(ctx, prID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableReviewers", reflect.TypeOf((*MockpullRequestRepository)(nil).GetAvailableReviewers), ctx, teamName, excludeIDs, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedPaths", reflect.TypeOf((*MockpullRequestRepository)(nil).GetChangedPaths), ctx, prID)
+}
+
+// GetOpenPRsWithFullInfo mocks base method.
+func (m *MockpullRequestRepository) GetOpenPRsWithFullInfo(ctx context.Context, deactivatedReviewerIDs []string) (map[string]pull_request.PRFullInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPRsWithFullInfo", ctx, deactivatedReviewerIDs)
+	ret0, _ := ret[0].(map[string]pull_request.PRFullInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenPRsWithFullInfo indicates an expected call of GetOpenPRsWithFullInfo.
+func (mr *MockpullRequestRepositoryMockRecorder) GetOpenPRsWithFullInfo(ctx, deactivatedReviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPRsWithFullInfo", reflect.TypeOf((*MockpullRequestRepository)(nil).GetOpenPRsWithFullInfo), ctx, deactivatedReviewerIDs)
+}
+
+// GetOpenPRsWithReviewers mocks base method.
+func (m *MockpullRequestRepository) GetOpenPRsWithReviewers(ctx context.Context, reviewerIDs []string) (map[string][]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenPRsWithReviewers", ctx, reviewerIDs)
+	ret0, _ := ret[0].(map[string][]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenPRsWithReviewers indicates an expected call of GetOpenPRsWithReviewers.
+func (mr *MockpullRequestRepositoryMockRecorder) GetOpenPRsWithReviewers(ctx, reviewerIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenPRsWithReviewers", reflect.TypeOf((*MockpullRequestRepository)(nil).GetOpenPRsWithReviewers), ctx, reviewerIDs)
+}
+
+// GetOverdueReviews mocks base method.
+func (m *MockpullRequestRepository) GetOverdueReviews(ctx context.Context, teamName string, unescalated bool) ([]models.OverdueReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOverdueReviews", ctx, teamName, unescalated)
+	ret0, _ := ret[0].([]models.OverdueReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOverdueReviews indicates an expected call of GetOverdueReviews.
+func (mr *MockpullRequestRepositoryMockRecorder) GetOverdueReviews(ctx, teamName, unescalated any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOverdueReviews", reflect.TypeOf((*MockpullRequestRepository)(nil).GetOverdueReviews), ctx, teamName, unescalated)
 }
 
 // GetPRByID mocks base method.
@@ -88,6 +192,36 @@ func (mr *MockpullRequestRepositoryMockRecorder) GetPRByID(ctx, prID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRByID", reflect.TypeOf((*MockpullRequestRepository)(nil).GetPRByID), ctx, prID)
 }
 
+// GetPRByIDForUpdate mocks base method.
+func (m *MockpullRequestRepository) GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPRByIDForUpdate", ctx, tx, prID)
+	ret0, _ := ret[0].(models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPRByIDForUpdate indicates an expected call of GetPRByIDForUpdate.
+func (mr *MockpullRequestRepositoryMockRecorder) GetPRByIDForUpdate(ctx, tx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRByIDForUpdate", reflect.TypeOf((*MockpullRequestRepository)(nil).GetPRByIDForUpdate), ctx, tx, prID)
+}
+
+// GetPullRequestAssignments mocks base method.
+func (m *MockpullRequestRepository) GetPullRequestAssignments(ctx context.Context, prID string) ([]models.Reviewer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPullRequestAssignments", ctx, prID)
+	ret0, _ := ret[0].([]models.Reviewer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPullRequestAssignments indicates an expected call of GetPullRequestAssignments.
+func (mr *MockpullRequestRepositoryMockRecorder) GetPullRequestAssignments(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestAssignments", reflect.TypeOf((*MockpullRequestRepository)(nil).GetPullRequestAssignments), ctx, prID)
+}
+
 // GetPullRequestReviewers mocks base method.
 func (m *MockpullRequestRepository) GetPullRequestReviewers(ctx context.Context, prID string) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +237,109 @@ func (mr *MockpullRequestRepositoryMockRecorder) GetPullRequestReviewers(ctx, pr
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPullRequestReviewers", reflect.TypeOf((*MockpullRequestRepository)(nil).GetPullRequestReviewers), ctx, prID)
 }
 
+// GetRequiredSkills mocks base method.
+func (m *MockpullRequestRepository) GetRequiredSkills(ctx context.Context, prID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequiredSkills", ctx, prID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequiredSkills indicates an expected call of GetRequiredSkills.
+func (mr *MockpullRequestRepositoryMockRecorder) GetRequiredSkills(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequiredSkills", reflect.TypeOf((*MockpullRequestRepository)(nil).GetRequiredSkills), ctx, prID)
+}
+
+// GetReviewEvents mocks base method.
+func (m *MockpullRequestRepository) GetReviewEvents(ctx context.Context, prID string) ([]models.ReviewEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewEvents", ctx, prID)
+	ret0, _ := ret[0].([]models.ReviewEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewEvents indicates an expected call of GetReviewEvents.
+func (mr *MockpullRequestRepositoryMockRecorder) GetReviewEvents(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewEvents", reflect.TypeOf((*MockpullRequestRepository)(nil).GetReviewEvents), ctx, prID)
+}
+
+// GetReviewersAtCapacity mocks base method.
+func (m *MockpullRequestRepository) GetReviewersAtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewersAtCapacity", ctx, teamName, excludeIDs)
+	ret0, _ := ret[0].([]models.Candidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewersAtCapacity indicates an expected call of GetReviewersAtCapacity.
+func (mr *MockpullRequestRepositoryMockRecorder) GetReviewersAtCapacity(ctx, teamName, excludeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewersAtCapacity", reflect.TypeOf((*MockpullRequestRepository)(nil).GetReviewersAtCapacity), ctx, teamName, excludeIDs)
+}
+
+// GetStatistics mocks base method.
+func (m *MockpullRequestRepository) GetStatistics(ctx context.Context, filter pull_request.StatisticsFilter) (*pull_request.Statistics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", ctx, filter)
+	ret0, _ := ret[0].(*pull_request.Statistics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockpullRequestRepositoryMockRecorder) GetStatistics(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockpullRequestRepository)(nil).GetStatistics), ctx, filter)
+}
+
+// GetUnderstaffedOpenPRs mocks base method.
+func (m *MockpullRequestRepository) GetUnderstaffedOpenPRs(ctx context.Context, teamName string) ([]pull_request.UnderstaffedPR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnderstaffedOpenPRs", ctx, teamName)
+	ret0, _ := ret[0].([]pull_request.UnderstaffedPR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnderstaffedOpenPRs indicates an expected call of GetUnderstaffedOpenPRs.
+func (mr *MockpullRequestRepositoryMockRecorder) GetUnderstaffedOpenPRs(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnderstaffedOpenPRs", reflect.TypeOf((*MockpullRequestRepository)(nil).GetUnderstaffedOpenPRs), ctx, teamName)
+}
+
+// InsertAssignmentEvents mocks base method.
+func (m *MockpullRequestRepository) InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertAssignmentEvents", ctx, tx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertAssignmentEvents indicates an expected call of InsertAssignmentEvents.
+func (mr *MockpullRequestRepositoryMockRecorder) InsertAssignmentEvents(ctx, tx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertAssignmentEvents", reflect.TypeOf((*MockpullRequestRepository)(nil).InsertAssignmentEvents), ctx, tx, events)
+}
+
+// InsertChangedPaths mocks base method.
+func (m *MockpullRequestRepository) InsertChangedPaths(ctx context.Context, tx *sqlx.Tx, prID string, paths []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertChangedPaths", ctx, tx, prID, paths)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertChangedPaths indicates an expected call of InsertChangedPaths.
+func (mr *MockpullRequestRepositoryMockRecorder) InsertChangedPaths(ctx, tx, prID, paths any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertChangedPaths", reflect.TypeOf((*MockpullRequestRepository)(nil).InsertChangedPaths), ctx, tx, prID, paths)
+}
+
 // InsertPullRequest mocks base method.
 func (m *MockpullRequestRepository) InsertPullRequest(ctx context.Context, tx *sqlx.Tx, pr *models.PullRequest) (models.PullRequest, error) {
 	m.ctrl.T.Helper()
@@ -118,8 +355,36 @@ func (mr *MockpullRequestRepositoryMockRecorder) InsertPullRequest(ctx, tx, pr a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertPullRequest", reflect.TypeOf((*MockpullRequestRepository)(nil).InsertPullRequest), ctx, tx, pr)
 }
 
+// InsertRequiredSkills mocks base method.
+func (m *MockpullRequestRepository) InsertRequiredSkills(ctx context.Context, tx *sqlx.Tx, prID string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertRequiredSkills", ctx, tx, prID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertRequiredSkills indicates an expected call of InsertRequiredSkills.
+func (mr *MockpullRequestRepositoryMockRecorder) InsertRequiredSkills(ctx, tx, prID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertRequiredSkills", reflect.TypeOf((*MockpullRequestRepository)(nil).InsertRequiredSkills), ctx, tx, prID, tags)
+}
+
+// InsertReviewEvent mocks base method.
+func (m *MockpullRequestRepository) InsertReviewEvent(ctx context.Context, tx *sqlx.Tx, event models.ReviewEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertReviewEvent", ctx, tx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertReviewEvent indicates an expected call of InsertReviewEvent.
+func (mr *MockpullRequestRepositoryMockRecorder) InsertReviewEvent(ctx, tx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReviewEvent", reflect.TypeOf((*MockpullRequestRepository)(nil).InsertReviewEvent), ctx, tx, event)
+}
+
 // InsertReviewers mocks base method.
-func (m *MockpullRequestRepository) InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []string) error {
+func (m *MockpullRequestRepository) InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []models.Reviewer) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "InsertReviewers", ctx, tx, prID, reviewers)
 	ret0, _ := ret[0].(error)
@@ -132,6 +397,50 @@ func (mr *MockpullRequestRepositoryMockRecorder) InsertReviewers(ctx, tx, prID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertReviewers", reflect.TypeOf((*MockpullRequestRepository)(nil).InsertReviewers), ctx, tx, prID, reviewers)
 }
 
+// ListPullRequests mocks base method.
+func (m *MockpullRequestRepository) ListPullRequests(ctx context.Context, spec pull_request.FindSpecification) ([]models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests", ctx, spec)
+	ret0, _ := ret[0].([]models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequests indicates an expected call of ListPullRequests.
+func (mr *MockpullRequestRepositoryMockRecorder) ListPullRequests(ctx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MockpullRequestRepository)(nil).ListPullRequests), ctx, spec)
+}
+
+// MarkMerged mocks base method.
+func (m *MockpullRequestRepository) MarkMerged(ctx context.Context, tx *sqlx.Tx, prID, mergedBy string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkMerged", ctx, tx, prID, mergedBy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkMerged indicates an expected call of MarkMerged.
+func (mr *MockpullRequestRepositoryMockRecorder) MarkMerged(ctx, tx, prID, mergedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkMerged", reflect.TypeOf((*MockpullRequestRepository)(nil).MarkMerged), ctx, tx, prID, mergedBy)
+}
+
+// MarkReviewEscalated mocks base method.
+func (m *MockpullRequestRepository) MarkReviewEscalated(ctx context.Context, tx *sqlx.Tx, prID, reviewerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReviewEscalated", ctx, tx, prID, reviewerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkReviewEscalated indicates an expected call of MarkReviewEscalated.
+func (mr *MockpullRequestRepositoryMockRecorder) MarkReviewEscalated(ctx, tx, prID, reviewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReviewEscalated", reflect.TypeOf((*MockpullRequestRepository)(nil).MarkReviewEscalated), ctx, tx, prID, reviewerID)
+}
+
 // PullRequestExists mocks base method.
 func (m *MockpullRequestRepository) PullRequestExists(ctx context.Context, prID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -148,45 +457,59 @@ func (mr *MockpullRequestRepositoryMockRecorder) PullRequestExists(ctx, prID any
 }
 
 // ReassignReviewer mocks base method.
-func (m *MockpullRequestRepository) ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID, newReviewerID string) error {
+func (m *MockpullRequestRepository) ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID string, newReviewer models.Reviewer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReassignReviewer", ctx, tx, prID, oldReviewerID, newReviewerID)
+	ret := m.ctrl.Call(m, "ReassignReviewer", ctx, tx, prID, oldReviewerID, newReviewer)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ReassignReviewer indicates an expected call of ReassignReviewer.
-func (mr *MockpullRequestRepositoryMockRecorder) ReassignReviewer(ctx, tx, prID, oldReviewerID, newReviewerID any) *gomock.Call {
+func (mr *MockpullRequestRepositoryMockRecorder) ReassignReviewer(ctx, tx, prID, oldReviewerID, newReviewer any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockpullRequestRepository)(nil).ReassignReviewer), ctx, tx, prID, oldReviewerID, newReviewerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockpullRequestRepository)(nil).ReassignReviewer), ctx, tx, prID, oldReviewerID, newReviewer)
 }
 
 // SetPullRequestStatus mocks base method.
-func (m *MockpullRequestRepository) SetPullRequestStatus(ctx context.Context, prID, statusName string) error {
+func (m *MockpullRequestRepository) SetPullRequestStatus(ctx context.Context, tx *sqlx.Tx, prID, statusName string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetPullRequestStatus", ctx, prID, statusName)
+	ret := m.ctrl.Call(m, "SetPullRequestStatus", ctx, tx, prID, statusName)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetPullRequestStatus indicates an expected call of SetPullRequestStatus.
-func (mr *MockpullRequestRepositoryMockRecorder) SetPullRequestStatus(ctx, prID, statusName any) *gomock.Call {
+func (mr *MockpullRequestRepositoryMockRecorder) SetPullRequestStatus(ctx, tx, prID, statusName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPullRequestStatus", reflect.TypeOf((*MockpullRequestRepository)(nil).SetPullRequestStatus), ctx, tx, prID, statusName)
+}
+
+// SetReviewState mocks base method.
+func (m *MockpullRequestRepository) SetReviewState(ctx context.Context, tx *sqlx.Tx, prID, reviewerID, state string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetReviewState", ctx, tx, prID, reviewerID, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetReviewState indicates an expected call of SetReviewState.
+func (mr *MockpullRequestRepositoryMockRecorder) SetReviewState(ctx, tx, prID, reviewerID, state any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPullRequestStatus", reflect.TypeOf((*MockpullRequestRepository)(nil).SetPullRequestStatus), ctx, prID, statusName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReviewState", reflect.TypeOf((*MockpullRequestRepository)(nil).SetReviewState), ctx, tx, prID, reviewerID, state)
 }
 
 // UpdatePullRequest mocks base method.
-func (m *MockpullRequestRepository) UpdatePullRequest(ctx context.Context, spec pull_request.UpdateSpecification) error {
+func (m *MockpullRequestRepository) UpdatePullRequest(ctx context.Context, tx *sqlx.Tx, spec pull_request.UpdateSpecification) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePullRequest", ctx, spec)
+	ret := m.ctrl.Call(m, "UpdatePullRequest", ctx, tx, spec)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePullRequest indicates an expected call of UpdatePullRequest.
-func (mr *MockpullRequestRepositoryMockRecorder) UpdatePullRequest(ctx, spec any) *gomock.Call {
+func (mr *MockpullRequestRepositoryMockRecorder) UpdatePullRequest(ctx, tx, spec any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockpullRequestRepository)(nil).UpdatePullRequest), ctx, spec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePullRequest", reflect.TypeOf((*MockpullRequestRepository)(nil).UpdatePullRequest), ctx, tx, spec)
 }
 
 // WithTx mocks base method.
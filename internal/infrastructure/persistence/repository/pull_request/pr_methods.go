@@ -43,9 +43,17 @@ func getPRValues(pr *models.PullRequest) []interface{} {
 		pr.Name,
 		pr.AuthorID,
 		pr.StatusID,
+		skillModeOrDefault(pr.SkillMode),
 	}
 }
 
+func skillModeOrDefault(mode string) interface{} {
+	if mode == "" {
+		return sq.Expr("DEFAULT")
+	}
+	return mode
+}
+
 func (r *Repository) InsertPullRequest(ctx context.Context, tx *sqlx.Tx, pr *models.PullRequest) (models.PullRequest, error) {
 	var created models.PullRequest
 
@@ -79,17 +87,17 @@ func (r *Repository) PullRequestExists(ctx context.Context, prID string) (bool,
 	return exists, nil
 }
 
-func (r *Repository) SetPullRequestStatus(ctx context.Context, prID string, statusName string) error {
+func (r *Repository) SetPullRequestStatus(ctx context.Context, tx *sqlx.Tx, prID string, statusName string) error {
 	foundStatus, err := r.FindStatus(ctx, status.NewGetStatusByNameSpecification(statusName))
 	if err != nil {
 		return err
 	}
 
 	spec := pr_spec.NewSetStatusSpecification(foundStatus, prID)
-	return r.UpdatePullRequest(ctx, spec)
+	return r.UpdatePullRequest(ctx, tx, spec)
 }
 
-func (r *Repository) UpdatePullRequest(ctx context.Context, spec UpdateSpecification) error {
+func (r *Repository) UpdatePullRequest(ctx context.Context, tx *sqlx.Tx, spec UpdateSpecification) error {
 	builder := st.Update(r.tableName)
 
 	for col, val := range spec.GetSetValues() {
@@ -103,7 +111,7 @@ func (r *Repository) UpdatePullRequest(ctx context.Context, spec UpdateSpecifica
 		return err
 	}
 
-	_, err = r.db.ExecContext(ctx, sqlStr, params...)
+	_, err = tx.ExecContext(ctx, sqlStr, params...)
 	if err != nil {
 		return err
 	}
@@ -112,69 +120,68 @@ func (r *Repository) UpdatePullRequest(ctx context.Context, spec UpdateSpecifica
 }
 
 func (r *Repository) GetPRByID(ctx context.Context, prID string) (models.PullRequest, error) {
-	var pr models.PullRequest
+	return r.getPRByID(ctx, r.db, prID, "")
+}
+
+// GetPRByIDForUpdate loads a PR like GetPRByID but locks its row until tx ends,
+// serializing merges and reviewer changes of the same PR.
+func (r *Repository) GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error) {
+	return r.getPRByID(ctx, tx, prID, "FOR UPDATE OF "+alias)
+}
+
+// getPRByID loads a PR with its assignments in a single query: the reviewers
+// are aggregated by a correlated subquery, so the row can still be locked.
+func (r *Repository) getPRByID(ctx context.Context, q sqlx.QueryerContext, prID string, suffix string) (models.PullRequest, error) {
+	var row struct {
+		models.PullRequest
+		AggregatedAssignments aggregatedAssignments `db:"assignments"`
+	}
 
 	query, args, err := st.
-		Select(r.pullRequestColumns.ForSelect(nil)...).
-		From(r.tableName).
-		Where(sq.Eq{r.pullRequestColumns.GetIDField(): prID}).
+		Select(append(r.pullRequestColumns.ForSelect([]string{alias + ".*"}), r.assignmentsAggregate()+" AS assignments")...).
+		From(fmt.Sprintf("%s %s", r.tableName, alias)).
+		Where(sq.Eq{alias + "." + r.pullRequestColumns.GetIDField(): prID}).
+		Suffix(suffix).
 		ToSql()
 	if err != nil {
-		return pr, err
+		return models.PullRequest{}, err
 	}
 
-	if err := r.db.GetContext(ctx, &pr, query, args...); err != nil {
+	if err := sqlx.GetContext(ctx, q, &row, query, args...); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return pr, ErrPRNotFound
+			return models.PullRequest{}, ErrPRNotFound
 		}
-		return pr, err
+		return models.PullRequest{}, err
 	}
 
+	pr := row.PullRequest
 	pr.Status, err = r.FindStatus(ctx, status.NewGetStatusByIDSpecification(pr.StatusID))
 	if err != nil {
 		return models.PullRequest{}, err
 	}
 
-	pr.Reviewers, err = r.getReviewers(ctx, pr.ID)
-	if err != nil {
-		return models.PullRequest{}, err
+	pr.Assignments = row.AggregatedAssignments
+	pr.Reviewers = make([]string, len(pr.Assignments))
+	for i, a := range pr.Assignments {
+		pr.Reviewers[i] = a.ReviewerID
 	}
 
 	return pr, nil
 }
 
-func (r *Repository) GetPRByIDShort(ctx context.Context, prID string) (models.PullRequest, error) {
-	var pr models.PullRequest
-
-	query, args, err := st.
-		Select(r.pullRequestColumns.ForSelect([]string{"pr_id", "pr_name", "author_id", "status_id"})...).
-		From(fmt.Sprintf("%s %s", r.tableName, r.pullRequestColumns.GetAlias())).
-		Where(sq.Eq{r.pullRequestColumns.GetIDField(): prID}).
-		ToSql()
-	if err != nil {
-		return pr, err
-	}
-
-	if err := r.db.GetContext(ctx, &pr, query, args...); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return pr, ErrPRNotFound
-		}
-		return pr, err
-	}
-
-	pr.Status, err = r.FindStatus(ctx, status.NewGetStatusByIDSpecification(pr.StatusID))
+func (r *Repository) MarkMerged(ctx context.Context, tx *sqlx.Tx, prID string, mergedBy string) error {
+	mergedStatus, err := r.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusMerged))
 	if err != nil {
-		return models.PullRequest{}, err
+		return err
 	}
 
-	return pr, nil
+	return r.UpdatePullRequest(ctx, tx, pr_spec.NewMergeSpecification(mergedStatus, prID, mergedBy))
 }
 
+// FindPullRequests returns the short form of the PRs selected by spec in one
+// query; statuses are resolved from the in-memory cache.
 func (r *Repository) FindPullRequests(ctx context.Context, spec FindSpecification) ([]models.PullRequest, error) {
-	queryBuilder := st.
-		Select(spec.GetFields()...).From(r.reviewersTableName)
-
-	sqlStr, params, err := spec.GetRule(queryBuilder).ToSql()
+	sqlStr, params, err := spec.GetRule(st.Select(spec.GetFields()...)).ToSql()
 	if err != nil {
 		return nil, err
 	}
@@ -185,17 +192,59 @@ func (r *Repository) FindPullRequests(ctx context.Context, spec FindSpecificatio
 		return nil, err
 	}
 
-	for i, pr := range pullRequests {
-		pr, err = r.GetPRByIDShort(ctx, pr.ID)
+	for i := range pullRequests {
+		pullRequests[i].Status, err = r.FindStatus(ctx, status.NewGetStatusByIDSpecification(pullRequests[i].StatusID))
 		if err != nil {
 			return nil, err
 		}
-		pullRequests[i] = pr
 	}
 
 	return pullRequests, nil
 }
 
-func (r *Repository) getReviewers(ctx context.Context, prID string) ([]string, error) {
-	return r.FindReviewers(ctx, reviewer.NewGetPRReviewersSpecification(prID, r.reviewersTableName))
+// ListPullRequests returns the PRs selected by spec with their status and
+// reviewer assignments, loading the assignments of the whole page at once.
+func (r *Repository) ListPullRequests(ctx context.Context, spec FindSpecification) ([]models.PullRequest, error) {
+	sqlStr, params, err := spec.GetRule(st.Select(spec.GetFields()...)).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		models.PullRequest
+		StatusName string `db:"status_name"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, sqlStr, params...); err != nil {
+		return nil, err
+	}
+
+	pullRequests := make([]models.PullRequest, len(rows))
+	prIDs := make([]string, len(rows))
+	for i, row := range rows {
+		pullRequests[i] = row.PullRequest
+		pullRequests[i].Status = &models.Status{ID: row.StatusID, Name: row.StatusName}
+		pullRequests[i].Reviewers = []string{}
+		prIDs[i] = row.ID
+	}
+	if len(prIDs) == 0 {
+		return pullRequests, nil
+	}
+
+	assignments, err := r.FindAssignments(ctx, reviewer.NewGetPRsAssignmentsSpecification(prIDs, r.reviewersTableName))
+	if err != nil {
+		return nil, err
+	}
+
+	byPR := make(map[string][]models.Reviewer, len(prIDs))
+	for _, a := range assignments {
+		byPR[a.PullRequestID] = append(byPR[a.PullRequestID], a)
+	}
+	for i := range pullRequests {
+		pullRequests[i].Assignments = byPR[pullRequests[i].ID]
+		for _, a := range pullRequests[i].Assignments {
+			pullRequests[i].Reviewers = append(pullRequests[i].Reviewers, a.ReviewerID)
+		}
+	}
+
+	return pullRequests, nil
 }
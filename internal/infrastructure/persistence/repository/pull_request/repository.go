@@ -15,29 +15,45 @@ const (
 )
 
 const (
-	reviewersTableName = "reviewers"
-	statusTableName    = "statuses"
-	usersTableName     = "users"
+	reviewersTableName        = "reviewers"
+	reviewEventsTableName     = "review_events"
+	assignmentEventsTableName = "assignment_events"
+	statusTableName           = "statuses"
+	usersTableName            = "users"
+	teamsTableName            = "teams"
+	unavailabilityTableName   = "user_unavailability"
+	skillsTableName           = "user_skills"
+	changedPathsTableName     = "pull_request_changed_paths"
+	requiredSkillsTableName   = "pull_request_required_skills"
 )
 
 type Repository struct {
 	db *sqlx.DB
 
-	tableName          string
-	reviewersTableName string
-	statusTableName    string
-	usersTableName     string
+	tableName                 string
+	reviewersTableName        string
+	reviewEventsTableName     string
+	assignmentEventsTableName string
+	statusTableName           string
+	usersTableName            string
+	teamsTableName            string
+	unavailabilityTableName   string
+	skillsTableName           string
+	changedPathsTableName     string
+	requiredSkillsTableName   string
 
 	pullRequestColumns *persistence.Columns
 	reviewerColumns    *persistence.Columns
 	statusColumns      *persistence.Columns
+
+	statuses *statusCache
 }
 
 func NewRepository(db *sqlx.DB) *Repository {
 	prCols := persistence.NewColumns(readableColumns, writableColumns, alias, idField)
 	rCols := persistence.NewColumns(
-		[]string{"pr_id", "reviewer_id"},
-		[]string{"pr_id", "reviewer_id"},
+		[]string{"pr_id", "reviewer_id", "source_team", "review_state", "reviewed_at", "assigned_at", "escalated_at"},
+		[]string{"pr_id", "reviewer_id", "source_team"},
 		"r",
 		"",
 	)
@@ -51,14 +67,23 @@ func NewRepository(db *sqlx.DB) *Repository {
 	return &Repository{
 		db: db,
 
-		tableName:          tableName,
-		reviewersTableName: reviewersTableName,
-		statusTableName:    statusTableName,
-		usersTableName:     usersTableName,
+		tableName:                 tableName,
+		reviewersTableName:        reviewersTableName,
+		reviewEventsTableName:     reviewEventsTableName,
+		assignmentEventsTableName: assignmentEventsTableName,
+		statusTableName:           statusTableName,
+		usersTableName:            usersTableName,
+		teamsTableName:            teamsTableName,
+		unavailabilityTableName:   unavailabilityTableName,
+		skillsTableName:           skillsTableName,
+		changedPathsTableName:     changedPathsTableName,
+		requiredSkillsTableName:   requiredSkillsTableName,
 
 		pullRequestColumns: prCols,
 		reviewerColumns:    rCols,
 		statusColumns:      sCols,
+
+		statuses: newStatusCache(),
 	}
 }
 
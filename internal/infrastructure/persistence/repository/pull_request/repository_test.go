@@ -135,19 +135,19 @@ func TestPullRequestRepository_SetPullRequestStatus(t *testing.T) {
 
 	t.Run("successful status update", func(t *testing.T) {
 		mockRepo.EXPECT().
-			SetPullRequestStatus(gomock.Any(), prID, statusName).
+			SetPullRequestStatus(gomock.Any(), gomock.Any(), prID, statusName).
 			Return(nil)
 
-		err := mockRepo.SetPullRequestStatus(ctx, prID, statusName)
+		err := mockRepo.SetPullRequestStatus(ctx, nil, prID, statusName)
 		assert.NoError(t, err)
 	})
 
 	t.Run("status not found", func(t *testing.T) {
 		mockRepo.EXPECT().
-			SetPullRequestStatus(gomock.Any(), prID, "INVALID").
+			SetPullRequestStatus(gomock.Any(), gomock.Any(), prID, "INVALID").
 			Return(pull_request.ErrStatusNotFound)
 
-		err := mockRepo.SetPullRequestStatus(ctx, prID, "INVALID")
+		err := mockRepo.SetPullRequestStatus(ctx, nil, prID, "INVALID")
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, pull_request.ErrStatusNotFound))
 	})
@@ -199,7 +199,10 @@ func TestPullRequestRepository_InsertReviewers(t *testing.T) {
 	mockRepo := mocks.NewMockpullRequestRepository(ctrl)
 	ctx := context.Background()
 	prID := "pr-1"
-	reviewers := []string{"reviewer-1", "reviewer-2"}
+	reviewers := []models.Reviewer{
+		{ReviewerID: "reviewer-1", SourceTeam: "team-1"},
+		{ReviewerID: "reviewer-2", SourceTeam: "team-2"},
+	}
 	var tx *sqlx.Tx
 
 	t.Run("successful insert", func(t *testing.T) {
@@ -213,10 +216,10 @@ func TestPullRequestRepository_InsertReviewers(t *testing.T) {
 
 	t.Run("empty reviewers list", func(t *testing.T) {
 		mockRepo.EXPECT().
-			InsertReviewers(gomock.Any(), gomock.Any(), prID, []string{}).
+			InsertReviewers(gomock.Any(), gomock.Any(), prID, []models.Reviewer{}).
 			Return(nil)
 
-		err := mockRepo.InsertReviewers(ctx, tx, prID, []string{})
+		err := mockRepo.InsertReviewers(ctx, tx, prID, []models.Reviewer{})
 		assert.NoError(t, err)
 	})
 }
@@ -252,6 +255,28 @@ func TestPullRequestRepository_GetPullRequestReviewers(t *testing.T) {
 	})
 }
 
+func TestPullRequestRepository_GetPullRequestAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockpullRequestRepository(ctrl)
+	ctx := context.Background()
+	prID := "pr-1"
+
+	expectedAssignments := []models.Reviewer{
+		{PullRequestID: prID, ReviewerID: "reviewer-1", SourceTeam: "team-1"},
+		{PullRequestID: prID, ReviewerID: "reviewer-2", SourceTeam: "buddy-team"},
+	}
+
+	mockRepo.EXPECT().
+		GetPullRequestAssignments(gomock.Any(), prID).
+		Return(expectedAssignments, nil)
+
+	assignments, err := mockRepo.GetPullRequestAssignments(ctx, prID)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedAssignments, assignments)
+}
+
 func TestPullRequestRepository_GetAvailableReviewers(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -260,26 +285,28 @@ func TestPullRequestRepository_GetAvailableReviewers(t *testing.T) {
 	ctx := context.Background()
 	teamName := "team-1"
 	excludeIDs := []string{"user-1"}
-	limit := 2
 
 	t.Run("successful get available reviewers", func(t *testing.T) {
-		expectedReviewers := []string{"user-2", "user-3"}
+		expectedReviewers := []models.Candidate{
+			{UserID: "user-2", OpenReviews: 0},
+			{UserID: "user-3", OpenReviews: 1},
+		}
 
 		mockRepo.EXPECT().
-			GetAvailableReviewers(gomock.Any(), teamName, excludeIDs, limit).
+			GetAvailableReviewers(gomock.Any(), teamName, excludeIDs).
 			Return(expectedReviewers, nil)
 
-		reviewers, err := mockRepo.GetAvailableReviewers(ctx, teamName, excludeIDs, limit)
+		reviewers, err := mockRepo.GetAvailableReviewers(ctx, teamName, excludeIDs)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedReviewers, reviewers)
 	})
 
 	t.Run("no available reviewers", func(t *testing.T) {
 		mockRepo.EXPECT().
-			GetAvailableReviewers(gomock.Any(), teamName, excludeIDs, limit).
+			GetAvailableReviewers(gomock.Any(), teamName, excludeIDs).
 			Return(nil, pull_request.ErrReviewersNotFound)
 
-		reviewers, err := mockRepo.GetAvailableReviewers(ctx, teamName, excludeIDs, limit)
+		reviewers, err := mockRepo.GetAvailableReviewers(ctx, teamName, excludeIDs)
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, pull_request.ErrReviewersNotFound))
 		assert.Nil(t, reviewers)
@@ -294,24 +321,24 @@ func TestPullRequestRepository_ReassignReviewer(t *testing.T) {
 	ctx := context.Background()
 	prID := "pr-1"
 	oldReviewerID := "reviewer-1"
-	newReviewerID := "reviewer-2"
+	newReviewer := models.Reviewer{ReviewerID: "reviewer-2", SourceTeam: "team-1"}
 	var tx *sqlx.Tx
 
 	t.Run("successful reassign", func(t *testing.T) {
 		mockRepo.EXPECT().
-			ReassignReviewer(gomock.Any(), gomock.Any(), prID, oldReviewerID, newReviewerID).
+			ReassignReviewer(gomock.Any(), gomock.Any(), prID, oldReviewerID, newReviewer).
 			Return(nil)
 
-		err := mockRepo.ReassignReviewer(ctx, tx, prID, oldReviewerID, newReviewerID)
+		err := mockRepo.ReassignReviewer(ctx, tx, prID, oldReviewerID, newReviewer)
 		assert.NoError(t, err)
 	})
 
 	t.Run("reviewer not assigned", func(t *testing.T) {
 		mockRepo.EXPECT().
-			ReassignReviewer(gomock.Any(), gomock.Any(), prID, "reviewer-999", newReviewerID).
+			ReassignReviewer(gomock.Any(), gomock.Any(), prID, "reviewer-999", newReviewer).
 			Return(pull_request.ErrReviewerNotAssigned)
 
-		err := mockRepo.ReassignReviewer(ctx, tx, prID, "reviewer-999", newReviewerID)
+		err := mockRepo.ReassignReviewer(ctx, tx, prID, "reviewer-999", newReviewer)
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, pull_request.ErrReviewerNotAssigned))
 	})
@@ -0,0 +1,51 @@
+package pull_request
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// InsertRequiredSkills stores the skills the PR's reviewers should cover,
+// keeping their order.
+func (r *Repository) InsertRequiredSkills(ctx context.Context, tx *sqlx.Tx, prID string, tags []string) error {
+	if len(tags) == 0 {
+		return nil
+	}
+
+	builder := st.
+		Insert(r.requiredSkillsTableName).
+		Columns("pr_id", "tag", "position")
+	for i, tag := range tags {
+		builder = builder.Values(prID, tag, i)
+	}
+
+	query, args, err := builder.Suffix("ON CONFLICT DO NOTHING").ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// GetRequiredSkills returns the skills required by the PR in the order they
+// were given.
+func (r *Repository) GetRequiredSkills(ctx context.Context, prID string) ([]string, error) {
+	query, args, err := st.
+		Select("tag").
+		From(r.requiredSkillsTableName).
+		Where(sq.Eq{"pr_id": prID}).
+		OrderBy("position ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	if err := r.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -9,8 +9,8 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/reviewer"
-	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
 )
 
 type PRFullInfo struct {
@@ -19,15 +19,145 @@ type PRFullInfo struct {
 	DeactivatedReviewers []string
 }
 
-func (r *Repository) GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string, limit int) ([]string, error) {
-	return r.FindReviewers(ctx, reviewer.NewGetAvailableReviewersSpecification(teamName, excludeIDs, limit, r.usersTableName))
+type UnderstaffedPR struct {
+	PRID            string `db:"pr_id"`
+	AuthorID        string `db:"author_id"`
+	TeamName        string `db:"team_name"`
+	TargetReviewers int    `db:"target_reviewers"`
+	ReviewerCount   int    `db:"reviewer_count"`
+}
+
+func (r *Repository) GetUnderstaffedOpenPRs(ctx context.Context, teamName string) ([]UnderstaffedPR, error) {
+	spec := pr_spec.NewGetUnderstaffedPRsSpecification(teamName)
+
+	sqlStr, params, err := spec.GetRule(st.Select(spec.GetFields()...)).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []UnderstaffedPR
+	if err := r.db.SelectContext(ctx, &res, sqlStr, params...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOverdueReviews returns the pending assignments past the review SLA of the
+// author's team. An empty teamName covers all teams; unescalated leaves out
+// the assignments already flagged by MarkReviewEscalated.
+func (r *Repository) GetOverdueReviews(ctx context.Context, teamName string, unescalated bool) ([]models.OverdueReview, error) {
+	spec := pr_spec.NewGetOverdueReviewsSpecification(teamName, unescalated)
+
+	sqlStr, params, err := spec.GetRule(st.Select(spec.GetFields()...)).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.OverdueReview, 0)
+	if err := r.db.SelectContext(ctx, &res, sqlStr, params...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// MarkReviewEscalated flags an overdue assignment within tx. It reports false
+// when the assignment is gone or was already flagged, e.g. by another instance
+// of the service.
+func (r *Repository) MarkReviewEscalated(ctx context.Context, tx *sqlx.Tx, prID, reviewerID string) (bool, error) {
+	query, args, err := st.
+		Update(r.reviewersTableName).
+		Set("escalated_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{
+			"pr_id":       prID,
+			"reviewer_id": reviewerID,
+		}).
+		Where(sq.Eq{"escalated_at": nil}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// GetAvailableReviewers returns the available members of teamName, or of every
+// team when teamName is empty, together with their skills.
+func (r *Repository) GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error) {
+	candidates, err := r.FindCandidates(ctx, reviewer.NewGetAvailableReviewersSpecification(teamName, excludeIDs, r.usersTableName, r.availabilityTables()))
+	if err != nil || len(candidates) == 0 {
+		return candidates, err
+	}
+
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserID
+	}
+
+	query, args, err := st.
+		Select("user_id", "tag").
+		From(r.skillsTableName).
+		Where(sq.Eq{"user_id": ids}).
+		OrderBy("user_id ASC", "tag ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		UserID string `db:"user_id"`
+		Tag    string `db:"tag"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	skills := make(map[string][]string)
+	for _, row := range rows {
+		skills[row.UserID] = append(skills[row.UserID], row.Tag)
+	}
+	for i := range candidates {
+		candidates[i].Skills = skills[candidates[i].UserID]
+	}
+
+	return candidates, nil
+}
+
+// GetReviewersAtCapacity returns the members of teamName, or of every team when
+// teamName is empty, that are available apart from having reached their open
+// review limit.
+func (r *Repository) GetReviewersAtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error) {
+	return r.FindCandidates(ctx, reviewer.NewGetReviewersAtCapacitySpecification(teamName, excludeIDs, r.usersTableName, r.availabilityTables()))
+}
+
+func (r *Repository) availabilityTables() reviewer.AvailabilityTables {
+	return reviewer.AvailabilityTables{
+		Teams:          r.teamsTableName,
+		Reviewers:      r.reviewersTableName,
+		PullRequests:   r.tableName,
+		Statuses:       r.statusTableName,
+		Unavailability: r.unavailabilityTableName,
+	}
 }
 
 func (r *Repository) GetPullRequestReviewers(ctx context.Context, prID string) ([]string, error) {
 	return r.FindReviewers(ctx, reviewer.NewGetPRReviewersSpecification(prID, r.reviewersTableName))
 }
 
-func (r *Repository) InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []string) error {
+func (r *Repository) GetPullRequestAssignments(ctx context.Context, prID string) ([]models.Reviewer, error) {
+	return r.FindAssignments(ctx, reviewer.NewGetPRAssignmentsSpecification(prID, r.reviewersTableName))
+}
+
+func (r *Repository) InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []models.Reviewer) error {
 	if len(reviewers) == 0 {
 		return nil
 	}
@@ -37,7 +167,7 @@ func (r *Repository) InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID stri
 		Columns(r.reviewerColumns.ForInsert()...)
 
 	for _, r := range reviewers {
-		builder = builder.Values(prID, r)
+		builder = builder.Values(prID, r.ReviewerID, r.SourceTeam)
 	}
 
 	query, args, err := builder.
@@ -73,7 +203,37 @@ func (r *Repository) FindReviewers(ctx context.Context, spec FindSpecification)
 	return res, nil
 }
 
-func (r *Repository) ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID, newReviewerID string) error {
+func (r *Repository) FindCandidates(ctx context.Context, spec FindSpecification) ([]models.Candidate, error) {
+	queryBuilder := st.Select(spec.GetFields()...)
+
+	sqlStr, params, err := spec.GetRule(queryBuilder).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.Candidate
+	if err = r.db.SelectContext(ctx, &res, sqlStr, params...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) FindAssignments(ctx context.Context, spec FindSpecification) ([]models.Reviewer, error) {
+	queryBuilder := st.Select(spec.GetFields()...)
+
+	sqlStr, params, err := spec.GetRule(queryBuilder).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var res []models.Reviewer
+	if err = r.db.SelectContext(ctx, &res, sqlStr, params...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID string, newReviewer models.Reviewer) error {
 	removed, err := r.deleteReviewer(ctx, tx, prID, oldReviewerID)
 	if err != nil {
 		return err
@@ -83,13 +243,78 @@ func (r *Repository) ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, ol
 		return ErrReviewerNotAssigned
 	}
 
-	if err := r.InsertReviewers(ctx, tx, prID, []string{newReviewerID}); err != nil {
+	if err := r.InsertReviewers(ctx, tx, prID, []models.Reviewer{newReviewer}); err != nil {
 		return err
 	}
 
 	return nil
 }
 
+func (r *Repository) SetReviewState(ctx context.Context, tx *sqlx.Tx, prID, reviewerID, state string) error {
+	query, args, err := st.
+		Update(r.reviewersTableName).
+		Set("review_state", state).
+		Set("reviewed_at", sq.Expr("CURRENT_TIMESTAMP")).
+		Where(sq.Eq{
+			"pr_id":       prID,
+			"reviewer_id": reviewerID,
+		}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrReviewerNotAssigned
+	}
+
+	return nil
+}
+
+func (r *Repository) InsertReviewEvent(ctx context.Context, tx *sqlx.Tx, event models.ReviewEvent) error {
+	query, args, err := st.
+		Insert(r.reviewEventsTableName).
+		Columns("pr_id", "reviewer_id", "review_state", "comment").
+		Values(event.PullRequestID, event.ReviewerID, event.State, event.Comment).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetReviewEvents(ctx context.Context, prID string) ([]models.ReviewEvent, error) {
+	query, args, err := st.
+		Select("event_id", "pr_id", "reviewer_id", "review_state", "comment", "created_at").
+		From(r.reviewEventsTableName).
+		Where(sq.Eq{"pr_id": prID}).
+		OrderBy("event_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.ReviewEvent, 0)
+	if err := r.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 func (r *Repository) deleteReviewer(ctx context.Context, tx *sqlx.Tx, prID, reviewerID string) (bool, error) {
 	query, args, err := st.
 		Delete(r.reviewersTableName).
@@ -120,16 +345,12 @@ func (r *Repository) GetOpenPRsWithReviewers(ctx context.Context, reviewerIDs []
 		return make(map[string][]string), nil
 	}
 
-	openStatus, err := r.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
-	if err != nil {
-		return nil, err
-	}
-
 	queryBuilder := st.
 		Select("r.pr_id", "r.reviewer_id").
 		From(fmt.Sprintf("%s r", r.reviewersTableName)).
 		Join(fmt.Sprintf("%s pr ON r.pr_id = pr.%s", r.tableName, r.pullRequestColumns.GetIDField())).
-		Where(sq.Eq{"pr.status_id": openStatus.ID}).
+		Join(fmt.Sprintf("%s s ON s.status_id = pr.status_id", r.statusTableName)).
+		Where(sq.Eq{"s.status_name": models.ReviewableStatuses}).
 		Where(sq.Eq{"r.reviewer_id": reviewerIDs})
 
 	sqlStr, params, err := queryBuilder.ToSql()
@@ -168,17 +389,14 @@ func (r *Repository) GetOpenPRsWithFullInfo(ctx context.Context, deactivatedRevi
 		return make(map[string]PRFullInfo), nil
 	}
 
-	openStatus, err := r.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
-	if err != nil {
-		return nil, err
-	}
-
 	queryBuilder := st.
 		Select([]string{"pr.pr_id", "pr.author_id", "r.reviewer_id"}...).
 		From(fmt.Sprintf("%s pr", r.tableName)).
 		Join(fmt.Sprintf("%s r ON r.pr_id = pr.%s", r.reviewersTableName, r.pullRequestColumns.GetIDField())).
-		Where(sq.Eq{"pr.status_id": openStatus.ID}).
-		Where(sq.Eq{"r.reviewer_id": deactivatedReviewerIDs})
+		Join(fmt.Sprintf("%s s ON s.status_id = pr.status_id", r.statusTableName)).
+		Where(sq.Eq{"s.status_name": models.ReviewableStatuses}).
+		Where(sq.Eq{"r.reviewer_id": deactivatedReviewerIDs}).
+		OrderBy("pr.pr_id", "r.reviewer_id")
 
 	sqlStr, params, err := queryBuilder.ToSql()
 	if err != nil {
@@ -267,19 +485,20 @@ func (r *Repository) BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, rea
 		reviewerMap := prReassignments.Reassignments
 		prID := prReassignments.PRID
 
-		if len(reviewerMap) == 0 {
+		if len(reviewerMap) == 0 && len(prReassignments.Removals) == 0 {
 			continue
 		}
 
-		oldReviewerIDs := make([]string, 0, len(reviewerMap))
+		oldReviewerIDs := make([]string, 0, len(reviewerMap)+len(prReassignments.Removals))
+		oldReviewerIDs = append(oldReviewerIDs, prReassignments.Removals...)
 		newReviewerSet := make(map[string]bool)
-		newReviewerIDs := make([]string, 0, len(reviewerMap))
+		newReviewers := make([]models.Reviewer, 0, len(reviewerMap))
 
-		for oldID, newID := range reviewerMap {
+		for oldID, newReviewer := range reviewerMap {
 			oldReviewerIDs = append(oldReviewerIDs, oldID)
-			if !newReviewerSet[newID] {
-				newReviewerSet[newID] = true
-				newReviewerIDs = append(newReviewerIDs, newID)
+			if !newReviewerSet[newReviewer.ReviewerID] {
+				newReviewerSet[newReviewer.ReviewerID] = true
+				newReviewers = append(newReviewers, newReviewer)
 			}
 		}
 
@@ -296,8 +515,8 @@ func (r *Repository) BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, rea
 			return err
 		}
 
-		if len(newReviewerIDs) > 0 {
-			if err := r.InsertReviewers(ctx, tx, prID, newReviewerIDs); err != nil {
+		if len(newReviewers) > 0 {
+			if err := r.InsertReviewers(ctx, tx, prID, newReviewers); err != nil {
 				return err
 			}
 		}
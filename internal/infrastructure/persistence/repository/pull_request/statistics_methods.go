@@ -2,8 +2,23 @@ package pull_request
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 )
 
+const week = 7 * 24 * time.Hour
+
+// StatisticsFilter limits historical statistics to events in [From, To) and,
+// when TeamName is set, to PRs authored by members of that team.
+type StatisticsFilter struct {
+	From     time.Time
+	To       time.Time
+	TeamName string
+}
+
 type UserAssignmentStats struct {
 	UserID string `db:"user_id"`
 	Count  int    `db:"count"`
@@ -14,40 +29,153 @@ type PRAssignmentStats struct {
 	Count         int    `db:"count"`
 }
 
+// DurationPercentiles summarizes durations in seconds; percentiles are nil
+// when Count is zero.
+type DurationPercentiles struct {
+	Count int      `db:"count"`
+	P50   *float64 `db:"p50"`
+	P90   *float64 `db:"p90"`
+	P95   *float64 `db:"p95"`
+}
+
+type ReviewerLoad struct {
+	UserID         string `db:"user_id"`
+	OpenReviews    int    `db:"open_reviews"`
+	PendingReviews int    `db:"pending_reviews"`
+}
+
+type WeeklyMerges struct {
+	WeekStart time.Time `db:"week_start"`
+	Count     int       `db:"count"`
+}
+
+type UserReassignmentStats struct {
+	UserID         string `db:"user_id"`
+	ReassignedFrom int    `db:"reassigned_from"`
+	ReassignedTo   int    `db:"reassigned_to"`
+}
+
+type PRWithoutReviewers struct {
+	PullRequestID string    `db:"pr_id"`
+	Name          string    `db:"pr_name"`
+	AuthorID      string    `db:"author_id"`
+	Status        string    `db:"status_name"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
 type Statistics struct {
 	AssignmentsByUser []UserAssignmentStats
 	AssignmentsByPR   []PRAssignmentStats
+
+	TimeToMerge   DurationPercentiles
+	ReviewLatency DurationPercentiles
+
+	MergesPerWeek       []WeeklyMerges
+	ReassignmentsByUser []UserReassignmentStats
+
+	// OpenReviewLoad and PRsWithoutReviewers describe the current state and
+	// ignore the time window.
+	OpenReviewLoad      []ReviewerLoad
+	PRsWithoutReviewers []PRWithoutReviewers
 }
 
-func (r *Repository) GetStatistics(ctx context.Context) (*Statistics, error) {
-	assignmentsByUser, err := r.getAssignmentsByUser(ctx)
+func (r *Repository) GetStatistics(ctx context.Context, filter StatisticsFilter) (*Statistics, error) {
+	var (
+		stats = &Statistics{}
+		err   error
+	)
+
+	if stats.AssignmentsByUser, err = r.getAssignmentsByUser(ctx, filter); err != nil {
+		return nil, fmt.Errorf("assignments by user: %w", err)
+	}
+	if stats.AssignmentsByPR, err = r.getAssignmentsByPR(ctx, filter); err != nil {
+		return nil, fmt.Errorf("assignments by pr: %w", err)
+	}
+	if stats.TimeToMerge, err = r.getTimeToMerge(ctx, filter); err != nil {
+		return nil, fmt.Errorf("time to merge: %w", err)
+	}
+	if stats.ReviewLatency, err = r.getReviewLatency(ctx, filter); err != nil {
+		return nil, fmt.Errorf("review latency: %w", err)
+	}
+	if stats.MergesPerWeek, err = r.getMergesPerWeek(ctx, filter); err != nil {
+		return nil, fmt.Errorf("merges per week: %w", err)
+	}
+	if stats.ReassignmentsByUser, err = r.getReassignmentsByUser(ctx, filter); err != nil {
+		return nil, fmt.Errorf("reassignments by user: %w", err)
+	}
+	if stats.OpenReviewLoad, err = r.getOpenReviewLoad(ctx, filter.TeamName); err != nil {
+		return nil, fmt.Errorf("open review load: %w", err)
+	}
+	if stats.PRsWithoutReviewers, err = r.getPRsWithoutReviewers(ctx, filter.TeamName); err != nil {
+		return nil, fmt.Errorf("prs without reviewers: %w", err)
+	}
+
+	return stats, nil
+}
+
+// withAuthorTeam restricts builder to PRs whose author belongs to teamName.
+func (r *Repository) withAuthorTeam(builder sq.SelectBuilder, teamName string) sq.SelectBuilder {
+	if teamName == "" {
+		return builder
+	}
+	return builder.
+		Join(fmt.Sprintf("%s au ON au.user_id = pr.author_id", r.usersTableName)).
+		Where(sq.Eq{"au.team_name": teamName})
+}
+
+func inWindow(column string, filter StatisticsFilter) sq.And {
+	return sq.And{sq.GtOrEq{column: filter.From}, sq.Lt{column: filter.To}}
+}
+
+func percentiles(seconds string) []string {
+	return []string{
+		"COUNT(*) AS count",
+		fmt.Sprintf("percentile_cont(0.5) WITHIN GROUP (ORDER BY %s) AS p50", seconds),
+		fmt.Sprintf("percentile_cont(0.9) WITHIN GROUP (ORDER BY %s) AS p90", seconds),
+		fmt.Sprintf("percentile_cont(0.95) WITHIN GROUP (ORDER BY %s) AS p95", seconds),
+	}
+}
+
+func (r *Repository) getAssignmentsByUser(ctx context.Context, filter StatisticsFilter) ([]UserAssignmentStats, error) {
+	builder := st.
+		Select("r.reviewer_id AS user_id", "COUNT(*) AS count").
+		From(fmt.Sprintf("%s r", r.reviewersTableName)).
+		Join(fmt.Sprintf("%s pr ON pr.pr_id = r.pr_id", r.tableName)).
+		Where(inWindow("pr.created_at", filter))
+
+	query, args, err := r.withAuthorTeam(builder, filter.TeamName).
+		GroupBy("r.reviewer_id").
+		OrderBy("count DESC", "user_id ASC").
+		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	assignmentsByPR, err := r.getAssignmentsByPR(ctx)
+	stats := []UserAssignmentStats{}
+	err = r.db.SelectContext(ctx, &stats, query, args...)
 	if err != nil {
 		return nil, err
 	}
 
-	return &Statistics{
-		AssignmentsByUser: assignmentsByUser,
-		AssignmentsByPR:   assignmentsByPR,
-	}, nil
+	return stats, nil
 }
 
-func (r *Repository) getAssignmentsByUser(ctx context.Context) ([]UserAssignmentStats, error) {
-	query, args, err := st.
-		Select("reviewer_id AS user_id", "COUNT(*) AS count").
-		From(r.reviewersTableName).
-		GroupBy("reviewer_id").
-		OrderBy("count DESC").
+func (r *Repository) getAssignmentsByPR(ctx context.Context, filter StatisticsFilter) ([]PRAssignmentStats, error) {
+	builder := st.
+		Select("r.pr_id AS pull_request_id", "COUNT(*) AS count").
+		From(fmt.Sprintf("%s r", r.reviewersTableName)).
+		Join(fmt.Sprintf("%s pr ON pr.pr_id = r.pr_id", r.tableName)).
+		Where(inWindow("pr.created_at", filter))
+
+	query, args, err := r.withAuthorTeam(builder, filter.TeamName).
+		GroupBy("r.pr_id").
+		OrderBy("count DESC", "pull_request_id ASC").
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var stats []UserAssignmentStats
+	stats := []PRAssignmentStats{}
 	err = r.db.SelectContext(ctx, &stats, query, args...)
 	if err != nil {
 		return nil, err
@@ -56,18 +184,178 @@ func (r *Repository) getAssignmentsByUser(ctx context.Context) ([]UserAssignment
 	return stats, nil
 }
 
-func (r *Repository) getAssignmentsByPR(ctx context.Context) ([]PRAssignmentStats, error) {
-	query, args, err := st.
-		Select("pr_id AS pull_request_id", "COUNT(*) AS count").
-		From(r.reviewersTableName).
-		GroupBy("pr_id").
-		OrderBy("count DESC").
+// getTimeToMerge measures created_at to merged_at of PRs merged in the window.
+func (r *Repository) getTimeToMerge(ctx context.Context, filter StatisticsFilter) (DurationPercentiles, error) {
+	builder := st.
+		Select(percentiles("EXTRACT(EPOCH FROM pr.merged_at - pr.created_at)")...).
+		From(fmt.Sprintf("%s pr", r.tableName)).
+		Where(inWindow("pr.merged_at", filter))
+
+	query, args, err := r.withAuthorTeam(builder, filter.TeamName).ToSql()
+	if err != nil {
+		return DurationPercentiles{}, err
+	}
+
+	var stats DurationPercentiles
+	err = r.db.GetContext(ctx, &stats, query, args...)
+	if err != nil {
+		return DurationPercentiles{}, err
+	}
+
+	return stats, nil
+}
+
+// getReviewLatency measures the time from a reviewer's assignment to their last
+// review for reviews left in the window. assigned_at restarts on reassignment;
+// for assignments older than the column it was backfilled from the assignment
+// log, or the PR creation time.
+func (r *Repository) getReviewLatency(ctx context.Context, filter StatisticsFilter) (DurationPercentiles, error) {
+	builder := st.
+		Select(percentiles("EXTRACT(EPOCH FROM r.reviewed_at - r.assigned_at)")...).
+		From(fmt.Sprintf("%s r", r.reviewersTableName)).
+		Join(fmt.Sprintf("%s pr ON pr.pr_id = r.pr_id", r.tableName)).
+		Where(inWindow("r.reviewed_at", filter))
+
+	query, args, err := r.withAuthorTeam(builder, filter.TeamName).ToSql()
+	if err != nil {
+		return DurationPercentiles{}, err
+	}
+
+	var stats DurationPercentiles
+	err = r.db.GetContext(ctx, &stats, query, args...)
+	if err != nil {
+		return DurationPercentiles{}, err
+	}
+
+	return stats, nil
+}
+
+// getMergesPerWeek counts merges per ISO week (starting on Monday, UTC),
+// including weeks of the window without merges.
+func (r *Repository) getMergesPerWeek(ctx context.Context, filter StatisticsFilter) ([]WeeklyMerges, error) {
+	builder := st.
+		Select("date_trunc('week', pr.merged_at) AS week_start", "COUNT(*) AS count").
+		From(fmt.Sprintf("%s pr", r.tableName)).
+		Where(inWindow("pr.merged_at", filter))
+
+	query, args, err := r.withAuthorTeam(builder, filter.TeamName).
+		GroupBy("week_start").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []WeeklyMerges
+	err = r.db.SelectContext(ctx, &rows, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[time.Time]int, len(rows))
+	for _, row := range rows {
+		counts[row.WeekStart.UTC()] = row.Count
+	}
+
+	stats := []WeeklyMerges{}
+	for start := WeekStart(filter.From); start.Before(filter.To); start = start.Add(week) {
+		stats = append(stats, WeeklyMerges{WeekStart: start, Count: counts[start]})
+	}
+
+	return stats, nil
+}
+
+// WeekStart returns midnight UTC of the Monday of t's week, matching
+// date_trunc('week', ...).
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+// getReassignmentsByUser counts manual and deactivation reassignments in the
+// window, both away from (ReassignedFrom) and to (ReassignedTo) each user.
+func (r *Repository) getReassignmentsByUser(ctx context.Context, filter StatisticsFilter) ([]UserReassignmentStats, error) {
+	builder := st.
+		Select("v.user_id", "SUM(v.reassigned_from) AS reassigned_from", "SUM(v.reassigned_to) AS reassigned_to").
+		From(fmt.Sprintf("%s ae", r.assignmentEventsTableName)).
+		Join(fmt.Sprintf("%s pr ON pr.pr_id = ae.pr_id", r.tableName)).
+		JoinClause("CROSS JOIN LATERAL (VALUES (ae.old_reviewer_id, 1, 0), (ae.new_reviewer_id, 0, 1)) AS v(user_id, reassigned_from, reassigned_to)").
+		Where(sq.Eq{"ae.event_type": []string{models.AssignmentEventReassign, models.AssignmentEventDeactivationReassign}}).
+		Where(sq.NotEq{"v.user_id": nil}).
+		Where(inWindow("ae.created_at", filter))
+
+	query, args, err := r.withAuthorTeam(builder, filter.TeamName).
+		GroupBy("v.user_id").
+		OrderBy("reassigned_from DESC", "v.user_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := []UserReassignmentStats{}
+	err = r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// getOpenReviewLoad counts reviewable PRs assigned to each reviewer and how
+// many of them still wait for the reviewer's review. With teamName only
+// members of that team are listed.
+func (r *Repository) getOpenReviewLoad(ctx context.Context, teamName string) ([]ReviewerLoad, error) {
+	builder := st.
+		Select(
+			"r.reviewer_id AS user_id",
+			"COUNT(*) AS open_reviews",
+			fmt.Sprintf("COUNT(*) FILTER (WHERE r.review_state = '%s') AS pending_reviews", models.ReviewStatePending),
+		).
+		From(fmt.Sprintf("%s r", r.reviewersTableName)).
+		Join(fmt.Sprintf("%s pr ON pr.pr_id = r.pr_id", r.tableName)).
+		Join(fmt.Sprintf("%s s ON s.status_id = pr.status_id", r.statusTableName)).
+		Where(sq.Eq{"s.status_name": models.ReviewableStatuses})
+
+	if teamName != "" {
+		builder = builder.
+			Join(fmt.Sprintf("%s u ON u.user_id = r.reviewer_id", r.usersTableName)).
+			Where(sq.Eq{"u.team_name": teamName})
+	}
+
+	query, args, err := builder.
+		GroupBy("r.reviewer_id").
+		OrderBy("open_reviews DESC", "user_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := []ReviewerLoad{}
+	err = r.db.SelectContext(ctx, &stats, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// getPRsWithoutReviewers lists reviewable PRs that have nobody assigned.
+func (r *Repository) getPRsWithoutReviewers(ctx context.Context, teamName string) ([]PRWithoutReviewers, error) {
+	builder := st.
+		Select("pr.pr_id", "pr.pr_name", "pr.author_id", "s.status_name", "pr.created_at").
+		From(fmt.Sprintf("%s pr", r.tableName)).
+		Join(fmt.Sprintf("%s s ON s.status_id = pr.status_id", r.statusTableName)).
+		Where(sq.Eq{"s.status_name": models.ReviewableStatuses}).
+		Where(fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s r WHERE r.pr_id = pr.pr_id)", r.reviewersTableName))
+
+	query, args, err := r.withAuthorTeam(builder, teamName).
+		OrderBy("pr.created_at ASC", "pr.pr_id ASC").
 		ToSql()
 	if err != nil {
 		return nil, err
 	}
 
-	var stats []PRAssignmentStats
+	stats := []PRWithoutReviewers{}
 	err = r.db.SelectContext(ctx, &stats, query, args...)
 	if err != nil {
 		return nil, err
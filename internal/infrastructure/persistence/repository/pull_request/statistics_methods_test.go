@@ -0,0 +1,18 @@
+package pull_request_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWeekStart(t *testing.T) {
+	monday := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, monday, pull_request.WeekStart(monday))
+	assert.Equal(t, monday, pull_request.WeekStart(time.Date(2025, 10, 8, 15, 30, 0, 0, time.UTC)))
+	assert.Equal(t, monday, pull_request.WeekStart(time.Date(2025, 10, 12, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, monday, pull_request.WeekStart(time.Date(2025, 10, 6, 2, 0, 0, 0, time.FixedZone("MSK", 3*3600)).Add(24*time.Hour)))
+}
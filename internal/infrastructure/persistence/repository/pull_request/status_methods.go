@@ -4,11 +4,101 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sync"
 
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
 )
 
+// statusCache keeps the statuses table in memory: it is seeded by migrations
+// and never changes at runtime, so lookups by id or name need no round trip.
+type statusCache struct {
+	mu     sync.RWMutex
+	byID   map[int64]models.Status
+	byName map[string]models.Status
+}
+
+func newStatusCache() *statusCache {
+	return &statusCache{}
+}
+
+func (c *statusCache) get(lookup func(c *statusCache) (models.Status, bool)) (*models.Status, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, ok := lookup(c)
+	if !ok {
+		return nil, false
+	}
+	return &s, true
+}
+
+func (c *statusCache) set(statuses []models.Status) {
+	byID := make(map[int64]models.Status, len(statuses))
+	byName := make(map[string]models.Status, len(statuses))
+	for _, s := range statuses {
+		byID[s.ID] = s
+		byName[s.Name] = s
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.byID = byID
+	c.byName = byName
+}
+
+// FindStatus serves lookups by id or name from the in-memory cache, reloading
+// it once on a miss; any other specification is queried directly.
 func (r *Repository) FindStatus(ctx context.Context, spec FindSpecification) (*models.Status, error) {
+	var lookup func(c *statusCache) (models.Status, bool)
+	switch s := spec.(type) {
+	case *status.GetStatusByIDSpecification:
+		lookup = func(c *statusCache) (models.Status, bool) {
+			found, ok := c.byID[s.StatusID()]
+			return found, ok
+		}
+	case *status.GetStatusByNameSpecification:
+		lookup = func(c *statusCache) (models.Status, bool) {
+			found, ok := c.byName[s.StatusName()]
+			return found, ok
+		}
+	default:
+		return r.queryStatus(ctx, spec)
+	}
+
+	if found, ok := r.statuses.get(lookup); ok {
+		return found, nil
+	}
+
+	if err := r.loadStatuses(ctx); err != nil {
+		return nil, err
+	}
+
+	if found, ok := r.statuses.get(lookup); ok {
+		return found, nil
+	}
+	return nil, ErrStatusNotFound
+}
+
+func (r *Repository) loadStatuses(ctx context.Context) error {
+	query, args, err := st.
+		Select(r.statusColumns.ForSelect(nil)...).
+		From(r.statusTableName).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	var statuses []models.Status
+	if err := r.db.SelectContext(ctx, &statuses, query, args...); err != nil {
+		return err
+	}
+
+	r.statuses.set(statuses)
+	return nil
+}
+
+func (r *Repository) queryStatus(ctx context.Context, spec FindSpecification) (*models.Status, error) {
 	status := &models.Status{}
 
 	builder := st.
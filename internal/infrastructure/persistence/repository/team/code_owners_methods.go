@@ -0,0 +1,49 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+// GetCodeOwners returns the team's CODEOWNERS document, or an empty one when
+// the team has none.
+func (r *Repository) GetCodeOwners(ctx context.Context, teamName string) (models.CodeOwners, error) {
+	res := models.CodeOwners{TeamName: teamName}
+
+	query, args, err := st.
+		Select("team_name", "document", "updated_at").
+		From(r.codeOwnersTable).
+		Where(sq.Eq{"team_name": teamName}).
+		ToSql()
+	if err != nil {
+		return res, err
+	}
+
+	if err := r.db.GetContext(ctx, &res, query, args...); err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return res, err
+	}
+	return res, nil
+}
+
+func (r *Repository) SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, error) {
+	var res models.CodeOwners
+
+	query, args, err := st.
+		Insert(r.codeOwnersTable).
+		Columns("team_name", "document").
+		Values(teamName, document).
+		Suffix("ON CONFLICT (team_name) DO UPDATE SET document = EXCLUDED.document, updated_at = NOW() RETURNING team_name, document, updated_at").
+		ToSql()
+	if err != nil {
+		return res, err
+	}
+
+	if err := r.db.GetContext(ctx, &res, query, args...); err != nil {
+		return res, err
+	}
+	return res, nil
+}
@@ -3,9 +3,22 @@ package team
 var (
 	writableColumns = []string{
 		"team_name",
+		"assignment_strategy",
+		"required_reviewers",
+		"max_reviewers",
+		"required_approvals",
+		"max_open_reviews",
+		"review_sla_hours",
 	}
 
 	readableColumns = []string{
 		"team_name",
+		"assignment_strategy",
+		"required_reviewers",
+		"max_reviewers",
+		"required_approvals",
+		"max_open_reviews",
+		"review_sla_hours",
+		"archived_at",
 	}
 )
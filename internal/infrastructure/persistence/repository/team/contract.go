@@ -12,6 +12,19 @@ import (
 type teamRepository interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
 
-	CreateTeam(ctx context.Context, tx *sqlx.Tx, team models.Team) (models.Team, error)
+	CreateTeam(ctx context.Context, tx *sqlx.Tx, teamName string, settings models.TeamSettings) (models.Team, error)
 	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	FindTeamByIDForUpdate(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error)
+	UpdateTeam(ctx context.Context, tx *sqlx.Tx, spec UpdateSpecification) (models.Team, error)
+
+	GetFallbackTeams(ctx context.Context, teamName string) ([]string, error)
+	SetFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbackTeams []string) error
+
+	RenameTeam(ctx context.Context, tx *sqlx.Tx, oldName, newName string) error
+	ArchiveTeam(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error)
+	InsertTeamEvents(ctx context.Context, tx *sqlx.Tx, events []models.TeamEvent) error
+	GetTeamEvents(ctx context.Context, teamName string) ([]models.TeamEvent, error)
+
+	GetCodeOwners(ctx context.Context, teamName string) (models.CodeOwners, error)
+	SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, error)
 }
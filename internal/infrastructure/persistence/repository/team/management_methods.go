@@ -0,0 +1,140 @@
+package team
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+const uniqueViolation = "23505"
+
+var teamEventFields = []string{
+	"event_id",
+	"team_name",
+	"event_type",
+	"COALESCE(user_id, '') AS user_id",
+	"COALESCE(old_value, '') AS old_value",
+	"COALESCE(new_value, '') AS new_value",
+	"COALESCE(actor, '') AS actor",
+	"created_at",
+}
+
+// RenameTeam changes the team's key. Members, fallbacks, webhook subscriptions
+// and team events follow it through ON UPDATE CASCADE; the source team of
+// reviewer assignments is plain text and is rewritten here.
+func (r *Repository) RenameTeam(ctx context.Context, tx *sqlx.Tx, oldName, newName string) error {
+	query, args, err := st.
+		Update(r.tableName).
+		Set(r.columns.GetIDField(), newName).
+		Where(sq.Eq{r.columns.GetIDField(): oldName}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+			return ErrAlreadyExists
+		}
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	query, args, err = st.
+		Update(r.reviewersTableName).
+		Set("source_team", newName).
+		Where(sq.Eq{"source_team": oldName}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+// ArchiveTeam marks the team as archived; archiving an archived team is a no-op
+// that keeps the original archived_at.
+func (r *Repository) ArchiveTeam(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error) {
+	var res models.Team
+
+	query, args, err := st.
+		Update(r.tableName).
+		Set("archived_at", sq.Expr("COALESCE(archived_at, NOW())")).
+		Where(sq.Eq{r.columns.GetIDField(): teamName}).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return res, err
+	}
+
+	if err := tx.GetContext(ctx, &res, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return res, ErrNotFound
+		}
+		return res, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) InsertTeamEvents(ctx context.Context, tx *sqlx.Tx, events []models.TeamEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := st.
+		Insert(r.eventsTableName).
+		Columns("team_name", "event_type", "user_id", "old_value", "new_value", "actor")
+
+	for _, e := range events {
+		builder = builder.Values(
+			e.TeamName,
+			e.Type,
+			sq.Expr("NULLIF(?, '')", e.UserID),
+			sq.Expr("NULLIF(?, '')", e.OldValue),
+			sq.Expr("NULLIF(?, '')", e.NewValue),
+			sq.Expr("NULLIF(?, '')", e.Actor),
+		)
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *Repository) GetTeamEvents(ctx context.Context, teamName string) ([]models.TeamEvent, error) {
+	query, args, err := st.
+		Select(teamEventFields...).
+		From(r.eventsTableName).
+		Where(sq.Eq{"team_name": teamName}).
+		OrderBy("event_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.TeamEvent, 0)
+	if err := r.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
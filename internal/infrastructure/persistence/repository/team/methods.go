@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"strings"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -18,24 +20,44 @@ var (
 	ErrAlreadyExists = errors.New("team already exists")
 )
 
-func getValues(team models.Team) []interface{} {
+type UpdateSpecification interface {
+	GetSetValues() map[string]interface{}
+	GetRule(builder sq.UpdateBuilder) sq.UpdateBuilder
+	GetReturningFields() []string
+}
+
+func getValues(teamName string, settings models.TeamSettings) []interface{} {
 	return []interface{}{
-		team.TeamName,
+		teamName,
+		valueOrDefault(settings.AssignmentStrategy),
+		valueOrDefault(settings.RequiredReviewers),
+		valueOrDefault(settings.MaxReviewers),
+		valueOrDefault(settings.RequiredApprovals),
+		valueOrDefault(settings.MaxOpenReviews),
+		valueOrDefault(settings.ReviewSLAHours),
+	}
+}
+
+func valueOrDefault[T any](value *T) interface{} {
+	if value == nil {
+		return sq.Expr("DEFAULT")
 	}
+	return *value
 }
 
-func (r *Repository) CreateTeam(ctx context.Context, tx *sqlx.Tx, team models.Team) (models.Team, error) {
-	if exists, err := r.Exists(ctx, team.TeamName); err != nil {
+// CreateTeam inserts the team, or returns it unchanged when it already exists.
+func (r *Repository) CreateTeam(ctx context.Context, tx *sqlx.Tx, teamName string, settings models.TeamSettings) (models.Team, error) {
+	if exists, err := r.Exists(ctx, teamName); err != nil {
 		return models.Team{}, err
 	} else if exists {
-		return team, nil
+		return r.FindTeamByID(ctx, teamName)
 	}
 	var res models.Team
 
 	sqlStr, params, err := st.
 		Insert(r.tableName).
 		Columns(r.columns.ForInsert()...).
-		Values(getValues(team)...).
+		Values(getValues(teamName, settings)...).
 		Suffix("RETURNING *").
 		ToSql()
 	if err != nil {
@@ -59,18 +81,29 @@ func (r *Repository) Exists(ctx context.Context, teamName string) (bool, error)
 }
 
 func (r *Repository) FindTeamByID(ctx context.Context, teamName string) (models.Team, error) {
+	return r.findTeamByID(ctx, r.db, teamName, "")
+}
+
+// FindTeamByIDForUpdate loads a team like FindTeamByID but locks its row until
+// tx ends, serializing settings changes of the same team.
+func (r *Repository) FindTeamByIDForUpdate(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error) {
+	return r.findTeamByID(ctx, tx, teamName, "FOR UPDATE")
+}
+
+func (r *Repository) findTeamByID(ctx context.Context, q sqlx.QueryerContext, teamName string, suffix string) (models.Team, error) {
 	var res models.Team
 
 	sqlStr, params, err := st.
-		Select(r.tableName).
+		Select(r.columns.ForSelect(nil)...).
 		From(r.tableName).
-		Where(sq.Eq{r.tableName: teamName}).
+		Where(sq.Eq{r.columns.GetIDField(): teamName}).
+		Suffix(suffix).
 		ToSql()
 	if err != nil {
 		return res, err
 	}
 
-	err = r.db.GetContext(ctx, &res, sqlStr, params...)
+	err = sqlx.GetContext(ctx, q, &res, sqlStr, params...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return res, ErrNotFound
@@ -78,5 +111,102 @@ func (r *Repository) FindTeamByID(ctx context.Context, teamName string) (models.
 		return res, err
 	}
 
+	res.FallbackTeams, err = r.getFallbackTeams(ctx, q, teamName)
+	if err != nil {
+		return models.Team{}, err
+	}
+
 	return res, nil
 }
+
+// GetFallbackTeams returns the team's fallback teams in order, skipping
+// archived ones.
+func (r *Repository) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	return r.getFallbackTeams(ctx, r.db, teamName)
+}
+
+func (r *Repository) getFallbackTeams(ctx context.Context, q sqlx.QueryerContext, teamName string) ([]string, error) {
+	sqlStr, params, err := st.
+		Select("f.fallback_team_name").
+		From(fmt.Sprintf("%s f", r.fallbacksTableName)).
+		Join(fmt.Sprintf("%s t ON t.team_name = f.fallback_team_name", r.tableName)).
+		Where(sq.Eq{"f.team_name": teamName}).
+		Where("t.archived_at IS NULL").
+		OrderBy("f.position ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]string, 0)
+	if err := sqlx.SelectContext(ctx, q, &res, sqlStr, params...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (r *Repository) SetFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbackTeams []string) error {
+	sqlStr, params, err := st.
+		Delete(r.fallbacksTableName).
+		Where(sq.Eq{"team_name": teamName}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, sqlStr, params...); err != nil {
+		return err
+	}
+
+	if len(fallbackTeams) == 0 {
+		return nil
+	}
+
+	builder := st.
+		Insert(r.fallbacksTableName).
+		Columns("team_name", "fallback_team_name", "position")
+	for i, fallback := range fallbackTeams {
+		builder = builder.Values(teamName, fallback, i)
+	}
+
+	sqlStr, params, err = builder.ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, sqlStr, params...); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) UpdateTeam(ctx context.Context, tx *sqlx.Tx, spec UpdateSpecification) (models.Team, error) {
+	var team models.Team
+
+	builder := st.Update(r.tableName)
+
+	for col, val := range spec.GetSetValues() {
+		builder = builder.Set(col, val)
+	}
+
+	builder = spec.GetRule(builder)
+
+	if returning := spec.GetReturningFields(); len(returning) > 0 {
+		builder = builder.Suffix(fmt.Sprintf("RETURNING %s", strings.Join(returning, ",")))
+	}
+
+	sqlStr, params, err := builder.ToSql()
+	if err != nil {
+		return team, err
+	}
+
+	err = tx.GetContext(ctx, &team, sqlStr, params...)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return team, ErrNotFound
+		}
+		return team, err
+	}
+
+	return team, nil
+}
@@ -15,6 +15,7 @@ import (
 
 	sqlx "github.com/jmoiron/sqlx"
 	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	team "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,19 +43,34 @@ func (m *MockteamRepository) EXPECT() *MockteamRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ArchiveTeam mocks base method.
+func (m *MockteamRepository) ArchiveTeam(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTeam", ctx, tx, teamName)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTeam indicates an expected call of ArchiveTeam.
+func (mr *MockteamRepositoryMockRecorder) ArchiveTeam(ctx, tx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTeam", reflect.TypeOf((*MockteamRepository)(nil).ArchiveTeam), ctx, tx, teamName)
+}
+
 // CreateTeam mocks base method.
-func (m *MockteamRepository) CreateTeam(ctx context.Context, tx *sqlx.Tx, team models.Team) (models.Team, error) {
+func (m *MockteamRepository) CreateTeam(ctx context.Context, tx *sqlx.Tx, teamName string, settings models.TeamSettings) (models.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTeam", ctx, tx, team)
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, tx, teamName, settings)
 	ret0, _ := ret[0].(models.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTeam indicates an expected call of CreateTeam.
-func (mr *MockteamRepositoryMockRecorder) CreateTeam(ctx, tx, team any) *gomock.Call {
+func (mr *MockteamRepositoryMockRecorder) CreateTeam(ctx, tx, teamName, settings any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockteamRepository)(nil).CreateTeam), ctx, tx, team)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockteamRepository)(nil).CreateTeam), ctx, tx, teamName, settings)
 }
 
 // FindTeamByID mocks base method.
@@ -72,6 +88,138 @@ func (mr *MockteamRepositoryMockRecorder) FindTeamByID(ctx, teamName any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamByID", reflect.TypeOf((*MockteamRepository)(nil).FindTeamByID), ctx, teamName)
 }
 
+// FindTeamByIDForUpdate mocks base method.
+func (m *MockteamRepository) FindTeamByIDForUpdate(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeamByIDForUpdate", ctx, tx, teamName)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeamByIDForUpdate indicates an expected call of FindTeamByIDForUpdate.
+func (mr *MockteamRepositoryMockRecorder) FindTeamByIDForUpdate(ctx, tx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamByIDForUpdate", reflect.TypeOf((*MockteamRepository)(nil).FindTeamByIDForUpdate), ctx, tx, teamName)
+}
+
+// GetCodeOwners mocks base method.
+func (m *MockteamRepository) GetCodeOwners(ctx context.Context, teamName string) (models.CodeOwners, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwners", ctx, teamName)
+	ret0, _ := ret[0].(models.CodeOwners)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwners indicates an expected call of GetCodeOwners.
+func (mr *MockteamRepositoryMockRecorder) GetCodeOwners(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwners", reflect.TypeOf((*MockteamRepository)(nil).GetCodeOwners), ctx, teamName)
+}
+
+// GetFallbackTeams mocks base method.
+func (m *MockteamRepository) GetFallbackTeams(ctx context.Context, teamName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFallbackTeams", ctx, teamName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFallbackTeams indicates an expected call of GetFallbackTeams.
+func (mr *MockteamRepositoryMockRecorder) GetFallbackTeams(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFallbackTeams", reflect.TypeOf((*MockteamRepository)(nil).GetFallbackTeams), ctx, teamName)
+}
+
+// GetTeamEvents mocks base method.
+func (m *MockteamRepository) GetTeamEvents(ctx context.Context, teamName string) ([]models.TeamEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamEvents", ctx, teamName)
+	ret0, _ := ret[0].([]models.TeamEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamEvents indicates an expected call of GetTeamEvents.
+func (mr *MockteamRepositoryMockRecorder) GetTeamEvents(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamEvents", reflect.TypeOf((*MockteamRepository)(nil).GetTeamEvents), ctx, teamName)
+}
+
+// InsertTeamEvents mocks base method.
+func (m *MockteamRepository) InsertTeamEvents(ctx context.Context, tx *sqlx.Tx, events []models.TeamEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertTeamEvents", ctx, tx, events)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertTeamEvents indicates an expected call of InsertTeamEvents.
+func (mr *MockteamRepositoryMockRecorder) InsertTeamEvents(ctx, tx, events any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertTeamEvents", reflect.TypeOf((*MockteamRepository)(nil).InsertTeamEvents), ctx, tx, events)
+}
+
+// RenameTeam mocks base method.
+func (m *MockteamRepository) RenameTeam(ctx context.Context, tx *sqlx.Tx, oldName, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTeam", ctx, tx, oldName, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTeam indicates an expected call of RenameTeam.
+func (mr *MockteamRepositoryMockRecorder) RenameTeam(ctx, tx, oldName, newName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTeam", reflect.TypeOf((*MockteamRepository)(nil).RenameTeam), ctx, tx, oldName, newName)
+}
+
+// SetCodeOwners mocks base method.
+func (m *MockteamRepository) SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCodeOwners", ctx, teamName, document)
+	ret0, _ := ret[0].(models.CodeOwners)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetCodeOwners indicates an expected call of SetCodeOwners.
+func (mr *MockteamRepositoryMockRecorder) SetCodeOwners(ctx, teamName, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCodeOwners", reflect.TypeOf((*MockteamRepository)(nil).SetCodeOwners), ctx, teamName, document)
+}
+
+// SetFallbackTeams mocks base method.
+func (m *MockteamRepository) SetFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbackTeams []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFallbackTeams", ctx, tx, teamName, fallbackTeams)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFallbackTeams indicates an expected call of SetFallbackTeams.
+func (mr *MockteamRepositoryMockRecorder) SetFallbackTeams(ctx, tx, teamName, fallbackTeams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFallbackTeams", reflect.TypeOf((*MockteamRepository)(nil).SetFallbackTeams), ctx, tx, teamName, fallbackTeams)
+}
+
+// UpdateTeam mocks base method.
+func (m *MockteamRepository) UpdateTeam(ctx context.Context, tx *sqlx.Tx, spec team.UpdateSpecification) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeam", ctx, tx, spec)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeam indicates an expected call of UpdateTeam.
+func (mr *MockteamRepositoryMockRecorder) UpdateTeam(ctx, tx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeam", reflect.TypeOf((*MockteamRepository)(nil).UpdateTeam), ctx, tx, spec)
+}
+
 // WithTx mocks base method.
 func (m *MockteamRepository) WithTx(ctx context.Context, fn func(context.Context, *sqlx.Tx) error) error {
 	m.ctrl.T.Helper()
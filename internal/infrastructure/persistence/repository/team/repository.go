@@ -12,21 +12,34 @@ const (
 	tableName = "teams"
 	alias     = "t"
 	idField   = "team_name"
+
+	fallbacksTableName = "team_fallbacks"
+	eventsTableName    = "team_events"
+	reviewersTableName = "reviewers"
+	codeOwnersTable    = "team_code_owners"
 )
 
 type Repository struct {
-	db        *sqlx.DB
-	tableName string
-	columns   *persistence.Columns
+	db                 *sqlx.DB
+	tableName          string
+	fallbacksTableName string
+	eventsTableName    string
+	reviewersTableName string
+	codeOwnersTable    string
+	columns            *persistence.Columns
 }
 
 func NewRepository(db *sqlx.DB) *Repository {
 	cols := persistence.NewColumns(readableColumns, writableColumns, alias, idField)
 
 	return &Repository{
-		db:        db,
-		tableName: tableName,
-		columns:   cols,
+		db:                 db,
+		tableName:          tableName,
+		fallbacksTableName: fallbacksTableName,
+		eventsTableName:    eventsTableName,
+		reviewersTableName: reviewersTableName,
+		codeOwnersTable:    codeOwnersTable,
+		columns:            cols,
 	}
 }
 
@@ -30,10 +30,10 @@ func TestTeamRepository_CreateTeam(t *testing.T) {
 		}
 
 		mockRepo.EXPECT().
-			CreateTeam(gomock.Any(), gomock.Any(), tm).
+			CreateTeam(gomock.Any(), gomock.Any(), tm.TeamName, models.TeamSettings{}).
 			Return(expectedTeam, nil)
 
-		result, err := mockRepo.CreateTeam(ctx, tx, tm)
+		result, err := mockRepo.CreateTeam(ctx, tx, tm.TeamName, models.TeamSettings{})
 		assert.NoError(t, err)
 		assert.Equal(t, expectedTeam.TeamName, result.TeamName)
 	})
@@ -44,10 +44,10 @@ func TestTeamRepository_CreateTeam(t *testing.T) {
 		}
 
 		mockRepo.EXPECT().
-			CreateTeam(gomock.Any(), gomock.Any(), tm).
+			CreateTeam(gomock.Any(), gomock.Any(), tm.TeamName, models.TeamSettings{}).
 			Return(tm, team.ErrAlreadyExists)
 
-		result, err := mockRepo.CreateTeam(ctx, tx, tm)
+		result, err := mockRepo.CreateTeam(ctx, tx, tm.TeamName, models.TeamSettings{})
 		assert.Error(t, err)
 		assert.True(t, errors.Is(err, team.ErrAlreadyExists))
 		assert.Equal(t, tm.TeamName, result.TeamName)
@@ -13,5 +13,6 @@ var (
 		"username",
 		"is_active",
 		"team_name",
+		"max_open_reviews",
 	}
 )
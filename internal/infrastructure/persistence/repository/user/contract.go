@@ -10,11 +10,24 @@ import (
 )
 
 type userRepository interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
 	UpsertUsers(ctx context.Context, tx *sqlx.Tx, users []models.User) ([]models.User, error)
 	GetUserByID(ctx context.Context, userID string) (models.User, error)
 	Find(ctx context.Context, spec FindSpecification) ([]models.User, error)
 	UserUpdate(ctx context.Context, spec UpdateSpecification) (models.User, error)
+	UserUpdateTx(ctx context.Context, tx *sqlx.Tx, spec UpdateSpecification) (models.User, error)
 
 	GetUserTeamName(ctx context.Context, userID string) (string, error)
 	BulkDeactivateTeamUsers(ctx context.Context, tx *sqlx.Tx, teamName string) ([]string, error)
+
+	CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error)
+	GetUnavailability(ctx context.Context, unavailabilityID int64) (models.Unavailability, error)
+	ListUnavailability(ctx context.Context, userID string) ([]models.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, unavailabilityID int64) error
+	GetStartedUnavailability(ctx context.Context) ([]models.Unavailability, error)
+	MarkUnavailabilityReassigned(ctx context.Context, tx *sqlx.Tx, unavailabilityID int64) (bool, error)
+
+	GetUserSkills(ctx context.Context, userID string) ([]string, error)
+	SetUserSkills(ctx context.Context, tx *sqlx.Tx, userID string, tags []string) error
 }
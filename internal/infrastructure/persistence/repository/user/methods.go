@@ -144,6 +144,15 @@ func (r *Repository) Find(ctx context.Context, spec FindSpecification) ([]models
 }
 
 func (r *Repository) UserUpdate(ctx context.Context, spec UpdateSpecification) (models.User, error) {
+	return r.userUpdate(ctx, r.db, spec)
+}
+
+// UserUpdateTx applies spec like UserUpdate but inside tx.
+func (r *Repository) UserUpdateTx(ctx context.Context, tx *sqlx.Tx, spec UpdateSpecification) (models.User, error) {
+	return r.userUpdate(ctx, tx, spec)
+}
+
+func (r *Repository) userUpdate(ctx context.Context, q sqlx.QueryerContext, spec UpdateSpecification) (models.User, error) {
 	var user models.User
 
 	builder := st.Update(r.tableName)
@@ -163,7 +172,7 @@ func (r *Repository) UserUpdate(ctx context.Context, spec UpdateSpecification) (
 		return user, err
 	}
 
-	err = r.db.GetContext(ctx, &user, sqlStr, params...)
+	err = sqlx.GetContext(ctx, q, &user, sqlStr, params...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return user, ErrNotFound
@@ -43,6 +43,50 @@ func (m *MockuserRepository) EXPECT() *MockuserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// BulkDeactivateTeamUsers mocks base method.
+func (m *MockuserRepository) BulkDeactivateTeamUsers(ctx context.Context, tx *sqlx.Tx, teamName string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDeactivateTeamUsers", ctx, tx, teamName)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkDeactivateTeamUsers indicates an expected call of BulkDeactivateTeamUsers.
+func (mr *MockuserRepositoryMockRecorder) BulkDeactivateTeamUsers(ctx, tx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeactivateTeamUsers", reflect.TypeOf((*MockuserRepository)(nil).BulkDeactivateTeamUsers), ctx, tx, teamName)
+}
+
+// CreateUnavailability mocks base method.
+func (m *MockuserRepository) CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnavailability", ctx, u)
+	ret0, _ := ret[0].(models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnavailability indicates an expected call of CreateUnavailability.
+func (mr *MockuserRepositoryMockRecorder) CreateUnavailability(ctx, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnavailability", reflect.TypeOf((*MockuserRepository)(nil).CreateUnavailability), ctx, u)
+}
+
+// DeleteUnavailability mocks base method.
+func (m *MockuserRepository) DeleteUnavailability(ctx context.Context, unavailabilityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnavailability", ctx, unavailabilityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnavailability indicates an expected call of DeleteUnavailability.
+func (mr *MockuserRepositoryMockRecorder) DeleteUnavailability(ctx, unavailabilityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnavailability", reflect.TypeOf((*MockuserRepository)(nil).DeleteUnavailability), ctx, unavailabilityID)
+}
+
 // Find mocks base method.
 func (m *MockuserRepository) Find(ctx context.Context, spec user.FindSpecification) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +102,36 @@ func (mr *MockuserRepositoryMockRecorder) Find(ctx, spec any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockuserRepository)(nil).Find), ctx, spec)
 }
 
+// GetStartedUnavailability mocks base method.
+func (m *MockuserRepository) GetStartedUnavailability(ctx context.Context) ([]models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStartedUnavailability", ctx)
+	ret0, _ := ret[0].([]models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStartedUnavailability indicates an expected call of GetStartedUnavailability.
+func (mr *MockuserRepositoryMockRecorder) GetStartedUnavailability(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStartedUnavailability", reflect.TypeOf((*MockuserRepository)(nil).GetStartedUnavailability), ctx)
+}
+
+// GetUnavailability mocks base method.
+func (m *MockuserRepository) GetUnavailability(ctx context.Context, unavailabilityID int64) (models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnavailability", ctx, unavailabilityID)
+	ret0, _ := ret[0].(models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnavailability indicates an expected call of GetUnavailability.
+func (mr *MockuserRepositoryMockRecorder) GetUnavailability(ctx, unavailabilityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnavailability", reflect.TypeOf((*MockuserRepository)(nil).GetUnavailability), ctx, unavailabilityID)
+}
+
 // GetUserByID mocks base method.
 func (m *MockuserRepository) GetUserByID(ctx context.Context, userID string) (models.User, error) {
 	m.ctrl.T.Helper()
@@ -73,6 +147,21 @@ func (mr *MockuserRepositoryMockRecorder) GetUserByID(ctx, userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockuserRepository)(nil).GetUserByID), ctx, userID)
 }
 
+// GetUserSkills mocks base method.
+func (m *MockuserRepository) GetUserSkills(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSkills", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSkills indicates an expected call of GetUserSkills.
+func (mr *MockuserRepositoryMockRecorder) GetUserSkills(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSkills", reflect.TypeOf((*MockuserRepository)(nil).GetUserSkills), ctx, userID)
+}
+
 // GetUserTeamName mocks base method.
 func (m *MockuserRepository) GetUserTeamName(ctx context.Context, userID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +177,50 @@ func (mr *MockuserRepositoryMockRecorder) GetUserTeamName(ctx, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTeamName", reflect.TypeOf((*MockuserRepository)(nil).GetUserTeamName), ctx, userID)
 }
 
+// ListUnavailability mocks base method.
+func (m *MockuserRepository) ListUnavailability(ctx context.Context, userID string) ([]models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnavailability", ctx, userID)
+	ret0, _ := ret[0].([]models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnavailability indicates an expected call of ListUnavailability.
+func (mr *MockuserRepositoryMockRecorder) ListUnavailability(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnavailability", reflect.TypeOf((*MockuserRepository)(nil).ListUnavailability), ctx, userID)
+}
+
+// MarkUnavailabilityReassigned mocks base method.
+func (m *MockuserRepository) MarkUnavailabilityReassigned(ctx context.Context, tx *sqlx.Tx, unavailabilityID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkUnavailabilityReassigned", ctx, tx, unavailabilityID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkUnavailabilityReassigned indicates an expected call of MarkUnavailabilityReassigned.
+func (mr *MockuserRepositoryMockRecorder) MarkUnavailabilityReassigned(ctx, tx, unavailabilityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUnavailabilityReassigned", reflect.TypeOf((*MockuserRepository)(nil).MarkUnavailabilityReassigned), ctx, tx, unavailabilityID)
+}
+
+// SetUserSkills mocks base method.
+func (m *MockuserRepository) SetUserSkills(ctx context.Context, tx *sqlx.Tx, userID string, tags []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserSkills", ctx, tx, userID, tags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserSkills indicates an expected call of SetUserSkills.
+func (mr *MockuserRepositoryMockRecorder) SetUserSkills(ctx, tx, userID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserSkills", reflect.TypeOf((*MockuserRepository)(nil).SetUserSkills), ctx, tx, userID, tags)
+}
+
 // UpsertUsers mocks base method.
 func (m *MockuserRepository) UpsertUsers(ctx context.Context, tx *sqlx.Tx, users []models.User) ([]models.User, error) {
 	m.ctrl.T.Helper()
@@ -117,3 +250,32 @@ func (mr *MockuserRepositoryMockRecorder) UserUpdate(ctx, spec any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUpdate", reflect.TypeOf((*MockuserRepository)(nil).UserUpdate), ctx, spec)
 }
+
+// UserUpdateTx mocks base method.
+func (m *MockuserRepository) UserUpdateTx(ctx context.Context, tx *sqlx.Tx, spec user.UpdateSpecification) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UserUpdateTx", ctx, tx, spec)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UserUpdateTx indicates an expected call of UserUpdateTx.
+func (mr *MockuserRepositoryMockRecorder) UserUpdateTx(ctx, tx, spec any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUpdateTx", reflect.TypeOf((*MockuserRepository)(nil).UserUpdateTx), ctx, tx, spec)
+}
+
+// WithTx mocks base method.
+func (m *MockuserRepository) WithTx(ctx context.Context, fn func(context.Context, *sqlx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockuserRepositoryMockRecorder) WithTx(ctx, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockuserRepository)(nil).WithTx), ctx, fn)
+}
@@ -1,6 +1,9 @@
 package user
 
 import (
+	"context"
+	"log"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence"
 )
@@ -9,12 +12,18 @@ const (
 	tableName = "users"
 	alias     = "u"
 	idField   = "user_id"
+
+	unavailabilityTableName = "user_unavailability"
+	skillsTableName         = "user_skills"
 )
 
 type Repository struct {
 	db        *sqlx.DB
 	tableName string
 	columns   *persistence.Columns
+
+	unavailabilityTableName string
+	skillsTableName         string
 }
 
 func NewRepository(db *sqlx.DB) *Repository {
@@ -24,5 +33,26 @@ func NewRepository(db *sqlx.DB) *Repository {
 		db:        db,
 		tableName: tableName,
 		columns:   cols,
+
+		unavailabilityTableName: unavailabilityTableName,
+		skillsTableName:         skillsTableName,
+	}
+}
+
+func (r *Repository) WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
 	}
+	defer func() {
+		if err := tx.Rollback(); err != nil {
+			log.Printf("rollback transaction: %v", err)
+		}
+	}()
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
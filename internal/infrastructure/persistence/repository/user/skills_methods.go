@@ -0,0 +1,62 @@
+package user
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+)
+
+// GetUserSkills returns the user's skill tags in name order.
+func (r *Repository) GetUserSkills(ctx context.Context, userID string) ([]string, error) {
+	query, args, err := st.
+		Select("tag").
+		From(r.skillsTableName).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("tag ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	res := []string{}
+	if err := r.db.SelectContext(ctx, &res, query, args...); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetUserSkills replaces the user's skill tags with tags.
+func (r *Repository) SetUserSkills(ctx context.Context, tx *sqlx.Tx, userID string, tags []string) error {
+	query, args, err := st.
+		Delete(r.skillsTableName).
+		Where(sq.Eq{"user_id": userID}).
+		Where(sq.NotEq{"tag": tags}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+		return err
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	builder := st.
+		Insert(r.skillsTableName).
+		Columns("user_id", "tag")
+	for _, tag := range tags {
+		builder = builder.Values(userID, tag)
+	}
+
+	query, args, err = builder.Suffix("ON CONFLICT DO NOTHING").ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, query, args...)
+	return err
+}
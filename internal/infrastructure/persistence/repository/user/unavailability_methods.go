@@ -0,0 +1,152 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+var ErrUnavailabilityNotFound = errors.New("unavailability not found")
+
+func (r *Repository) CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error) {
+	var created models.Unavailability
+
+	query, args, err := st.
+		Insert(r.unavailabilityTableName).
+		Columns("user_id", "starts_at", "ends_at", "reason", "reassign_reviews").
+		Values(u.UserID, u.StartsAt, u.EndsAt, u.Reason, u.ReassignReviews).
+		Suffix("RETURNING *").
+		ToSql()
+	if err != nil {
+		return created, err
+	}
+
+	if err := r.db.GetContext(ctx, &created, query, args...); err != nil {
+		return created, err
+	}
+
+	return created, nil
+}
+
+func (r *Repository) GetUnavailability(ctx context.Context, unavailabilityID int64) (models.Unavailability, error) {
+	var u models.Unavailability
+
+	query, args, err := st.
+		Select("*").
+		From(r.unavailabilityTableName).
+		Where(sq.Eq{"unavailability_id": unavailabilityID}).
+		ToSql()
+	if err != nil {
+		return u, err
+	}
+
+	if err := r.db.GetContext(ctx, &u, query, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return u, ErrUnavailabilityNotFound
+		}
+		return u, err
+	}
+
+	return u, nil
+}
+
+// ListUnavailability returns the current and future periods of a user.
+func (r *Repository) ListUnavailability(ctx context.Context, userID string) ([]models.Unavailability, error) {
+	query, args, err := st.
+		Select("*").
+		From(r.unavailabilityTableName).
+		Where(sq.Eq{"user_id": userID}).
+		Where("ends_at > NOW()").
+		OrderBy("starts_at ASC", "unavailability_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	periods := []models.Unavailability{}
+	if err := r.db.SelectContext(ctx, &periods, query, args...); err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+func (r *Repository) DeleteUnavailability(ctx context.Context, unavailabilityID int64) error {
+	query, args, err := st.
+		Delete(r.unavailabilityTableName).
+		Where(sq.Eq{"unavailability_id": unavailabilityID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	res, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrUnavailabilityNotFound
+	}
+
+	return nil
+}
+
+// GetStartedUnavailability returns the periods in effect whose reviews are to
+// be reassigned and have not been yet.
+func (r *Repository) GetStartedUnavailability(ctx context.Context) ([]models.Unavailability, error) {
+	query, args, err := st.
+		Select("*").
+		From(r.unavailabilityTableName).
+		Where(sq.Eq{"reassign_reviews": true}).
+		Where(sq.Eq{"reassigned_at": nil}).
+		Where("starts_at <= NOW()").
+		Where("ends_at > NOW()").
+		OrderBy("starts_at ASC", "unavailability_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []models.Unavailability
+	if err := r.db.SelectContext(ctx, &periods, query, args...); err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// MarkUnavailabilityReassigned claims a period for reassignment within tx.
+// It reports false when the period was already handled, e.g. by another
+// instance of the service.
+func (r *Repository) MarkUnavailabilityReassigned(ctx context.Context, tx *sqlx.Tx, unavailabilityID int64) (bool, error) {
+	query, args, err := st.
+		Update(r.unavailabilityTableName).
+		Set("reassigned_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"unavailability_id": unavailabilityID}).
+		Where(sq.Eq{"reassigned_at": nil}).
+		ToSql()
+	if err != nil {
+		return false, err
+	}
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
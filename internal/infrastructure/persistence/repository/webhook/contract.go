@@ -0,0 +1,23 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type webhookRepository interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	CreateSubscription(ctx context.Context, tx *sqlx.Tx, sub models.WebhookSubscription) (models.WebhookSubscription, error)
+	ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error)
+	DeleteSubscription(ctx context.Context, subscriptionID int64) error
+
+	ClaimDeliveries(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]models.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, deliveryID int64) error
+	MarkFailed(ctx context.Context, deliveryID int64, retryAfter time.Duration, lastError string) error
+}
@@ -0,0 +1,226 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+var st = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+var ErrNotFound = errors.New("webhook subscription not found")
+
+func (r *Repository) CreateSubscription(ctx context.Context, tx *sqlx.Tx, sub models.WebhookSubscription) (models.WebhookSubscription, error) {
+	var res models.WebhookSubscription
+
+	query, args, err := st.
+		Insert(r.subscriptionsTableName).
+		Columns("url", "secret", "team_name").
+		Values(sub.URL, sub.Secret, sq.Expr("NULLIF(?, '')", sub.TeamName)).
+		Suffix("RETURNING subscription_id, url, secret, COALESCE(team_name, '') AS team_name, created_at").
+		ToSql()
+	if err != nil {
+		return res, err
+	}
+
+	if err := tx.GetContext(ctx, &res, query, args...); err != nil {
+		return res, err
+	}
+
+	if len(sub.EventTypes) > 0 {
+		builder := st.
+			Insert(r.subscriptionEventsTableName).
+			Columns("subscription_id", "event_type")
+		for _, eventType := range sub.EventTypes {
+			builder = builder.Values(res.ID, eventType)
+		}
+
+		query, args, err = builder.ToSql()
+		if err != nil {
+			return res, err
+		}
+
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return res, err
+		}
+	}
+	res.EventTypes = sub.EventTypes
+
+	return res, nil
+}
+
+func (r *Repository) ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	query, args, err := st.
+		Select("subscription_id", "url", "secret", "COALESCE(team_name, '') AS team_name", "created_at").
+		From(r.subscriptionsTableName).
+		OrderBy("subscription_id ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]models.WebhookSubscription, 0)
+	if err := r.db.SelectContext(ctx, &subs, query, args...); err != nil {
+		return nil, err
+	}
+
+	query, args, err = st.
+		Select("subscription_id", "event_type").
+		From(r.subscriptionEventsTableName).
+		OrderBy("subscription_id ASC", "event_type ASC").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		SubscriptionID int64  `db:"subscription_id"`
+		EventType      string `db:"event_type"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	eventTypes := make(map[int64][]string)
+	for _, row := range rows {
+		eventTypes[row.SubscriptionID] = append(eventTypes[row.SubscriptionID], row.EventType)
+	}
+	for i := range subs {
+		subs[i].EventTypes = eventTypes[subs[i].ID]
+	}
+
+	return subs, nil
+}
+
+func (r *Repository) DeleteSubscription(ctx context.Context, subscriptionID int64) error {
+	query, args, err := st.
+		Delete(r.subscriptionsTableName).
+		Where(sq.Eq{"subscription_id": subscriptionID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ClaimDeliveries picks due undelivered outbox entries that have not used up
+// maxAttempts and pushes their next attempt forward by lease, so concurrent
+// dispatchers skip them and a crashed dispatcher's entries are retried once the
+// lease expires.
+func (r *Repository) ClaimDeliveries(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	var res []models.WebhookDelivery
+
+	err := r.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		query, args, err := st.
+			Select(
+				"o.delivery_id",
+				"o.attempts",
+				"s.url",
+				"s.secret",
+				"u.team_name AS author_team",
+				"e.event_id",
+				"e.pr_id",
+				"e.event_type",
+				"COALESCE(e.old_reviewer_id, '') AS old_reviewer_id",
+				"COALESCE(e.new_reviewer_id, '') AS new_reviewer_id",
+				"COALESCE(e.actor, '') AS actor",
+				"e.reason",
+				"e.created_at",
+			).
+			From(fmt.Sprintf("%s o", r.outboxTableName)).
+			Join(fmt.Sprintf("%s s ON s.subscription_id = o.subscription_id", r.subscriptionsTableName)).
+			Join("assignment_events e ON e.event_id = o.event_id").
+			Join("pull_requests pr ON pr.pr_id = e.pr_id").
+			Join("users u ON u.user_id = pr.author_id").
+			Where("o.delivered_at IS NULL").
+			Where("o.next_attempt_at <= NOW()").
+			Where(sq.Lt{"o.attempts": maxAttempts}).
+			OrderBy("o.next_attempt_at ASC", "o.delivery_id ASC").
+			Limit(uint64(limit)).
+			Suffix("FOR UPDATE OF o SKIP LOCKED").
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		if err := tx.SelectContext(ctx, &res, query, args...); err != nil {
+			return err
+		}
+		if len(res) == 0 {
+			return nil
+		}
+
+		ids := make([]int64, len(res))
+		for i, d := range res {
+			ids[i] = d.ID
+		}
+
+		query, args, err = st.
+			Update(r.outboxTableName).
+			Set("next_attempt_at", sq.Expr("NOW() + make_interval(secs => ?)", lease.Seconds())).
+			Where(sq.Eq{"delivery_id": ids}).
+			ToSql()
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, query, args...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (r *Repository) MarkDelivered(ctx context.Context, deliveryID int64) error {
+	query, args, err := st.
+		Update(r.outboxTableName).
+		Set("delivered_at", sq.Expr("NOW()")).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("last_error", "").
+		Where(sq.Eq{"delivery_id": deliveryID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
+
+func (r *Repository) MarkFailed(ctx context.Context, deliveryID int64, retryAfter time.Duration, lastError string) error {
+	query, args, err := st.
+		Update(r.outboxTableName).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("next_attempt_at", sq.Expr("NOW() + make_interval(secs => ?)", retryAfter.Seconds())).
+		Set("last_error", lastError).
+		Where(sq.Eq{"delivery_id": deliveryID}).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx, query, args...)
+	return err
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	subscriptionsTableName      = "webhook_subscriptions"
+	subscriptionEventsTableName = "webhook_subscription_events"
+	outboxTableName             = "webhook_outbox"
+)
+
+type Repository struct {
+	db                          *sqlx.DB
+	subscriptionsTableName      string
+	subscriptionEventsTableName string
+	outboxTableName             string
+}
+
+func NewRepository(db *sqlx.DB) *Repository {
+	return &Repository{
+		db:                          db,
+		subscriptionsTableName:      subscriptionsTableName,
+		subscriptionEventsTableName: subscriptionEventsTableName,
+		outboxTableName:             outboxTableName,
+	}
+}
+
+func (r *Repository) WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil {
+			log.Printf("rollback transaction: %v", err)
+		}
+	}()
+
+	if err := fn(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -0,0 +1,59 @@
+package pr_spec
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+const dueAt = "r.assigned_at + make_interval(hours => t.review_sla_hours)"
+
+type GetOverdueReviewsSpecification struct {
+	TeamName    string
+	Unescalated bool
+}
+
+// NewGetOverdueReviewsSpecification selects pending assignments on reviewable
+// pull requests that are past the review SLA of the author's team, oldest
+// first. An empty teamName covers all teams.
+func NewGetOverdueReviewsSpecification(teamName string, unescalated bool) *GetOverdueReviewsSpecification {
+	return &GetOverdueReviewsSpecification{
+		TeamName:    teamName,
+		Unescalated: unescalated,
+	}
+}
+
+func (s *GetOverdueReviewsSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
+	builder = builder.
+		From("pull_requests pr").
+		Join("statuses s ON s.status_id = pr.status_id").
+		Join("users u ON u.user_id = pr.author_id").
+		Join("teams t ON t.team_name = u.team_name").
+		Join("reviewers r ON r.pr_id = pr.pr_id").
+		Where(sq.Eq{"s.status_name": models.ReviewableStatuses}).
+		Where(sq.Eq{"r.review_state": models.ReviewStatePending}).
+		Where(sq.Gt{"t.review_sla_hours": 0}).
+		Where(dueAt + " < CURRENT_TIMESTAMP")
+
+	if s.TeamName != "" {
+		builder = builder.Where(sq.Eq{"u.team_name": s.TeamName})
+	}
+	if s.Unescalated {
+		builder = builder.Where(sq.Eq{"r.escalated_at": nil})
+	}
+
+	return builder.OrderBy("due_at ASC", "pr.pr_id ASC", "r.reviewer_id ASC")
+}
+
+func (s *GetOverdueReviewsSpecification) GetFields() []string {
+	return []string{
+		"pr.pr_id",
+		"pr.pr_name",
+		"pr.author_id",
+		"u.team_name",
+		"r.reviewer_id",
+		"r.assigned_at",
+		dueAt + " AS due_at",
+		"t.review_sla_hours",
+		"r.escalated_at",
+	}
+}
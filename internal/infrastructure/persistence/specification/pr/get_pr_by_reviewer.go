@@ -13,9 +13,13 @@ func NewGetPRByReviewerSpecification(reviewerID string) *GetPRByReviewerSpecific
 }
 
 func (s *GetPRByReviewerSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
-	return builder.Where(sq.Eq{"reviewer_id": s.ReviewerID})
+	return builder.
+		From("pull_requests pr").
+		Join("reviewers r ON r.pr_id = pr.pr_id").
+		Where(sq.Eq{"r.reviewer_id": s.ReviewerID}).
+		OrderBy("pr.pr_id ASC")
 }
 
 func (s *GetPRByReviewerSpecification) GetFields() []string {
-	return []string{"pr_id"}
+	return []string{"pr.pr_id", "pr.pr_name", "pr.author_id", "pr.status_id"}
 }
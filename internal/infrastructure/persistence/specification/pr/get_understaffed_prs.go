@@ -0,0 +1,45 @@
+package pr_spec
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type GetUnderstaffedPRsSpecification struct {
+	TeamName string
+}
+
+func NewGetUnderstaffedPRsSpecification(teamName string) *GetUnderstaffedPRsSpecification {
+	return &GetUnderstaffedPRsSpecification{
+		TeamName: teamName,
+	}
+}
+
+func (s *GetUnderstaffedPRsSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
+	builder = builder.
+		From("pull_requests pr").
+		Join("statuses s ON s.status_id = pr.status_id").
+		Join("users u ON u.user_id = pr.author_id").
+		Join("teams t ON t.team_name = u.team_name").
+		LeftJoin("reviewers r ON r.pr_id = pr.pr_id").
+		Where(sq.Eq{"s.status_name": models.ReviewableStatuses})
+
+	if s.TeamName != "" {
+		builder = builder.Where(sq.Eq{"u.team_name": s.TeamName})
+	}
+
+	return builder.
+		GroupBy("pr.pr_id", "pr.author_id", "u.team_name", "t.max_reviewers").
+		Having("COUNT(r.reviewer_id) < t.max_reviewers").
+		OrderBy("pr.pr_id ASC")
+}
+
+func (s *GetUnderstaffedPRsSpecification) GetFields() []string {
+	return []string{
+		"pr.pr_id",
+		"pr.author_id",
+		"u.team_name",
+		"t.max_reviewers AS target_reviewers",
+		"COUNT(r.reviewer_id) AS reviewer_count",
+	}
+}
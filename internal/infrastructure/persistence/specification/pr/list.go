@@ -0,0 +1,107 @@
+package pr_spec
+
+import (
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Cursor points at the last PR of the previous page in (created_at DESC, pr_id DESC) order.
+type Cursor struct {
+	CreatedAt time.Time
+	PRID      string
+}
+
+// ListFilter narrows the PR list. Zero values are ignored; TeamName is the
+// author's team, date ranges are [from, to).
+type ListFilter struct {
+	Statuses     []string
+	AuthorID     string
+	ReviewerID   string
+	TeamName     string
+	NameContains string
+	CreatedFrom  *time.Time
+	CreatedTo    *time.Time
+	MergedFrom   *time.Time
+	MergedTo     *time.Time
+}
+
+type ListSpecification struct {
+	Filter ListFilter
+	After  *Cursor
+	Limit  uint64
+}
+
+func NewListSpecification(filter ListFilter, after *Cursor, limit uint64) *ListSpecification {
+	return &ListSpecification{
+		Filter: filter,
+		After:  after,
+		Limit:  limit,
+	}
+}
+
+func (s *ListSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
+	builder = builder.
+		From("pull_requests pr").
+		Join("statuses s ON s.status_id = pr.status_id")
+
+	f := s.Filter
+	if len(f.Statuses) > 0 {
+		builder = builder.Where(sq.Eq{"s.status_name": f.Statuses})
+	}
+	if f.AuthorID != "" {
+		builder = builder.Where(sq.Eq{"pr.author_id": f.AuthorID})
+	}
+	if f.ReviewerID != "" {
+		builder = builder.Where("EXISTS (SELECT 1 FROM reviewers r WHERE r.pr_id = pr.pr_id AND r.reviewer_id = ?)", f.ReviewerID)
+	}
+	if f.TeamName != "" {
+		builder = builder.
+			Join("users u ON u.user_id = pr.author_id").
+			Where(sq.Eq{"u.team_name": f.TeamName})
+	}
+	if f.NameContains != "" {
+		builder = builder.Where("pr.pr_name ILIKE ? ESCAPE '\\'", "%"+escapeLike(f.NameContains)+"%")
+	}
+	if f.CreatedFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"pr.created_at": *f.CreatedFrom})
+	}
+	if f.CreatedTo != nil {
+		builder = builder.Where(sq.Lt{"pr.created_at": *f.CreatedTo})
+	}
+	if f.MergedFrom != nil {
+		builder = builder.Where(sq.GtOrEq{"pr.merged_at": *f.MergedFrom})
+	}
+	if f.MergedTo != nil {
+		builder = builder.Where(sq.Lt{"pr.merged_at": *f.MergedTo})
+	}
+
+	if s.After != nil {
+		builder = builder.Where("(pr.created_at, pr.pr_id) < (?, ?)", s.After.CreatedAt, s.After.PRID)
+	}
+
+	return builder.
+		OrderBy("pr.created_at DESC", "pr.pr_id DESC").
+		Limit(s.Limit)
+}
+
+func (s *ListSpecification) GetFields() []string {
+	return []string{
+		"pr.pr_id",
+		"pr.pr_name",
+		"pr.author_id",
+		"pr.status_id",
+		"pr.created_at",
+		"pr.merged_at",
+		"pr.merged_by",
+		"pr.closed_at",
+		"s.status_name",
+	}
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,43 @@
+package pr_spec
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+// MergeSpecification moves a PR to MERGED. It only matches PRs that are not merged
+// yet, so merged_at and merged_by are written exactly once.
+type MergeSpecification struct {
+	Status        *models.Status
+	MergedBy      string
+	pullRequestID string
+}
+
+func NewMergeSpecification(status *models.Status, pullRequestID, mergedBy string) *MergeSpecification {
+	return &MergeSpecification{
+		Status:        status,
+		MergedBy:      mergedBy,
+		pullRequestID: pullRequestID,
+	}
+}
+
+func (s *MergeSpecification) GetSetValues() map[string]interface{} {
+	result := map[string]interface{}{
+		"status_id": s.Status.ID,
+		"merged_at": sq.Expr("NOW()"),
+	}
+	if s.MergedBy != "" {
+		result["merged_by"] = s.MergedBy
+	}
+	return result
+}
+
+func (s *MergeSpecification) GetRule(builder sq.UpdateBuilder) sq.UpdateBuilder {
+	return builder.
+		Where(sq.Eq{"pr_id": s.pullRequestID}).
+		Where(sq.NotEq{"status_id": s.Status.ID})
+}
+
+func (s *MergeSpecification) GetReturningFields() []string {
+	return []string{"*"}
+}
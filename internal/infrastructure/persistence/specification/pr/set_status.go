@@ -21,8 +21,13 @@ func (s *SetStatusSpecification) GetSetValues() map[string]interface{} {
 	result := map[string]interface{}{
 		"status_id": s.Status.ID,
 	}
-	if s.Status.Name == "MERGED" {
+	switch s.Status.Name {
+	case models.StatusMerged:
 		result["merged_at"] = sq.Expr("COALESCE(merged_at, NOW())")
+	case models.StatusClosed:
+		result["closed_at"] = sq.Expr("NOW()")
+	case models.StatusReopened:
+		result["closed_at"] = nil
 	}
 	return result
 }
@@ -1,36 +1,103 @@
 package reviewer
 
-import sq "github.com/Masterminds/squirrel"
+import (
+	"fmt"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// openReviewsLoad counts reviewable pull requests currently assigned to each reviewer.
+const openReviewsLoad = `(
+	SELECT r.reviewer_id, COUNT(*) AS open_reviews
+	FROM %s r
+	JOIN %s pr ON pr.pr_id = r.pr_id
+	JOIN %s s ON s.status_id = pr.status_id
+	WHERE s.status_name IN ('OPEN', 'READY_FOR_REVIEW', 'REOPENED')
+	GROUP BY r.reviewer_id
+) l ON l.reviewer_id = u.user_id`
+
+// notUnavailable skips users with an unavailability period in effect.
+const notUnavailable = `NOT EXISTS (
+	SELECT 1 FROM %s ua
+	WHERE ua.user_id = u.user_id AND ua.starts_at <= NOW() AND ua.ends_at > NOW()
+)`
+
+// maxOpenReviews is the effective open review limit of a user: their own one
+// or, when unset, the team default. 0 means no limit.
+const maxOpenReviews = `COALESCE(u.max_open_reviews, t.max_open_reviews)`
+
+// AvailabilityTables names the tables read besides FromTable: the teams, the
+// assignments with their pull requests and statuses for the review load, and
+// the unavailability periods.
+type AvailabilityTables struct {
+	Teams          string
+	Reviewers      string
+	PullRequests   string
+	Statuses       string
+	Unavailability string
+}
 
 type GetAvailableReviewersSpecification struct {
 	ExcludeIDs []string
 	TeamName   string
-	Limit      int
 	FromTable  string
+	Tables     AvailabilityTables
+	// AtCapacity selects the users who would be available if they were not
+	// at their open review limit instead of the available ones.
+	AtCapacity bool
 }
 
-func NewGetAvailableReviewersSpecification(teamName string, excludeIDs []string, limit int, fromTable string) *GetAvailableReviewersSpecification {
+func NewGetAvailableReviewersSpecification(teamName string, excludeIDs []string, fromTable string, tables AvailabilityTables) *GetAvailableReviewersSpecification {
 	return &GetAvailableReviewersSpecification{
 		ExcludeIDs: excludeIDs,
 		TeamName:   teamName,
-		Limit:      limit,
 
 		FromTable: fromTable,
+		Tables:    tables,
 	}
 }
 
+// NewGetReviewersAtCapacitySpecification is like
+// NewGetAvailableReviewersSpecification but selects the users skipped only
+// because of their open review limit.
+func NewGetReviewersAtCapacitySpecification(teamName string, excludeIDs []string, fromTable string, tables AvailabilityTables) *GetAvailableReviewersSpecification {
+	spec := NewGetAvailableReviewersSpecification(teamName, excludeIDs, fromTable, tables)
+	spec.AtCapacity = true
+	return spec
+}
+
+// GetRule selects the available members of TeamName, or of every team when
+// TeamName is empty.
 func (s *GetAvailableReviewersSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
-	builder = builder.From(s.FromTable).
-		Where(sq.Eq{"team_name": s.TeamName}).
-		Where(sq.Eq{"is_active": true})
+	builder = builder.From(fmt.Sprintf("%s u", s.FromTable)).
+		Join(fmt.Sprintf("%s t ON t.team_name = u.team_name", s.Tables.Teams)).
+		LeftJoin(fmt.Sprintf(openReviewsLoad, s.Tables.Reviewers, s.Tables.PullRequests, s.Tables.Statuses)).
+		Where(sq.Eq{"u.is_active": true}).
+		Where(fmt.Sprintf(notUnavailable, s.Tables.Unavailability))
+
+	underLimit := fmt.Sprintf("(%[1]s = 0 OR COALESCE(l.open_reviews, 0) < %[1]s)", maxOpenReviews)
+	if s.AtCapacity {
+		builder = builder.Where("NOT " + underLimit)
+	} else {
+		builder = builder.Where(underLimit)
+	}
+
+	if s.TeamName != "" {
+		builder = builder.Where(sq.Eq{"u.team_name": s.TeamName})
+	}
 
 	if len(s.ExcludeIDs) > 0 {
-		builder = builder.Where(sq.NotEq{"user_id": s.ExcludeIDs})
+		builder = builder.Where(sq.NotEq{"u.user_id": s.ExcludeIDs})
 	}
 
-	return builder.Limit(uint64(s.Limit))
+	return builder.OrderBy("open_reviews ASC", "u.user_id ASC")
 }
 
 func (s *GetAvailableReviewersSpecification) GetFields() []string {
-	return []string{"user_id"}
+	return []string{
+		"u.user_id",
+		"u.team_name",
+		"COALESCE(l.open_reviews, 0) AS open_reviews",
+		maxOpenReviews + " AS max_open_reviews",
+	}
 }
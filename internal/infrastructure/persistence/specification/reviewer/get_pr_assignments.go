@@ -0,0 +1,23 @@
+package reviewer
+
+import sq "github.com/Masterminds/squirrel"
+
+type GetPRAssignmentsSpecification struct {
+	PullRequestID string
+	FromTable     string
+}
+
+func NewGetPRAssignmentsSpecification(pullRequestID string, fromTable string) *GetPRAssignmentsSpecification {
+	return &GetPRAssignmentsSpecification{
+		PullRequestID: pullRequestID,
+		FromTable:     fromTable,
+	}
+}
+
+func (s *GetPRAssignmentsSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
+	return builder.From(s.FromTable).Where(sq.Eq{"pr_id": s.PullRequestID}).OrderBy("reviewer_id ASC")
+}
+
+func (s *GetPRAssignmentsSpecification) GetFields() []string {
+	return []string{"pr_id", "reviewer_id", "COALESCE(source_team, '') AS source_team", "review_state", "reviewed_at", "assigned_at", "escalated_at"}
+}
@@ -0,0 +1,23 @@
+package reviewer
+
+import sq "github.com/Masterminds/squirrel"
+
+type GetPRsAssignmentsSpecification struct {
+	PullRequestIDs []string
+	FromTable      string
+}
+
+func NewGetPRsAssignmentsSpecification(pullRequestIDs []string, fromTable string) *GetPRsAssignmentsSpecification {
+	return &GetPRsAssignmentsSpecification{
+		PullRequestIDs: pullRequestIDs,
+		FromTable:      fromTable,
+	}
+}
+
+func (s *GetPRsAssignmentsSpecification) GetRule(builder sq.SelectBuilder) sq.SelectBuilder {
+	return builder.From(s.FromTable).Where(sq.Eq{"pr_id": s.PullRequestIDs}).OrderBy("pr_id ASC", "reviewer_id ASC")
+}
+
+func (s *GetPRsAssignmentsSpecification) GetFields() []string {
+	return []string{"pr_id", "reviewer_id", "COALESCE(source_team, '') AS source_team", "review_state", "reviewed_at", "assigned_at", "escalated_at"}
+}
@@ -10,6 +10,10 @@ func NewGetStatusByIDSpecification(statusID int64) *GetStatusByIDSpecification {
 	return &GetStatusByIDSpecification{statusID: statusID}
 }
 
+func (s *GetStatusByIDSpecification) StatusID() int64 {
+	return s.statusID
+}
+
 func (s *GetStatusByIDSpecification) GetFields() []string {
 	return []string{"*"}
 }
@@ -10,6 +10,10 @@ func NewGetStatusByNameSpecification(statusName string) *GetStatusByNameSpecific
 	return &GetStatusByNameSpecification{statusName: statusName}
 }
 
+func (s *GetStatusByNameSpecification) StatusName() string {
+	return s.statusName
+}
+
 func (s *GetStatusByNameSpecification) GetFields() []string {
 	return []string{"*"}
 }
@@ -0,0 +1,56 @@
+package team_spec
+
+import sq "github.com/Masterminds/squirrel"
+
+type UpdateSettingsSpecification struct {
+	teamName           string
+	assignmentStrategy *string
+	requiredReviewers  *int
+	maxReviewers       *int
+	requiredApprovals  *int
+	maxOpenReviews     *int
+	reviewSLAHours     *int
+}
+
+func NewUpdateSettingsSpecification(teamName string, assignmentStrategy *string, requiredReviewers, maxReviewers, requiredApprovals, maxOpenReviews, reviewSLAHours *int) *UpdateSettingsSpecification {
+	return &UpdateSettingsSpecification{
+		teamName:           teamName,
+		assignmentStrategy: assignmentStrategy,
+		requiredReviewers:  requiredReviewers,
+		maxReviewers:       maxReviewers,
+		requiredApprovals:  requiredApprovals,
+		maxOpenReviews:     maxOpenReviews,
+		reviewSLAHours:     reviewSLAHours,
+	}
+}
+
+func (s *UpdateSettingsSpecification) GetSetValues() map[string]interface{} {
+	result := map[string]interface{}{}
+	if s.assignmentStrategy != nil {
+		result["assignment_strategy"] = *s.assignmentStrategy
+	}
+	if s.requiredReviewers != nil {
+		result["required_reviewers"] = *s.requiredReviewers
+	}
+	if s.maxReviewers != nil {
+		result["max_reviewers"] = *s.maxReviewers
+	}
+	if s.requiredApprovals != nil {
+		result["required_approvals"] = *s.requiredApprovals
+	}
+	if s.maxOpenReviews != nil {
+		result["max_open_reviews"] = *s.maxOpenReviews
+	}
+	if s.reviewSLAHours != nil {
+		result["review_sla_hours"] = *s.reviewSLAHours
+	}
+	return result
+}
+
+func (s *UpdateSettingsSpecification) GetRule(builder sq.UpdateBuilder) sq.UpdateBuilder {
+	return builder.Where(sq.Eq{"team_name": s.teamName})
+}
+
+func (s *UpdateSettingsSpecification) GetReturningFields() []string {
+	return []string{"*"}
+}
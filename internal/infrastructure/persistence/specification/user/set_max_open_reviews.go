@@ -0,0 +1,31 @@
+package user
+
+import sq "github.com/Masterminds/squirrel"
+
+type SetMaxOpenReviewsSpecification struct {
+	userID         string
+	maxOpenReviews *int
+}
+
+// NewSetMaxOpenReviewsSpecification sets the user's open review limit; a nil
+// limit clears it so the team default applies.
+func NewSetMaxOpenReviewsSpecification(userID string, maxOpenReviews *int) *SetMaxOpenReviewsSpecification {
+	return &SetMaxOpenReviewsSpecification{
+		userID:         userID,
+		maxOpenReviews: maxOpenReviews,
+	}
+}
+
+func (s *SetMaxOpenReviewsSpecification) GetSetValues() map[string]interface{} {
+	return map[string]interface{}{
+		"max_open_reviews": s.maxOpenReviews,
+	}
+}
+
+func (s *SetMaxOpenReviewsSpecification) GetRule(builder sq.UpdateBuilder) sq.UpdateBuilder {
+	return builder.Where(sq.Eq{"user_id": s.userID})
+}
+
+func (s *SetMaxOpenReviewsSpecification) GetReturningFields() []string {
+	return []string{"*"}
+}
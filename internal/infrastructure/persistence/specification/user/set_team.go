@@ -0,0 +1,29 @@
+package user
+
+import sq "github.com/Masterminds/squirrel"
+
+type SetTeamSpecification struct {
+	userID   string
+	teamName string
+}
+
+func NewSetTeamSpecification(userID, teamName string) *SetTeamSpecification {
+	return &SetTeamSpecification{
+		userID:   userID,
+		teamName: teamName,
+	}
+}
+
+func (s *SetTeamSpecification) GetSetValues() map[string]interface{} {
+	return map[string]interface{}{
+		"team_name": s.teamName,
+	}
+}
+
+func (s *SetTeamSpecification) GetRule(builder sq.UpdateBuilder) sq.UpdateBuilder {
+	return builder.Where(sq.Eq{"user_id": s.userID})
+}
+
+func (s *SetTeamSpecification) GetReturningFields() []string {
+	return []string{"*"}
+}
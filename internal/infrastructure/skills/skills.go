@@ -0,0 +1,52 @@
+// Package skills normalizes the expertise tags carried by users and required
+// by pull requests.
+package skills
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const MaxLength = 32
+
+var tagRe = regexp.MustCompile(`^[a-z0-9][a-z0-9+#._-]*$`)
+
+// Normalize lowercases and trims the tags, drops duplicates and empty values
+// and keeps the first-seen order. A tag must start with a letter or a digit
+// and may contain letters, digits and "+#._-".
+func Normalize(tags []string) ([]string, error) {
+	res := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		if len(tag) > MaxLength {
+			return nil, fmt.Errorf("skill %q is longer than %d characters", tag, MaxLength)
+		}
+		if !tagRe.MatchString(tag) {
+			return nil, fmt.Errorf("skill %q must contain only letters, digits and \"+#._-\"", tag)
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+
+	return res, nil
+}
+
+// Covered returns, in the order of required, the required tags present in have.
+func Covered(have, required []string) []string {
+	var res []string
+	for _, tag := range required {
+		for _, h := range have {
+			if h == tag {
+				res = append(res, tag)
+				break
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,43 @@
+package skills
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalize(t *testing.T) {
+	tags, err := Normalize([]string{" Go", "sql", "go", "", "c++", "node.js"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "sql", "c++", "node.js"}, tags)
+
+	tags, err = Normalize(nil)
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
+
+func TestNormalize_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+	}{
+		{name: "space inside", tag: "front end"},
+		{name: "comma", tag: "go,sql"},
+		{name: "leading punctuation", tag: "-go"},
+		{name: "too long", tag: strings.Repeat("a", MaxLength+1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Normalize([]string{tt.tag})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestCovered(t *testing.T) {
+	assert.Equal(t, []string{"sql", "go"}, Covered([]string{"go", "sql", "frontend"}, []string{"sql", "k8s", "go"}))
+	assert.Empty(t, Covered(nil, []string{"go"}))
+}
@@ -0,0 +1,60 @@
+// Package worker runs the service's background jobs.
+package worker
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Job is a unit of background work. Run returns how many items it handled; a
+// failed item does not have to stop the others, so both the count and the
+// error are logged.
+type Job struct {
+	// Name describes the job in error messages, e.g. "rebalance pull requests".
+	Name string
+	// Report formats the handled count, e.g. "rebalanced %d pull requests".
+	Report string
+	Run    func(ctx context.Context) (int, error)
+}
+
+type Periodic struct {
+	job      Job
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+func NewPeriodic(job Job, interval time.Duration, logger *slog.Logger) *Periodic {
+	return &Periodic{
+		job:      job,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run runs the job every interval until ctx is cancelled. A non-positive
+// interval disables the job.
+func (p *Periodic) Run(ctx context.Context) {
+	if p.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			handled, err := p.job.Run(ctx)
+			if err != nil {
+				p.logger.Error(fmt.Sprintf("%s: %v", p.job.Name, err))
+			}
+			if handled > 0 {
+				p.logger.Info(fmt.Sprintf(p.job.Report, handled))
+			}
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeriodic_Run(t *testing.T) {
+	var runs atomic.Int32
+	ctx, cancel := context.WithCancel(context.Background())
+
+	job := Job{
+		Name:   "test job",
+		Report: "handled %d items",
+		Run: func(context.Context) (int, error) {
+			if runs.Add(1) == 3 {
+				cancel()
+			}
+			return 1, errors.New("one item failed")
+		},
+	}
+
+	done := make(chan struct{})
+	go func() {
+		NewPeriodic(job, time.Millisecond, slog.New(slog.NewTextHandler(io.Discard, nil))).Run(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the job did not stop after ctx was cancelled")
+	}
+	assert.GreaterOrEqual(t, runs.Load(), int32(3), "a failing run does not stop the job")
+}
+
+func TestPeriodic_RunDisabled(t *testing.T) {
+	job := Job{Run: func(context.Context) (int, error) {
+		t.Fatal("a disabled job must not run")
+		return 0, nil
+	}}
+
+	NewPeriodic(job, 0, slog.New(slog.NewTextHandler(io.Discard, nil))).Run(context.Background())
+}
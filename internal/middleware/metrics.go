@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+)
+
+// unmatchedRoute labels requests that matched no route, keeping the label
+// cardinality bounded by the spec's paths.
+const unmatchedRoute = "unmatched"
+
+// MetricsMiddleware records request counts and latency per route template.
+// It must be registered before the OpenAPI middleware so that rejected
+// requests are counted too.
+func MetricsMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+
+			if err := next(c); err != nil {
+				// Let Echo write the error response now to observe its status.
+				c.Error(err)
+			}
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+			labels := []string{c.Request().Method, route, strconv.Itoa(c.Response().Status)}
+
+			metrics.HTTPRequests.WithLabelValues(labels...).Inc()
+			metrics.HTTPRequestDuration.WithLabelValues(labels...).Observe(time.Since(start).Seconds())
+
+			return nil
+		}
+	}
+}
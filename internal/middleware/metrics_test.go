@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"database/sql"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMetricsMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(MetricsMiddleware())
+	e.Use(NewOpenAPIMiddleware("../../api/openapi.yaml", nil, "/metrics"))
+	e.GET("/team/get", func(c echo.Context) error {
+		return c.NoContent(http.StatusOK)
+	})
+	e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+
+	require.NoError(t, metrics.RegisterDB(&sql.DB{}, "test"))
+	require.NoError(t, metrics.RegisterDB(&sql.DB{}, "test"))
+	metrics.PullRequestsCreated.Inc()
+
+	assert.Equal(t, http.StatusOK, doRequest(e, http.MethodGet, "/team/get?team_name=backend", "").Code)
+	assert.Equal(t, http.StatusBadRequest, doRequest(e, http.MethodGet, "/team/get", "").Code)
+	assert.Equal(t, http.StatusNotFound, doRequest(e, http.MethodGet, "/nope", "").Code)
+
+	rec := doRequest(e, http.MethodGet, "/metrics", "")
+	require.Equal(t, http.StatusOK, rec.Code)
+	body := rec.Body.String()
+
+	assert.Contains(t, body, `reviewer_http_requests_total{method="GET",route="/team/get",status="200"} 1`)
+	assert.Contains(t, body, `reviewer_http_requests_total{method="GET",route="/team/get",status="400"} 1`)
+	assert.Contains(t, body, `reviewer_http_requests_total{method="GET",route="unmatched",status="404"} 1`)
+	assert.Contains(t, body, `reviewer_http_request_duration_seconds_bucket{method="GET",route="/team/get",status="200",le="+Inf"} 1`)
+	assert.Contains(t, body, "reviewer_pull_requests_created_total 1")
+	assert.Contains(t, body, `go_sql_open_connections{db_name="test"} 0`)
+}
@@ -1,18 +1,98 @@
 package middleware
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
 	"github.com/labstack/echo/v4"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	echomw "github.com/oapi-codegen/echo-middleware"
 )
 
-func NewOpenAPIMiddleware(specPath string) echo.MiddlewareFunc {
+// rolesExtension lists the roles allowed to call an operation. Operations
+// without it are open to every authenticated caller.
+const rolesExtension = "x-roles"
+
+// NewOpenAPIMiddleware validates requests against the spec. A nil authenticator
+// disables authentication of the spec's security requirements. Routes listed in
+// skipPaths are not part of the API (e.g. /metrics) and are passed through.
+func NewOpenAPIMiddleware(specPath string, authenticator *auth.Authenticator, skipPaths ...string) echo.MiddlewareFunc {
 	loader := openapi3.NewLoader()
 	doc, err := loader.LoadFromFile(specPath)
 	if err != nil {
 		panic(fmt.Errorf("failed to load OpenAPI spec: %v", err))
 	}
-	return echomw.OapiRequestValidator(doc)
+
+	authenticate := openapi3filter.NoopAuthenticationFunc
+	if authenticator != nil {
+		authenticate = newAuthenticationFunc(authenticator)
+	}
+
+	return echomw.OapiRequestValidatorWithOptions(doc, &echomw.Options{
+		Options: openapi3filter.Options{AuthenticationFunc: authenticate},
+		Skipper: func(c echo.Context) bool {
+			return slices.Contains(skipPaths, c.Path())
+		},
+		ErrorHandler: func(c echo.Context, err *echo.HTTPError) error {
+			switch err.Code {
+			case http.StatusUnauthorized:
+				return rpc_errors.RespondUnauthorized(c, "")
+			case http.StatusForbidden:
+				return rpc_errors.RespondForbidden(c, fmt.Sprint(err.Message))
+			}
+			return err
+		},
+	})
+}
+
+func newAuthenticationFunc(authenticator *auth.Authenticator) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		req := input.RequestValidationInput.Request
+
+		token, ok := strings.CutPrefix(req.Header.Get(echo.HeaderAuthorization), "Bearer ")
+		if !ok {
+			return echo.NewHTTPError(http.StatusUnauthorized)
+		}
+
+		principal, err := authenticator.Authenticate(strings.TrimSpace(token))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized)
+		}
+
+		if roles := operationRoles(input.RequestValidationInput.Route.Operation); roles != nil && !principal.HasRole(roles...) {
+			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("role %q is not allowed to call this endpoint", principal.Role))
+		}
+
+		if c := echomw.GetEchoContext(ctx); c != nil {
+			c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), principal)))
+		}
+
+		return nil
+	}
+}
+
+func operationRoles(op *openapi3.Operation) []string {
+	if op == nil {
+		return nil
+	}
+
+	raw, ok := op.Extensions[rolesExtension].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	roles := make([]string, 0, len(raw))
+	for _, r := range raw {
+		if role, ok := r.(string); ok {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles
 }
@@ -0,0 +1,83 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T, authenticator *auth.Authenticator) (*echo.Echo, *auth.Principal) {
+	t.Helper()
+
+	var seen auth.Principal
+	e := echo.New()
+	e.Use(NewOpenAPIMiddleware("../../api/openapi.yaml", authenticator))
+
+	handler := func(c echo.Context) error {
+		seen, _ = auth.PrincipalFromContext(c.Request().Context())
+		return c.NoContent(http.StatusOK)
+	}
+	e.POST("/users/bulkDeactivate", handler)
+	e.GET("/team/get", handler)
+
+	return e, &seen
+}
+
+func doRequest(e *echo.Echo, method, target, token string) *httptest.ResponseRecorder {
+	var req *http.Request
+	if method == http.MethodPost {
+		req = httptest.NewRequest(method, target, strings.NewReader(`{"team_name":"backend","user_ids":["u1"]}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	} else {
+		req = httptest.NewRequest(method, target, nil)
+	}
+	if token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestOpenAPIMiddleware_Authorization(t *testing.T) {
+	authenticator, err := auth.NewAuthenticator(auth.Config{APITokens: map[string]string{
+		"admin-token":  "admin:alice",
+		"member-token": "member:bob",
+	}})
+	require.NoError(t, err)
+
+	e, seen := newTestServer(t, authenticator)
+
+	rec := doRequest(e, http.MethodPost, "/users/bulkDeactivate", "")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Contains(t, rec.Body.String(), "UNAUTHORIZED")
+
+	rec = doRequest(e, http.MethodPost, "/users/bulkDeactivate", "wrong-token")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	rec = doRequest(e, http.MethodPost, "/users/bulkDeactivate", "member-token")
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "FORBIDDEN")
+
+	rec = doRequest(e, http.MethodPost, "/users/bulkDeactivate", "admin-token")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, auth.Principal{Subject: "alice", Role: auth.RoleAdmin}, *seen)
+
+	rec = doRequest(e, http.MethodGet, "/team/get?team_name=backend", "member-token")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, auth.Principal{Subject: "bob", Role: auth.RoleMember}, *seen)
+}
+
+func TestOpenAPIMiddleware_AuthDisabled(t *testing.T) {
+	e, _ := newTestServer(t, nil)
+
+	rec := doRequest(e, http.MethodPost, "/users/bulkDeactivate", "")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
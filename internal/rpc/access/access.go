@@ -0,0 +1,45 @@
+// Package access holds the authorization checks shared by the handlers that
+// act on a single user. Without a principal, i.e. with authentication
+// disabled, every check passes.
+package access
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type userTeams interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
+
+// CheckTeamMember allows admins and the team lead of userID's team.
+func CheckTeamMember(ctx context.Context, users userTeams, userID string) error {
+	return check(ctx, users, userID, auth.Principal.CanManageTeam,
+		"team leads can only manage members of their own team")
+}
+
+// CheckUser allows admins, the team lead of userID's team and the user
+// themselves.
+func CheckUser(ctx context.Context, users userTeams, userID string) error {
+	return check(ctx, users, userID, func(p auth.Principal, teamName string) bool {
+		return p.CanManageUser(userID, teamName)
+	}, "only the user or their team lead can manage the user")
+}
+
+func check(ctx context.Context, users userTeams, userID string, allowed func(auth.Principal, string) bool, message string) error {
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.Role == auth.RoleAdmin {
+		return nil
+	}
+
+	teamName, err := users.GetUserTeamName(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !allowed(principal, teamName) {
+		return rpc_errors.NewForbidden(message)
+	}
+	return nil
+}
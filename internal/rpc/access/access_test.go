@@ -0,0 +1,63 @@
+package access
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubUserTeams map[string]string
+
+func (s stubUserTeams) GetUserTeamName(_ context.Context, userID string) (string, error) {
+	teamName, ok := s[userID]
+	if !ok {
+		return "", user.ErrNotFound
+	}
+	return teamName, nil
+}
+
+func TestCheck(t *testing.T) {
+	users := stubUserTeams{"u1": "backend", "u2": "payments"}
+	lead := auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "backend"}
+	member := auth.Principal{Subject: "u1", Role: auth.RoleMember, TeamName: "backend"}
+	admin := auth.Principal{Subject: "root", Role: auth.RoleAdmin}
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		check     func(ctx context.Context) error
+		forbidden bool
+	}{
+		{name: "auth disabled", check: func(ctx context.Context) error { return CheckTeamMember(ctx, users, "u2") }},
+		{name: "admin", principal: &admin, check: func(ctx context.Context) error { return CheckTeamMember(ctx, users, "ghost") }},
+		{name: "lead of the team", principal: &lead, check: func(ctx context.Context) error { return CheckTeamMember(ctx, users, "u1") }},
+		{name: "lead of another team", principal: &lead, check: func(ctx context.Context) error { return CheckTeamMember(ctx, users, "u2") }, forbidden: true},
+		{name: "member on themselves", principal: &member, check: func(ctx context.Context) error { return CheckTeamMember(ctx, users, "u1") }, forbidden: true},
+		{name: "user on themselves", principal: &member, check: func(ctx context.Context) error { return CheckUser(ctx, users, "u1") }},
+		{name: "user on a teammate", principal: &member, check: func(ctx context.Context) error { return CheckUser(ctx, users, "u2") }, forbidden: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, *tt.principal)
+			}
+
+			err := tt.check(ctx)
+			if !tt.forbidden {
+				assert.NoError(t, err)
+				return
+			}
+			var forbidden *rpc_errors.ForbiddenError
+			assert.ErrorAs(t, err, &forbidden)
+		})
+	}
+
+	err := CheckTeamMember(auth.WithPrincipal(context.Background(), lead), users, "ghost")
+	assert.ErrorIs(t, err, user.ErrNotFound)
+}
@@ -83,6 +83,90 @@ func NewTeamExists(message string) *TeamExistsError {
 	return &TeamExistsError{Message: message}
 }
 
+type NotApprovedError struct {
+	Message string
+}
+
+func (e *NotApprovedError) Error() string {
+	return e.Message
+}
+
+func NewNotApproved(message string) *NotApprovedError {
+	return &NotApprovedError{Message: message}
+}
+
+type InvalidTransitionError struct {
+	Message string
+}
+
+func (e *InvalidTransitionError) Error() string {
+	return e.Message
+}
+
+func NewInvalidTransition(message string) *InvalidTransitionError {
+	return &InvalidTransitionError{Message: message}
+}
+
+type PRClosedError struct {
+	Message string
+}
+
+func (e *PRClosedError) Error() string {
+	return e.Message
+}
+
+func NewPRClosed(message string) *PRClosedError {
+	return &PRClosedError{Message: message}
+}
+
+type TeamArchivedError struct {
+	Message string
+}
+
+func (e *TeamArchivedError) Error() string {
+	return e.Message
+}
+
+func NewTeamArchived(message string) *TeamArchivedError {
+	return &TeamArchivedError{Message: message}
+}
+
+type TeamNotEmptyError struct {
+	Message string
+}
+
+func (e *TeamNotEmptyError) Error() string {
+	return e.Message
+}
+
+func NewTeamNotEmpty(message string) *TeamNotEmptyError {
+	return &TeamNotEmptyError{Message: message}
+}
+
+type BadRequestError struct {
+	Message string
+}
+
+func (e *BadRequestError) Error() string {
+	return e.Message
+}
+
+func NewBadRequest(message string) *BadRequestError {
+	return &BadRequestError{Message: message}
+}
+
+type ForbiddenError struct {
+	Message string
+}
+
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+func NewForbidden(message string) *ForbiddenError {
+	return &ForbiddenError{Message: message}
+}
+
 func RespondBadRequest(ctx echo.Context, message string) error {
 	if message == "" {
 		message = "bad request"
@@ -163,12 +247,84 @@ func RespondTeamExists(ctx echo.Context, message string) error {
 	return ctx.JSON(http.StatusBadRequest, resp)
 }
 
+func RespondNotApproved(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "PR does not have the required approvals"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.NOTAPPROVED
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusConflict, resp)
+}
+
+func RespondInvalidTransition(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "status transition is not allowed"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.INVALIDTRANSITION
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusConflict, resp)
+}
+
+func RespondPRClosed(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "PR is closed"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.PRCLOSED
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusConflict, resp)
+}
+
+func RespondTeamArchived(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "team is archived"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.TEAMARCHIVED
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusConflict, resp)
+}
+
+func RespondTeamNotEmpty(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "team has active members"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.TEAMNOTEMPTY
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusConflict, resp)
+}
+
+func RespondUnauthorized(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "invalid or missing credentials"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.UNAUTHORIZED
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusUnauthorized, resp)
+}
+
+func RespondForbidden(ctx echo.Context, message string) error {
+	if message == "" {
+		message = "access denied"
+	}
+	resp := generated.ErrorResponse{}
+	resp.Error.Code = generated.FORBIDDEN
+	resp.Error.Message = message
+	return ctx.JSON(http.StatusForbidden, resp)
+}
+
 func RespondFromError(ctx echo.Context, err error) error {
 	if err == nil {
 		return RespondInternal(ctx, "unknown error")
 	}
 
 	switch e := err.(type) {
+	case *BadRequestError:
+		return RespondBadRequest(ctx, e.Message)
 	case *NotFoundError:
 		return RespondNotFound(ctx, e.Message)
 	case *PRExistsError:
@@ -181,6 +337,18 @@ func RespondFromError(ctx echo.Context, err error) error {
 		return RespondNoCandidate(ctx, e.Message)
 	case *TeamExistsError:
 		return RespondTeamExists(ctx, e.Message)
+	case *NotApprovedError:
+		return RespondNotApproved(ctx, e.Message)
+	case *InvalidTransitionError:
+		return RespondInvalidTransition(ctx, e.Message)
+	case *PRClosedError:
+		return RespondPRClosed(ctx, e.Message)
+	case *TeamArchivedError:
+		return RespondTeamArchived(ctx, e.Message)
+	case *TeamNotEmptyError:
+		return RespondTeamNotEmpty(ctx, e.Message)
+	case *ForbiddenError:
+		return RespondForbidden(ctx, e.Message)
 	}
 
 	if errors.Is(err, pull_request.ErrPRNotFound) || errors.Is(err, user.ErrNotFound) || errors.Is(err, team.ErrNotFound) {
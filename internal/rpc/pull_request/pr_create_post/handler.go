@@ -26,7 +26,12 @@ func (h *Handler) PRCreatePost(ctx echo.Context) error {
 		return rpc_errors.RespondBadRequest(ctx, "")
 	}
 
-	prModel := converter.FromOpenAPIPullRequestCreate(&input, generated.PullRequestStatusOPEN)
+	status := generated.PullRequestStatusOPEN
+	if input.Draft != nil && *input.Draft {
+		status = generated.PullRequestStatusDRAFT
+	}
+
+	prModel := converter.FromOpenAPIPullRequestCreate(&input, status)
 
 	pullRequest, err := h.createPRService.CreatePR(ctx.Request().Context(), prModel)
 	if err != nil {
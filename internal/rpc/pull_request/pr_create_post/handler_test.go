@@ -67,6 +67,134 @@ func TestHandler_PRCreatePost(t *testing.T) {
 		assert.Equal(t, "OPEN", prData["status"])
 	})
 
+	t.Run("draft creation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreatePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","pull_request_name":"Add search","author_id":"u1","draft":true}`)
+
+		mockService.EXPECT().
+			CreatePR(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, pr *models.PullRequest) (models.PullRequest, error) {
+				assert.Equal(t, "DRAFT", pr.Status.Name)
+				return models.PullRequest{
+					ID:        pr.ID,
+					Name:      pr.Name,
+					AuthorID:  pr.AuthorID,
+					Status:    &models.Status{Name: "DRAFT"},
+					Reviewers: []string{},
+				}, nil
+			})
+
+		err := handler.PRCreatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response struct {
+			PR generated.PullRequest `json:"pr"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.PullRequestStatusDRAFT, response.PR.Status)
+		assert.Empty(t, response.PR.AssignedReviewers)
+	})
+
+	t.Run("changed paths are passed to the service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreatePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","pull_request_name":"Add search","author_id":"u1","changed_paths":["payments/ledger.go"]}`)
+
+		mockService.EXPECT().
+			CreatePR(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, pr *models.PullRequest) (models.PullRequest, error) {
+				assert.Equal(t, []string{"payments/ledger.go"}, pr.ChangedPaths)
+				return models.PullRequest{
+					ID:        pr.ID,
+					Name:      pr.Name,
+					AuthorID:  pr.AuthorID,
+					Status:    &models.Status{Name: "OPEN"},
+					Reviewers: []string{"u7"},
+				}, nil
+			})
+
+		err := handler.PRCreatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+	})
+
+	t.Run("required skills are passed and matched skills reported", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreatePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","pull_request_name":"Add search","author_id":"u1","required_skills":["sql"],"skill_mode":"require"}`)
+
+		mockService.EXPECT().
+			CreatePR(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, pr *models.PullRequest) (models.PullRequest, error) {
+				assert.Equal(t, []string{"sql"}, pr.RequiredSkills)
+				assert.Equal(t, models.SkillModeRequire, pr.SkillMode)
+				return models.PullRequest{
+					ID:          pr.ID,
+					Name:        pr.Name,
+					AuthorID:    pr.AuthorID,
+					Status:      &models.Status{Name: "OPEN"},
+					Reviewers:   []string{"u3"},
+					Assignments: []models.Reviewer{{ReviewerID: "u3", SourceTeam: "backend", MatchedSkills: []string{"sql"}}},
+				}, nil
+			})
+
+		err := handler.PRCreatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"matched_skills":["sql"]`)
+	})
+
+	t.Run("requested reviewers are passed to the service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreatePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","pull_request_name":"Add search","author_id":"u1","requested_reviewers":["u5"]}`)
+
+		mockService.EXPECT().
+			CreatePR(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ interface{}, pr *models.PullRequest) (models.PullRequest, error) {
+				assert.Equal(t, []string{"u5"}, pr.RequestedReviewers)
+				return models.PullRequest{
+					ID:        pr.ID,
+					Name:      pr.Name,
+					AuthorID:  pr.AuthorID,
+					Status:    &models.Status{Name: "OPEN"},
+					Reviewers: []string{"u5", "u2"},
+				}, nil
+			})
+
+		err := handler.PRCreatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"assigned_reviewers":["u5","u2"]`)
+	})
+
 	t.Run("bad request - invalid JSON", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package pr_history_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type prRepo interface {
+	PullRequestExists(ctx context.Context, prID string) (bool, error)
+	GetAssignmentEventsByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error)
+}
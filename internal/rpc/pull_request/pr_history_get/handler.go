@@ -0,0 +1,40 @@
+package pr_history_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	prRepo prRepo
+}
+
+func New(prRepo prRepo) *Handler {
+	return &Handler{
+		prRepo: prRepo,
+	}
+}
+
+func (h *Handler) PRHistoryGet(ctx echo.Context, params generated.GetPullRequestHistoryParams) error {
+	exists, err := h.prRepo.PullRequestExists(ctx.Request().Context(), params.PullRequestId)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+	if !exists {
+		return rpc_errors.RespondNotFound(ctx, "PR not found")
+	}
+
+	events, err := h.prRepo.GetAssignmentEventsByPR(ctx.Request().Context(), params.PullRequestId)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pull_request_id": params.PullRequestId,
+		"events":          converter.ToOpenAPIAssignmentEvents(events),
+	})
+}
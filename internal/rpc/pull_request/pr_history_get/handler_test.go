@@ -0,0 +1,79 @@
+package pr_history_get
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_history_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_PRHistoryGet(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockprRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=pr-1001", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		createdAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+		events := []models.AssignmentEvent{
+			{ID: 1, PullRequestID: "pr-1001", Type: models.AssignmentEventAssign, NewReviewerID: "u2", Actor: "u1", Reason: "pull request created", CreatedAt: createdAt},
+			{ID: 2, PullRequestID: "pr-1001", Type: models.AssignmentEventReassign, OldReviewerID: "u2", NewReviewerID: "u5", Reason: "manual reassign", CreatedAt: createdAt},
+		}
+
+		mockRepo.EXPECT().PullRequestExists(gomock.Any(), "pr-1001").Return(true, nil)
+		mockRepo.EXPECT().GetAssignmentEventsByPR(gomock.Any(), "pr-1001").Return(events, nil)
+
+		err := handler.PRHistoryGet(c, generated.GetPullRequestHistoryParams{PullRequestId: "pr-1001"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			PullRequestID string                      `json:"pull_request_id"`
+			Events        []generated.AssignmentEvent `json:"events"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "pr-1001", response.PullRequestID)
+		assert.Len(t, response.Events, 2)
+		assert.Equal(t, generated.ASSIGN, response.Events[0].EventType)
+		assert.Nil(t, response.Events[0].OldReviewerId)
+		assert.Equal(t, "u1", *response.Events[0].Actor)
+		assert.Equal(t, "u2", *response.Events[1].OldReviewerId)
+		assert.Nil(t, response.Events[1].Actor)
+	})
+
+	t.Run("PR not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockprRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=missing", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().PullRequestExists(gomock.Any(), "missing").Return(false, nil)
+
+		err := handler.PRHistoryGet(c, generated.GetPullRequestHistoryParams{PullRequestId: "missing"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockprRepo is a mock of prRepo interface.
+type MockprRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockprRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockprRepoMockRecorder is the mock recorder for MockprRepo.
+type MockprRepoMockRecorder struct {
+	mock *MockprRepo
+}
+
+// NewMockprRepo creates a new mock instance.
+func NewMockprRepo(ctrl *gomock.Controller) *MockprRepo {
+	mock := &MockprRepo{ctrl: ctrl}
+	mock.recorder = &MockprRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprRepo) EXPECT() *MockprRepoMockRecorder {
+	return m.recorder
+}
+
+// GetAssignmentEventsByPR mocks base method.
+func (m *MockprRepo) GetAssignmentEventsByPR(ctx context.Context, prID string) ([]models.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentEventsByPR", ctx, prID)
+	ret0, _ := ret[0].([]models.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentEventsByPR indicates an expected call of GetAssignmentEventsByPR.
+func (mr *MockprRepoMockRecorder) GetAssignmentEventsByPR(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentEventsByPR", reflect.TypeOf((*MockprRepo)(nil).GetAssignmentEventsByPR), ctx, prID)
+}
+
+// PullRequestExists mocks base method.
+func (m *MockprRepo) PullRequestExists(ctx context.Context, prID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PullRequestExists", ctx, prID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PullRequestExists indicates an expected call of PullRequestExists.
+func (mr *MockprRepoMockRecorder) PullRequestExists(ctx, prID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PullRequestExists", reflect.TypeOf((*MockprRepo)(nil).PullRequestExists), ctx, prID)
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package pr_list_get
+
+import (
+	"context"
+
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/list_prs"
+)
+
+type listPRsService interface {
+	ListPullRequests(ctx context.Context, filter pr_spec.ListFilter, cursor string, limit int) (list_prs.Page, error)
+}
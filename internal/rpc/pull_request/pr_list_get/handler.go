@@ -0,0 +1,75 @@
+package pr_list_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	listPRsService listPRsService
+}
+
+func New(listPRsService listPRsService) *Handler {
+	return &Handler{
+		listPRsService: listPRsService,
+	}
+}
+
+func (h *Handler) PRListGet(ctx echo.Context, params generated.GetPullRequestListParams) error {
+	filter := pr_spec.ListFilter{
+		CreatedFrom: params.CreatedFrom,
+		CreatedTo:   params.CreatedTo,
+		MergedFrom:  params.MergedFrom,
+		MergedTo:    params.MergedTo,
+	}
+	if params.Status != nil {
+		for _, status := range *params.Status {
+			filter.Statuses = append(filter.Statuses, string(status))
+		}
+	}
+	if params.AuthorId != nil {
+		filter.AuthorID = *params.AuthorId
+	}
+	if params.ReviewerId != nil {
+		filter.ReviewerID = *params.ReviewerId
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.Name != nil {
+		filter.NameContains = *params.Name
+	}
+
+	var cursor string
+	if params.Cursor != nil {
+		cursor = *params.Cursor
+	}
+	var limit int
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	page, err := h.listPRsService.ListPullRequests(ctx.Request().Context(), filter, cursor, limit)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	pullRequests := make([]generated.PullRequest, len(page.PullRequests))
+	for i, pr := range page.PullRequests {
+		pullRequests[i] = *converter.ToOpenAPIPullRequest(pr)
+	}
+
+	response := map[string]interface{}{
+		"pull_requests": pullRequests,
+	}
+	if page.NextCursor != "" {
+		response["next_cursor"] = page.NextCursor
+	}
+
+	return ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,120 @@
+package pr_list_get
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_list_get/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/list_prs"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_PRListGet(t *testing.T) {
+	t.Run("successful list with filters", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocklistPRsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/list", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		createdFrom := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+		params := generated.GetPullRequestListParams{
+			Status:      &[]generated.GetPullRequestListParamsStatus{generated.GetPullRequestListParamsStatusOPEN},
+			TeamName:    ptr.To("backend"),
+			Name:        ptr.To("search"),
+			CreatedFrom: &createdFrom,
+			Cursor:      ptr.To("abc"),
+			Limit:       ptr.To(2),
+		}
+
+		mockService.EXPECT().
+			ListPullRequests(gomock.Any(), pr_spec.ListFilter{
+				Statuses:     []string{"OPEN"},
+				TeamName:     "backend",
+				NameContains: "search",
+				CreatedFrom:  &createdFrom,
+			}, "abc", 2).
+			Return(list_prs.Page{
+				PullRequests: []models.PullRequest{
+					{ID: "pr-2", Name: "Add search", AuthorID: "u1", Status: &models.Status{Name: "OPEN"}, Reviewers: []string{"u2"}},
+				},
+				NextCursor: "next",
+			}, nil)
+
+		err := handler.PRListGet(c, params)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			PullRequests []generated.PullRequest `json:"pull_requests"`
+			NextCursor   *string                 `json:"next_cursor"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.PullRequests, 1)
+		assert.Equal(t, "pr-2", response.PullRequests[0].PullRequestId)
+		assert.Equal(t, []string{"u2"}, response.PullRequests[0].AssignedReviewers)
+		assert.Equal(t, "next", *response.NextCursor)
+	})
+
+	t.Run("last page has no cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocklistPRsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/list", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().
+			ListPullRequests(gomock.Any(), pr_spec.ListFilter{}, "", 0).
+			Return(list_prs.Page{PullRequests: []models.PullRequest{}}, nil)
+
+		err := handler.PRListGet(c, generated.GetPullRequestListParams{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"pull_requests":[]}`, rec.Body.String())
+	})
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocklistPRsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/list?cursor=bad", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().
+			ListPullRequests(gomock.Any(), gomock.Any(), "bad", 0).
+			Return(list_prs.Page{}, rpc_errors.NewBadRequest("invalid cursor"))
+
+		err := handler.PRListGet(c, generated.GetPullRequestListParams{Cursor: ptr.To("bad")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
+	list_prs "github.com/loloneme/potential-waffle/internal/usecase/list_prs"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocklistPRsService is a mock of listPRsService interface.
+type MocklistPRsService struct {
+	ctrl     *gomock.Controller
+	recorder *MocklistPRsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocklistPRsServiceMockRecorder is the mock recorder for MocklistPRsService.
+type MocklistPRsServiceMockRecorder struct {
+	mock *MocklistPRsService
+}
+
+// NewMocklistPRsService creates a new mock instance.
+func NewMocklistPRsService(ctrl *gomock.Controller) *MocklistPRsService {
+	mock := &MocklistPRsService{ctrl: ctrl}
+	mock.recorder = &MocklistPRsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklistPRsService) EXPECT() *MocklistPRsServiceMockRecorder {
+	return m.recorder
+}
+
+// ListPullRequests mocks base method.
+func (m *MocklistPRsService) ListPullRequests(ctx context.Context, filter pr_spec.ListFilter, cursor string, limit int) (list_prs.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPullRequests", ctx, filter, cursor, limit)
+	ret0, _ := ret[0].(list_prs.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPullRequests indicates an expected call of ListPullRequests.
+func (mr *MocklistPRsServiceMockRecorder) ListPullRequests(ctx, filter, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPullRequests", reflect.TypeOf((*MocklistPRsService)(nil).ListPullRequests), ctx, filter, cursor, limit)
+}
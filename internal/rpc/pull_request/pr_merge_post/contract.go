@@ -9,5 +9,5 @@ import (
 )
 
 type MergePRService interface {
-	MergePullRequest(ctx context.Context, prID string, mergeStatus string) (models.PullRequest, error)
+	MergePullRequest(ctx context.Context, prID string, mergedBy string, actor string) (models.PullRequest, error)
 }
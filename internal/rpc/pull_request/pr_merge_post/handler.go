@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 )
@@ -26,9 +27,27 @@ func (h *Handler) PRMergePost(ctx echo.Context) error {
 		return rpc_errors.RespondBadRequest(ctx, "")
 	}
 
-	pr, err := h.mergePRService.MergePullRequest(ctx.Request().Context(),
-		input.PullRequestId,
-		string(generated.PullRequestStatusMERGED))
+	var mergedBy string
+	if input.MergedBy != nil {
+		mergedBy = *input.MergedBy
+	}
+
+	// Only admins may merge on behalf of someone else; everybody else merges
+	// as themselves. The caller's subject is recorded without a user lookup,
+	// since bot and admin tokens are not users.
+	var actor string
+	if principal, ok := auth.PrincipalFromContext(ctx.Request().Context()); ok {
+		switch {
+		case principal.Role == auth.RoleAdmin && mergedBy != "":
+		case mergedBy == "" || mergedBy == principal.Subject:
+			mergedBy = ""
+			actor = principal.Subject
+		default:
+			return rpc_errors.RespondForbidden(ctx, "merged_by must be the caller")
+		}
+	}
+
+	pr, err := h.mergePRService.MergePullRequest(ctx.Request().Context(), input.PullRequestId, mergedBy, actor)
 	if err != nil {
 		return rpc_errors.RespondFromError(ctx, err)
 	}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_merge_post/mocks"
@@ -46,7 +47,7 @@ func TestHandler_PRMergePost(t *testing.T) {
 		}
 
 		mockService.EXPECT().
-			MergePullRequest(gomock.Any(), "pr-1001", "MERGED").
+			MergePullRequest(gomock.Any(), "pr-1001", "", "").
 			Return(expectedPR, nil)
 
 		err := handler.PRMergePost(c)
@@ -64,6 +65,134 @@ func TestHandler_PRMergePost(t *testing.T) {
 		assert.Equal(t, "MERGED", prData["status"])
 	})
 
+	t.Run("merge records merged_by", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockMergePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","merged_by":"u1"}`)
+
+		mergedBy := "u1"
+		expectedPR := models.PullRequest{
+			ID:       "pr-1001",
+			Name:     "Add search",
+			AuthorID: "u1",
+			Status:   &models.Status{Name: "MERGED"},
+			MergedBy: &mergedBy,
+		}
+
+		mockService.EXPECT().
+			MergePullRequest(gomock.Any(), "pr-1001", "u1", "").
+			Return(expectedPR, nil)
+
+		err := handler.PRMergePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			PR generated.PullRequest `json:"pr"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "u1", *response.PR.MergedBy)
+	})
+
+	t.Run("merged_by defaults to the caller", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockMergePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validMergeJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "u2", Role: auth.RoleMember})))
+
+		mockService.EXPECT().
+			MergePullRequest(gomock.Any(), "pr-1001", "", "u2").
+			Return(models.PullRequest{ID: "pr-1001", Status: &models.Status{Name: "MERGED"}}, nil)
+
+		err := handler.PRMergePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("bot merges as itself", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockMergePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validMergeJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "bot:ci-bot", Role: auth.RoleBot})))
+
+		mergedBy := "bot:ci-bot"
+		mockService.EXPECT().
+			MergePullRequest(gomock.Any(), "pr-1001", "", "bot:ci-bot").
+			Return(models.PullRequest{ID: "pr-1001", Status: &models.Status{Name: "MERGED"}, MergedBy: &mergedBy}, nil)
+
+		err := handler.PRMergePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			PR generated.PullRequest `json:"pr"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "bot:ci-bot", *response.PR.MergedBy)
+	})
+
+	t.Run("admin merges on behalf of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockMergePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","merged_by":"u1"}`)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "root", Role: auth.RoleAdmin})))
+
+		mockService.EXPECT().
+			MergePullRequest(gomock.Any(), "pr-1001", "u1", "").
+			Return(models.PullRequest{ID: "pr-1001", Status: &models.Status{Name: "MERGED"}}, nil)
+
+		err := handler.PRMergePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("forbidden - merged_by of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockMergePRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","merged_by":"u1"}`)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "u2", Role: auth.RoleTeamLead, TeamName: "backend"})))
+
+		err := handler.PRMergePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
 	t.Run("bad request - invalid JSON", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
@@ -96,7 +225,7 @@ func TestHandler_PRMergePost(t *testing.T) {
 		c, rec := makeTestRequest(e, validMergeJSON)
 
 		mockService.EXPECT().
-			MergePullRequest(gomock.Any(), "pr-1001", "MERGED").
+			MergePullRequest(gomock.Any(), "pr-1001", "", "").
 			Return(models.PullRequest{}, rpc_errors.NewNotFound("PR not found"))
 
 		err := handler.PRMergePost(c)
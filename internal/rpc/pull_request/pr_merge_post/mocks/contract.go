@@ -42,16 +42,16 @@ func (m *MockMergePRService) EXPECT() *MockMergePRServiceMockRecorder {
 }
 
 // MergePullRequest mocks base method.
-func (m *MockMergePRService) MergePullRequest(ctx context.Context, prID, mergeStatus string) (models.PullRequest, error) {
+func (m *MockMergePRService) MergePullRequest(ctx context.Context, prID, mergedBy, actor string) (models.PullRequest, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MergePullRequest", ctx, prID, mergeStatus)
+	ret := m.ctrl.Call(m, "MergePullRequest", ctx, prID, mergedBy, actor)
 	ret0, _ := ret[0].(models.PullRequest)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MergePullRequest indicates an expected call of MergePullRequest.
-func (mr *MockMergePRServiceMockRecorder) MergePullRequest(ctx, prID, mergeStatus any) *gomock.Call {
+func (mr *MockMergePRServiceMockRecorder) MergePullRequest(ctx, prID, mergedBy, actor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*MockMergePRService)(nil).MergePullRequest), ctx, prID, mergeStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergePullRequest", reflect.TypeOf((*MockMergePRService)(nil).MergePullRequest), ctx, prID, mergedBy, actor)
 }
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package pr_overdue_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type overdueService interface {
+	ListOverdue(ctx context.Context, teamName string) ([]models.OverdueReview, error)
+}
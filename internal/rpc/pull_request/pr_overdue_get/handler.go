@@ -0,0 +1,36 @@
+package pr_overdue_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	overdueService overdueService
+}
+
+func New(overdueService overdueService) *Handler {
+	return &Handler{
+		overdueService: overdueService,
+	}
+}
+
+func (h *Handler) PROverdueGet(ctx echo.Context, params generated.GetPullRequestOverdueParams) error {
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+
+	reviews, err := h.overdueService.ListOverdue(ctx.Request().Context(), teamName)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"overdue": converter.ToOpenAPIOverdueReviews(reviews),
+	})
+}
@@ -0,0 +1,117 @@
+package pr_overdue_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_overdue_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_PROverdueGet(t *testing.T) {
+	t.Run("successful list for team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockoverdueService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/overdue?team_name=backend", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		assignedAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+		escalatedAt := assignedAt.Add(25 * time.Hour)
+		mockService.EXPECT().ListOverdue(gomock.Any(), "backend").Return([]models.OverdueReview{
+			{
+				PullRequestID:   "pr-1001",
+				PullRequestName: "Add search",
+				AuthorID:        "u1",
+				TeamName:        "backend",
+				ReviewerID:      "u2",
+				AssignedAt:      assignedAt,
+				DueAt:           assignedAt.Add(24 * time.Hour),
+				SLAHours:        24,
+				EscalatedAt:     &escalatedAt,
+			},
+			{
+				PullRequestID:   "pr-1002",
+				PullRequestName: "Fix login",
+				AuthorID:        "u1",
+				TeamName:        "backend",
+				ReviewerID:      "u3",
+				AssignedAt:      assignedAt,
+				DueAt:           assignedAt.Add(24 * time.Hour),
+				SLAHours:        24,
+			},
+		}, nil)
+
+		err := handler.PROverdueGet(c, generated.GetPullRequestOverdueParams{TeamName: ptr.To("backend")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Overdue []generated.OverdueReview `json:"overdue"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Overdue, 2)
+		assert.Equal(t, "u2", response.Overdue[0].ReviewerId)
+		assert.Equal(t, 24, response.Overdue[0].SlaHours)
+		assert.True(t, assignedAt.Add(24*time.Hour).Equal(response.Overdue[0].DueAt))
+		assert.NotNil(t, response.Overdue[0].EscalatedAt)
+		assert.Nil(t, response.Overdue[1].EscalatedAt)
+	})
+
+	t.Run("no overdue reviews", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockoverdueService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/overdue", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().ListOverdue(gomock.Any(), "").Return([]models.OverdueReview{}, nil)
+
+		err := handler.PROverdueGet(c, generated.GetPullRequestOverdueParams{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"overdue":[]}`, rec.Body.String())
+	})
+
+	t.Run("service error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockoverdueService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/pullRequest/overdue", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockService.EXPECT().ListOverdue(gomock.Any(), "").Return(nil, errors.New("db down"))
+
+		err := handler.PROverdueGet(c, generated.GetPullRequestOverdueParams{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockoverdueService is a mock of overdueService interface.
+type MockoverdueService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoverdueServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockoverdueServiceMockRecorder is the mock recorder for MockoverdueService.
+type MockoverdueServiceMockRecorder struct {
+	mock *MockoverdueService
+}
+
+// NewMockoverdueService creates a new mock instance.
+func NewMockoverdueService(ctrl *gomock.Controller) *MockoverdueService {
+	mock := &MockoverdueService{ctrl: ctrl}
+	mock.recorder = &MockoverdueServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoverdueService) EXPECT() *MockoverdueServiceMockRecorder {
+	return m.recorder
+}
+
+// ListOverdue mocks base method.
+func (m *MockoverdueService) ListOverdue(ctx context.Context, teamName string) ([]models.OverdueReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdue", ctx, teamName)
+	ret0, _ := ret[0].([]models.OverdueReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockoverdueServiceMockRecorder) ListOverdue(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockoverdueService)(nil).ListOverdue), ctx, teamName)
+}
@@ -9,5 +9,5 @@ import (
 )
 
 type reassignPRService interface {
-	ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (models.PullRequest, string, error)
+	ReassignReviewer(ctx context.Context, prID, oldReviewerID, actor string) (models.PullRequest, string, error)
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 )
@@ -26,7 +27,9 @@ func (h *Handler) PRReassignPost(ctx echo.Context) error {
 		return rpc_errors.RespondBadRequest(ctx, "")
 	}
 
-	pr, newReviewerID, err := h.reassignPRService.ReassignReviewer(ctx.Request().Context(), input.PullRequestId, input.OldUserId)
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	pr, newReviewerID, err := h.reassignPRService.ReassignReviewer(ctx.Request().Context(), input.PullRequestId, input.OldUserId, principal.Subject)
 	if err != nil {
 		return rpc_errors.RespondFromError(ctx, err)
 	}
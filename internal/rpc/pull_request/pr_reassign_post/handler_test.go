@@ -9,6 +9,7 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_reassign_post/mocks"
@@ -35,6 +36,8 @@ func TestHandler_PRReassignPost(t *testing.T) {
 
 		e := echo.New()
 		c, rec := makeTestRequest(e, validReassignJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "u1", Role: auth.RoleMember})))
 
 		expectedPR := models.PullRequest{
 			ID:       "pr-1001",
@@ -47,7 +50,7 @@ func TestHandler_PRReassignPost(t *testing.T) {
 		}
 
 		mockService.EXPECT().
-			ReassignReviewer(gomock.Any(), "pr-1001", "u2").
+			ReassignReviewer(gomock.Any(), "pr-1001", "u2", "u1").
 			Return(expectedPR, "u4", nil)
 
 		err := handler.PRReassignPost(c)
@@ -98,7 +101,7 @@ func TestHandler_PRReassignPost(t *testing.T) {
 		c, rec := makeTestRequest(e, validReassignJSON)
 
 		mockService.EXPECT().
-			ReassignReviewer(gomock.Any(), "pr-1001", "u2").
+			ReassignReviewer(gomock.Any(), "pr-1001", "u2", "").
 			Return(models.PullRequest{}, "", rpc_errors.NewNotFound("PR not found"))
 
 		err := handler.PRReassignPost(c)
@@ -123,7 +126,7 @@ func TestHandler_PRReassignPost(t *testing.T) {
 		c, rec := makeTestRequest(e, validReassignJSON)
 
 		mockService.EXPECT().
-			ReassignReviewer(gomock.Any(), "pr-1001", "u2").
+			ReassignReviewer(gomock.Any(), "pr-1001", "u2", "").
 			Return(models.PullRequest{}, "", rpc_errors.NewPRMerged("cannot reassign on merged PR"))
 
 		err := handler.PRReassignPost(c)
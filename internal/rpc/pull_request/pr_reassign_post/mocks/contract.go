@@ -42,9 +42,9 @@ func (m *MockreassignPRService) EXPECT() *MockreassignPRServiceMockRecorder {
 }
 
 // ReassignReviewer mocks base method.
-func (m *MockreassignPRService) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (models.PullRequest, string, error) {
+func (m *MockreassignPRService) ReassignReviewer(ctx context.Context, prID, oldReviewerID, actor string) (models.PullRequest, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ReassignReviewer", ctx, prID, oldReviewerID)
+	ret := m.ctrl.Call(m, "ReassignReviewer", ctx, prID, oldReviewerID, actor)
 	ret0, _ := ret[0].(models.PullRequest)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -52,7 +52,7 @@ func (m *MockreassignPRService) ReassignReviewer(ctx context.Context, prID, oldR
 }
 
 // ReassignReviewer indicates an expected call of ReassignReviewer.
-func (mr *MockreassignPRServiceMockRecorder) ReassignReviewer(ctx, prID, oldReviewerID any) *gomock.Call {
+func (mr *MockreassignPRServiceMockRecorder) ReassignReviewer(ctx, prID, oldReviewerID, actor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockreassignPRService)(nil).ReassignReviewer), ctx, prID, oldReviewerID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReassignReviewer", reflect.TypeOf((*MockreassignPRService)(nil).ReassignReviewer), ctx, prID, oldReviewerID, actor)
 }
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package pr_rebalance_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/usecase/rebalance_prs"
+)
+
+type rebalanceService interface {
+	Rebalance(ctx context.Context, teamName, actor string) ([]rebalance_prs.RebalancedPR, error)
+}
@@ -0,0 +1,62 @@
+package pr_rebalance_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	rebalanceService rebalanceService
+}
+
+func New(rebalanceService rebalanceService) *Handler {
+	return &Handler{
+		rebalanceService: rebalanceService,
+	}
+}
+
+func (h *Handler) PRRebalancePost(ctx echo.Context) error {
+	var input generated.PostPullRequestRebalanceJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	var teamName string
+	if input.TeamName != nil {
+		teamName = *input.TeamName
+	}
+
+	principal, ok := auth.PrincipalFromContext(ctx.Request().Context())
+	if ok && !principal.CanManageTeam(teamName) {
+		return rpc_errors.RespondForbidden(ctx, "team leads can only rebalance their own team")
+	}
+
+	result, err := h.rebalanceService.Rebalance(ctx.Request().Context(), teamName, principal.Subject)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	rebalanced := make([]generated.RebalancedPullRequest, 0, len(result))
+	for _, pr := range result {
+		added := make([]generated.ReviewerAssignment, 0, len(pr.Added))
+		for _, r := range pr.Added {
+			added = append(added, generated.ReviewerAssignment{
+				UserId:     r.ReviewerID,
+				SourceTeam: r.SourceTeam,
+			})
+		}
+		rebalanced = append(rebalanced, generated.RebalancedPullRequest{
+			PullRequestId:  pr.PRID,
+			AddedReviewers: added,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"rebalanced": rebalanced,
+	})
+}
@@ -0,0 +1,161 @@
+package pr_rebalance_post
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_rebalance_post/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/rebalance_prs"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/rebalance", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_PRRebalancePost(t *testing.T) {
+	t.Run("successful rebalance for a team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockrebalanceService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"team_name":"backend"}`)
+
+		mockService.EXPECT().
+			Rebalance(gomock.Any(), "backend", "").
+			Return([]rebalance_prs.RebalancedPR{
+				{
+					PRID:  "pr-1001",
+					Added: []models.Reviewer{{ReviewerID: "u3", SourceTeam: "backend"}},
+				},
+			}, nil)
+
+		err := handler.PRRebalancePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Rebalanced []generated.RebalancedPullRequest `json:"rebalanced"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []generated.RebalancedPullRequest{
+			{
+				PullRequestId:  "pr-1001",
+				AddedReviewers: []generated.ReviewerAssignment{{UserId: "u3", SourceTeam: "backend"}},
+			},
+		}, response.Rebalanced)
+	})
+
+	t.Run("empty body rebalances all teams", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockrebalanceService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "")
+
+		mockService.EXPECT().
+			Rebalance(gomock.Any(), "", "").
+			Return([]rebalance_prs.RebalancedPR{}, nil)
+
+		err := handler.PRRebalancePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"rebalanced":[]}`, rec.Body.String())
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockrebalanceService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "invalid json")
+
+		err := handler.PRRebalancePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockrebalanceService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "{}")
+
+		mockService.EXPECT().
+			Rebalance(gomock.Any(), "", "").
+			Return(nil, errors.New("database error"))
+
+		err := handler.PRRebalancePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+	t.Run("team lead rebalances own team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockrebalanceService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"team_name":"backend"}`)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "backend"})))
+
+		mockService.EXPECT().
+			Rebalance(gomock.Any(), "backend", "lead").
+			Return(nil, nil)
+
+		err := handler.PRRebalancePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("forbidden - team lead rebalances all teams", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockrebalanceService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "{}")
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "backend"})))
+
+		err := handler.PRRebalancePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
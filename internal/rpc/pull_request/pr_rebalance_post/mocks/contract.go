@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	rebalance_prs "github.com/loloneme/potential-waffle/internal/usecase/rebalance_prs"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockrebalanceService is a mock of rebalanceService interface.
+type MockrebalanceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrebalanceServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockrebalanceServiceMockRecorder is the mock recorder for MockrebalanceService.
+type MockrebalanceServiceMockRecorder struct {
+	mock *MockrebalanceService
+}
+
+// NewMockrebalanceService creates a new mock instance.
+func NewMockrebalanceService(ctrl *gomock.Controller) *MockrebalanceService {
+	mock := &MockrebalanceService{ctrl: ctrl}
+	mock.recorder = &MockrebalanceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrebalanceService) EXPECT() *MockrebalanceServiceMockRecorder {
+	return m.recorder
+}
+
+// Rebalance mocks base method.
+func (m *MockrebalanceService) Rebalance(ctx context.Context, teamName, actor string) ([]rebalance_prs.RebalancedPR, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebalance", ctx, teamName, actor)
+	ret0, _ := ret[0].([]rebalance_prs.RebalancedPR)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rebalance indicates an expected call of Rebalance.
+func (mr *MockrebalanceServiceMockRecorder) Rebalance(ctx, teamName, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebalance", reflect.TypeOf((*MockrebalanceService)(nil).Rebalance), ctx, teamName, actor)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package pr_review_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type reviewPRService interface {
+	SubmitReview(ctx context.Context, prID, reviewerID, state, comment string) (models.PullRequest, []models.ReviewEvent, error)
+}
@@ -0,0 +1,47 @@
+package pr_review_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	reviewPRService reviewPRService
+}
+
+func New(reviewPRService reviewPRService) *Handler {
+	return &Handler{
+		reviewPRService: reviewPRService,
+	}
+}
+
+func (h *Handler) PRReviewPost(ctx echo.Context) error {
+	var input generated.PostPullRequestReviewJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	var comment string
+	if input.Comment != nil {
+		comment = *input.Comment
+	}
+
+	pr, events, err := h.reviewPRService.SubmitReview(ctx.Request().Context(),
+		input.PullRequestId,
+		input.ReviewerId,
+		string(input.State),
+		comment)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pr":      converter.ToOpenAPIPullRequest(pr),
+		"reviews": converter.ToOpenAPIReviewEvents(events),
+	})
+}
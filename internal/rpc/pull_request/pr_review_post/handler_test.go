@@ -0,0 +1,117 @@
+package pr_review_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_review_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validReviewJSON = `{"pull_request_id":"pr-1001","reviewer_id":"u2","state":"APPROVED","comment":"LGTM"}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/review", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_PRReviewPost(t *testing.T) {
+	t.Run("successful review", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockreviewPRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validReviewJSON)
+
+		reviewedAt := time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC)
+		expectedPR := models.PullRequest{
+			ID:        "pr-1001",
+			Name:      "Add search",
+			AuthorID:  "u1",
+			Status:    &models.Status{Name: "OPEN"},
+			Reviewers: []string{"u2"},
+			Assignments: []models.Reviewer{
+				{ReviewerID: "u2", SourceTeam: "backend", ReviewState: models.ReviewStateApproved, ReviewedAt: &reviewedAt},
+			},
+		}
+		expectedEvents := []models.ReviewEvent{
+			{ReviewerID: "u2", State: models.ReviewStateApproved, Comment: "LGTM", CreatedAt: reviewedAt},
+		}
+
+		mockService.EXPECT().
+			SubmitReview(gomock.Any(), "pr-1001", "u2", "APPROVED", "LGTM").
+			Return(expectedPR, expectedEvents, nil)
+
+		err := handler.PRReviewPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			PR      generated.PullRequest   `json:"pr"`
+			Reviews []generated.ReviewEvent `json:"reviews"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "pr-1001", response.PR.PullRequestId)
+		assert.NotNil(t, response.PR.ReviewerAssignments)
+		assert.Equal(t, generated.ReviewStateAPPROVED, *(*response.PR.ReviewerAssignments)[0].ReviewState)
+		assert.Len(t, response.Reviews, 1)
+		assert.Equal(t, "LGTM", *response.Reviews[0].Comment)
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockreviewPRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "invalid json")
+
+		err := handler.PRReviewPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("reviewer not assigned", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockreviewPRService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validReviewJSON)
+
+		mockService.EXPECT().
+			SubmitReview(gomock.Any(), "pr-1001", "u2", "APPROVED", "LGTM").
+			Return(models.PullRequest{}, nil, rpc_errors.NewNotAssigned("reviewer is not assigned to this PR"))
+
+		err := handler.PRReviewPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.NOTASSIGNED, response.Error.Code)
+	})
+}
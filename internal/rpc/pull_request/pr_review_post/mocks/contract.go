@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockreviewPRService is a mock of reviewPRService interface.
+type MockreviewPRService struct {
+	ctrl     *gomock.Controller
+	recorder *MockreviewPRServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockreviewPRServiceMockRecorder is the mock recorder for MockreviewPRService.
+type MockreviewPRServiceMockRecorder struct {
+	mock *MockreviewPRService
+}
+
+// NewMockreviewPRService creates a new mock instance.
+func NewMockreviewPRService(ctrl *gomock.Controller) *MockreviewPRService {
+	mock := &MockreviewPRService{ctrl: ctrl}
+	mock.recorder = &MockreviewPRServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreviewPRService) EXPECT() *MockreviewPRServiceMockRecorder {
+	return m.recorder
+}
+
+// SubmitReview mocks base method.
+func (m *MockreviewPRService) SubmitReview(ctx context.Context, prID, reviewerID, state, comment string) (models.PullRequest, []models.ReviewEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitReview", ctx, prID, reviewerID, state, comment)
+	ret0, _ := ret[0].(models.PullRequest)
+	ret1, _ := ret[1].([]models.ReviewEvent)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SubmitReview indicates an expected call of SubmitReview.
+func (mr *MockreviewPRServiceMockRecorder) SubmitReview(ctx, prID, reviewerID, state, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitReview", reflect.TypeOf((*MockreviewPRService)(nil).SubmitReview), ctx, prID, reviewerID, state, comment)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package pr_set_status_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type setPRStatusService interface {
+	SetStatus(ctx context.Context, prID, statusName, actor string) (models.PullRequest, error)
+}
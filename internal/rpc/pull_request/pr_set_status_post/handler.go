@@ -0,0 +1,49 @@
+package pr_set_status_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	setPRStatusService setPRStatusService
+}
+
+func New(setPRStatusService setPRStatusService) *Handler {
+	return &Handler{
+		setPRStatusService: setPRStatusService,
+	}
+}
+
+func (h *Handler) PRSetStatusPost(ctx echo.Context) error {
+	var input generated.PostPullRequestSetStatusJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	switch input.Status {
+	case generated.PostPullRequestSetStatusJSONBodyStatusDRAFT,
+		generated.PostPullRequestSetStatusJSONBodyStatusREADYFORREVIEW,
+		generated.PostPullRequestSetStatusJSONBodyStatusCLOSED,
+		generated.PostPullRequestSetStatusJSONBodyStatusREOPENED:
+	default:
+		return rpc_errors.RespondBadRequest(ctx, "unknown status")
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	pr, err := h.setPRStatusService.SetStatus(ctx.Request().Context(), input.PullRequestId, string(input.Status), principal.Subject)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"pr": converter.ToOpenAPIPullRequest(pr),
+	})
+}
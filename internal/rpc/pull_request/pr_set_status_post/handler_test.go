@@ -0,0 +1,105 @@
+package pr_set_status_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_set_status_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validSetStatusJSON = `{"pull_request_id":"pr-1001","status":"READY_FOR_REVIEW"}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/pullRequest/setStatus", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_PRSetStatusPost(t *testing.T) {
+	t.Run("successful status change", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetPRStatusService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetStatusJSON)
+
+		expectedPR := models.PullRequest{
+			ID:        "pr-1001",
+			Name:      "Add search",
+			AuthorID:  "u1",
+			Status:    &models.Status{Name: "READY_FOR_REVIEW"},
+			Reviewers: []string{"u2", "u3"},
+		}
+
+		mockService.EXPECT().
+			SetStatus(gomock.Any(), "pr-1001", "READY_FOR_REVIEW", "").
+			Return(expectedPR, nil)
+
+		err := handler.PRSetStatusPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			PR generated.PullRequest `json:"pr"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.PullRequestStatusREADYFORREVIEW, response.PR.Status)
+		assert.Equal(t, []string{"u2", "u3"}, response.PR.AssignedReviewers)
+	})
+
+	t.Run("bad request - unknown status", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetPRStatusService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"pull_request_id":"pr-1001","status":"MERGED"}`)
+
+		err := handler.PRSetStatusPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid transition", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetPRStatusService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetStatusJSON)
+
+		mockService.EXPECT().
+			SetStatus(gomock.Any(), "pr-1001", "READY_FOR_REVIEW", "").
+			Return(models.PullRequest{}, rpc_errors.NewInvalidTransition("cannot move PR from MERGED to READY_FOR_REVIEW"))
+
+		err := handler.PRSetStatusPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.INVALIDTRANSITION, response.Error.Code)
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksetPRStatusService is a mock of setPRStatusService interface.
+type MocksetPRStatusService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetPRStatusServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocksetPRStatusServiceMockRecorder is the mock recorder for MocksetPRStatusService.
+type MocksetPRStatusServiceMockRecorder struct {
+	mock *MocksetPRStatusService
+}
+
+// NewMocksetPRStatusService creates a new mock instance.
+func NewMocksetPRStatusService(ctrl *gomock.Controller) *MocksetPRStatusService {
+	mock := &MocksetPRStatusService{ctrl: ctrl}
+	mock.recorder = &MocksetPRStatusServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetPRStatusService) EXPECT() *MocksetPRStatusServiceMockRecorder {
+	return m.recorder
+}
+
+// SetStatus mocks base method.
+func (m *MocksetPRStatusService) SetStatus(ctx context.Context, prID, statusName, actor string) (models.PullRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetStatus", ctx, prID, statusName, actor)
+	ret0, _ := ret[0].(models.PullRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetStatus indicates an expected call of SetStatus.
+func (mr *MocksetPRStatusServiceMockRecorder) SetStatus(ctx, prID, statusName, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStatus", reflect.TypeOf((*MocksetPRStatusService)(nil).SetStatus), ctx, prID, statusName, actor)
+}
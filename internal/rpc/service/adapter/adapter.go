@@ -4,51 +4,147 @@ import (
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_create_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_history_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_list_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_merge_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_overdue_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_reassign_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_rebalance_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_review_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/pull_request/pr_set_status_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/statistics/statistics_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/team/team_add_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_archive_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_code_owners_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_code_owners_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/team/team_get_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_history_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_move_member_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_rename_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_settings_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_bulk_deactivate_post"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_get_review_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_history_get"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_is_active_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_max_open_reviews_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_skills_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_skills_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_add_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_delete_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_list_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_delete_post"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_list_get"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_subscribe_post"
 )
 
 type Adapter struct {
-	createTeamHandler *team_add_post.Handler
-	getTeamHandler    *team_get_get.Handler
+	createTeamHandler         *team_add_post.Handler
+	getTeamHandler            *team_get_get.Handler
+	updateTeamSettingsHandler *team_settings_post.Handler
+	renameTeamHandler         *team_rename_post.Handler
+	archiveTeamHandler        *team_archive_post.Handler
+	moveTeamMemberHandler     *team_move_member_post.Handler
+	teamHistoryHandler        *team_history_get.Handler
+	getCodeOwnersHandler      *team_code_owners_get.Handler
+	setCodeOwnersHandler      *team_code_owners_post.Handler
 
 	createPullRequestHandler   *pr_create_post.Handler
 	mergePullRequestHandler    *pr_merge_post.Handler
 	reassignPullRequestHandler *pr_reassign_post.Handler
+	rebalanceHandler           *pr_rebalance_post.Handler
+	reviewPullRequestHandler   *pr_review_post.Handler
+	setStatusHandler           *pr_set_status_post.Handler
+	prHistoryHandler           *pr_history_get.Handler
+	listPullRequestsHandler    *pr_list_get.Handler
+	overdueReviewsHandler      *pr_overdue_get.Handler
 
-	getUsersReviewHandler *users_get_review_get.Handler
-	setIsActiveHandler    *users_set_is_active_post.Handler
-	bulkDeactivateHandler *users_bulk_deactivate_post.Handler
-	getStatisticsHandler  *statistics_get.Handler
+	getUsersReviewHandler  *users_get_review_get.Handler
+	getUsersHistoryHandler *users_history_get.Handler
+	setIsActiveHandler     *users_set_is_active_post.Handler
+	bulkDeactivateHandler  *users_bulk_deactivate_post.Handler
+	getSkillsHandler       *users_skills_get.Handler
+	setSkillsHandler       *users_set_skills_post.Handler
+
+	setMaxOpenReviewsHandler *users_set_max_open_reviews_post.Handler
+
+	addUnavailabilityHandler    *users_unavailability_add_post.Handler
+	listUnavailabilityHandler   *users_unavailability_list_get.Handler
+	deleteUnavailabilityHandler *users_unavailability_delete_post.Handler
+
+	getStatisticsHandler *statistics_get.Handler
+
+	subscribeWebhookHandler *webhooks_subscribe_post.Handler
+	listWebhooksHandler     *webhooks_list_get.Handler
+	deleteWebhookHandler    *webhooks_delete_post.Handler
 }
 
 func NewAdapter(
 	createTeamHandler *team_add_post.Handler,
 	getTeamHandler *team_get_get.Handler,
+	updateTeamSettingsHandler *team_settings_post.Handler,
+	renameTeamHandler *team_rename_post.Handler,
+	archiveTeamHandler *team_archive_post.Handler,
+	moveTeamMemberHandler *team_move_member_post.Handler,
+	teamHistoryHandler *team_history_get.Handler,
+	getCodeOwnersHandler *team_code_owners_get.Handler,
+	setCodeOwnersHandler *team_code_owners_post.Handler,
 	createPullRequestHandler *pr_create_post.Handler,
 	mergePullRequestHandler *pr_merge_post.Handler,
 	reassignPullRequestHandler *pr_reassign_post.Handler,
+	rebalanceHandler *pr_rebalance_post.Handler,
+	reviewPullRequestHandler *pr_review_post.Handler,
+	setStatusHandler *pr_set_status_post.Handler,
+	prHistoryHandler *pr_history_get.Handler,
+	listPullRequestsHandler *pr_list_get.Handler,
+	overdueReviewsHandler *pr_overdue_get.Handler,
 	getUsersReviewHandler *users_get_review_get.Handler,
+	getUsersHistoryHandler *users_history_get.Handler,
 	setIsActiveHandler *users_set_is_active_post.Handler,
 	bulkDeactivateHandler *users_bulk_deactivate_post.Handler,
+	getSkillsHandler *users_skills_get.Handler,
+	setSkillsHandler *users_set_skills_post.Handler,
+	setMaxOpenReviewsHandler *users_set_max_open_reviews_post.Handler,
+	addUnavailabilityHandler *users_unavailability_add_post.Handler,
+	listUnavailabilityHandler *users_unavailability_list_get.Handler,
+	deleteUnavailabilityHandler *users_unavailability_delete_post.Handler,
 	getStatisticsHandler *statistics_get.Handler,
+	subscribeWebhookHandler *webhooks_subscribe_post.Handler,
+	listWebhooksHandler *webhooks_list_get.Handler,
+	deleteWebhookHandler *webhooks_delete_post.Handler,
 ) *Adapter {
 	return &Adapter{
-		createTeamHandler:          createTeamHandler,
-		getTeamHandler:             getTeamHandler,
-		createPullRequestHandler:   createPullRequestHandler,
-		mergePullRequestHandler:    mergePullRequestHandler,
-		reassignPullRequestHandler: reassignPullRequestHandler,
-		getUsersReviewHandler:      getUsersReviewHandler,
-		setIsActiveHandler:         setIsActiveHandler,
-		bulkDeactivateHandler:      bulkDeactivateHandler,
-		getStatisticsHandler:       getStatisticsHandler,
+		createTeamHandler:           createTeamHandler,
+		getTeamHandler:              getTeamHandler,
+		updateTeamSettingsHandler:   updateTeamSettingsHandler,
+		renameTeamHandler:           renameTeamHandler,
+		archiveTeamHandler:          archiveTeamHandler,
+		moveTeamMemberHandler:       moveTeamMemberHandler,
+		teamHistoryHandler:          teamHistoryHandler,
+		getCodeOwnersHandler:        getCodeOwnersHandler,
+		setCodeOwnersHandler:        setCodeOwnersHandler,
+		createPullRequestHandler:    createPullRequestHandler,
+		mergePullRequestHandler:     mergePullRequestHandler,
+		reassignPullRequestHandler:  reassignPullRequestHandler,
+		rebalanceHandler:            rebalanceHandler,
+		reviewPullRequestHandler:    reviewPullRequestHandler,
+		setStatusHandler:            setStatusHandler,
+		prHistoryHandler:            prHistoryHandler,
+		listPullRequestsHandler:     listPullRequestsHandler,
+		overdueReviewsHandler:       overdueReviewsHandler,
+		getUsersReviewHandler:       getUsersReviewHandler,
+		getUsersHistoryHandler:      getUsersHistoryHandler,
+		setIsActiveHandler:          setIsActiveHandler,
+		bulkDeactivateHandler:       bulkDeactivateHandler,
+		getSkillsHandler:            getSkillsHandler,
+		setSkillsHandler:            setSkillsHandler,
+		setMaxOpenReviewsHandler:    setMaxOpenReviewsHandler,
+		addUnavailabilityHandler:    addUnavailabilityHandler,
+		listUnavailabilityHandler:   listUnavailabilityHandler,
+		deleteUnavailabilityHandler: deleteUnavailabilityHandler,
+		getStatisticsHandler:        getStatisticsHandler,
+		subscribeWebhookHandler:     subscribeWebhookHandler,
+		listWebhooksHandler:         listWebhooksHandler,
+		deleteWebhookHandler:        deleteWebhookHandler,
 	}
 }
 
@@ -64,6 +160,30 @@ func (a *Adapter) PostPullRequestReassign(ctx echo.Context) error {
 	return a.reassignPullRequestHandler.PRReassignPost(ctx)
 }
 
+func (a *Adapter) PostPullRequestRebalance(ctx echo.Context) error {
+	return a.rebalanceHandler.PRRebalancePost(ctx)
+}
+
+func (a *Adapter) PostPullRequestReview(ctx echo.Context) error {
+	return a.reviewPullRequestHandler.PRReviewPost(ctx)
+}
+
+func (a *Adapter) PostPullRequestSetStatus(ctx echo.Context) error {
+	return a.setStatusHandler.PRSetStatusPost(ctx)
+}
+
+func (a *Adapter) GetPullRequestHistory(ctx echo.Context, params generated.GetPullRequestHistoryParams) error {
+	return a.prHistoryHandler.PRHistoryGet(ctx, params)
+}
+
+func (a *Adapter) GetPullRequestList(ctx echo.Context, params generated.GetPullRequestListParams) error {
+	return a.listPullRequestsHandler.PRListGet(ctx, params)
+}
+
+func (a *Adapter) GetPullRequestOverdue(ctx echo.Context, params generated.GetPullRequestOverdueParams) error {
+	return a.overdueReviewsHandler.PROverdueGet(ctx, params)
+}
+
 func (a *Adapter) PostTeamAdd(ctx echo.Context) error {
 	return a.createTeamHandler.TeamAddPost(ctx)
 }
@@ -72,10 +192,42 @@ func (a *Adapter) GetTeamGet(ctx echo.Context, params generated.GetTeamGetParams
 	return a.getTeamHandler.TeamGetGet(ctx, params)
 }
 
+func (a *Adapter) PostTeamSettings(ctx echo.Context) error {
+	return a.updateTeamSettingsHandler.TeamSettingsPost(ctx)
+}
+
+func (a *Adapter) PostTeamRename(ctx echo.Context) error {
+	return a.renameTeamHandler.TeamRenamePost(ctx)
+}
+
+func (a *Adapter) PostTeamArchive(ctx echo.Context) error {
+	return a.archiveTeamHandler.TeamArchivePost(ctx)
+}
+
+func (a *Adapter) PostTeamMoveMember(ctx echo.Context) error {
+	return a.moveTeamMemberHandler.TeamMoveMemberPost(ctx)
+}
+
+func (a *Adapter) GetTeamHistory(ctx echo.Context, params generated.GetTeamHistoryParams) error {
+	return a.teamHistoryHandler.TeamHistoryGet(ctx, params)
+}
+
+func (a *Adapter) GetTeamCodeOwners(ctx echo.Context, params generated.GetTeamCodeOwnersParams) error {
+	return a.getCodeOwnersHandler.TeamCodeOwnersGet(ctx, params)
+}
+
+func (a *Adapter) PostTeamCodeOwners(ctx echo.Context) error {
+	return a.setCodeOwnersHandler.TeamCodeOwnersPost(ctx)
+}
+
 func (a *Adapter) GetUsersGetReview(ctx echo.Context, params generated.GetUsersGetReviewParams) error {
 	return a.getUsersReviewHandler.UsersGetReviewGet(ctx, params)
 }
 
+func (a *Adapter) GetUsersHistory(ctx echo.Context, params generated.GetUsersHistoryParams) error {
+	return a.getUsersHistoryHandler.UsersHistoryGet(ctx, params)
+}
+
 func (a *Adapter) PostUsersSetIsActive(ctx echo.Context) error {
 	return a.setIsActiveHandler.UsersSetIsActivePost(ctx)
 }
@@ -84,6 +236,42 @@ func (a *Adapter) PostUsersBulkDeactivate(ctx echo.Context) error {
 	return a.bulkDeactivateHandler.UsersBulkDeactivatePost(ctx)
 }
 
-func (a *Adapter) GetStatistics(ctx echo.Context) error {
-	return a.getStatisticsHandler.StatisticsGet(ctx)
+func (a *Adapter) GetUsersSkills(ctx echo.Context, params generated.GetUsersSkillsParams) error {
+	return a.getSkillsHandler.UsersSkillsGet(ctx, params)
+}
+
+func (a *Adapter) PostUsersSetSkills(ctx echo.Context) error {
+	return a.setSkillsHandler.UsersSetSkillsPost(ctx)
+}
+
+func (a *Adapter) PostUsersSetMaxOpenReviews(ctx echo.Context) error {
+	return a.setMaxOpenReviewsHandler.UsersSetMaxOpenReviewsPost(ctx)
+}
+
+func (a *Adapter) PostUsersUnavailabilityAdd(ctx echo.Context) error {
+	return a.addUnavailabilityHandler.UsersUnavailabilityAddPost(ctx)
+}
+
+func (a *Adapter) GetUsersUnavailabilityList(ctx echo.Context, params generated.GetUsersUnavailabilityListParams) error {
+	return a.listUnavailabilityHandler.UsersUnavailabilityListGet(ctx, params)
+}
+
+func (a *Adapter) PostUsersUnavailabilityDelete(ctx echo.Context) error {
+	return a.deleteUnavailabilityHandler.UsersUnavailabilityDeletePost(ctx)
+}
+
+func (a *Adapter) GetStatistics(ctx echo.Context, params generated.GetStatisticsParams) error {
+	return a.getStatisticsHandler.StatisticsGet(ctx, params)
+}
+
+func (a *Adapter) PostWebhooksSubscribe(ctx echo.Context) error {
+	return a.subscribeWebhookHandler.WebhooksSubscribePost(ctx)
+}
+
+func (a *Adapter) GetWebhooksList(ctx echo.Context) error {
+	return a.listWebhooksHandler.WebhooksListGet(ctx)
+}
+
+func (a *Adapter) PostWebhooksDelete(ctx echo.Context) error {
+	return a.deleteWebhookHandler.WebhooksDeletePost(ctx)
 }
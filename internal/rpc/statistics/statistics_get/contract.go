@@ -5,9 +5,9 @@ package statistics_get
 import (
 	"context"
 
-	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/usecase/get_statistics"
 )
 
-type prRepo interface {
-	GetStatistics(ctx context.Context) (*pull_request.Statistics, error)
+type statisticsService interface {
+	GetStatistics(ctx context.Context, filter get_statistics.Filter) (get_statistics.Report, error)
 }
@@ -2,18 +2,22 @@ package statistics_get
 
 import (
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/get_statistics"
 )
 
 type Handler struct {
-	prRepo prRepo
+	statisticsService statisticsService
 }
 
-func New(prRepo prRepo) *Handler {
+func New(statisticsService statisticsService) *Handler {
 	return &Handler{
-		prRepo: prRepo,
+		statisticsService: statisticsService,
 	}
 }
 
@@ -27,15 +31,63 @@ type PRAssignmentStat struct {
 	Count         int    `json:"count"`
 }
 
+type DurationStat struct {
+	Count      int      `json:"count"`
+	P50Seconds *float64 `json:"p50_seconds,omitempty"`
+	P90Seconds *float64 `json:"p90_seconds,omitempty"`
+	P95Seconds *float64 `json:"p95_seconds,omitempty"`
+}
+
+type WeeklyMergesStat struct {
+	WeekStart time.Time `json:"week_start"`
+	Count     int       `json:"count"`
+}
+
+type UserReassignmentStat struct {
+	UserID         string `json:"user_id"`
+	ReassignedFrom int    `json:"reassigned_from"`
+	ReassignedTo   int    `json:"reassigned_to"`
+}
+
+type ReviewerLoadStat struct {
+	UserID         string `json:"user_id"`
+	OpenReviews    int    `json:"open_reviews"`
+	PendingReviews int    `json:"pending_reviews"`
+}
+
+type PRWithoutReviewersStat struct {
+	PullRequestID   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+	AuthorID        string    `json:"author_id"`
+	Status          string    `json:"status"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
 type StatisticsResponse struct {
-	AssignmentsByUser []UserAssignmentStat `json:"assignments_by_user"`
-	AssignmentsByPR   []PRAssignmentStat   `json:"assignments_by_pr"`
+	From                time.Time                `json:"from"`
+	To                  time.Time                `json:"to"`
+	AssignmentsByUser   []UserAssignmentStat     `json:"assignments_by_user"`
+	AssignmentsByPR     []PRAssignmentStat       `json:"assignments_by_pr"`
+	TimeToMerge         DurationStat             `json:"time_to_merge"`
+	ReviewLatency       DurationStat             `json:"review_latency"`
+	MergesPerWeek       []WeeklyMergesStat       `json:"merges_per_week"`
+	ReassignmentsByUser []UserReassignmentStat   `json:"reassignments_by_user"`
+	OpenReviewLoad      []ReviewerLoadStat       `json:"open_review_load"`
+	PRsWithoutReviewers []PRWithoutReviewersStat `json:"prs_without_reviewers"`
 }
 
-func (h *Handler) StatisticsGet(ctx echo.Context) error {
-	stats, err := h.prRepo.GetStatistics(ctx.Request().Context())
+func (h *Handler) StatisticsGet(ctx echo.Context, params generated.GetStatisticsParams) error {
+	filter := get_statistics.Filter{
+		From: params.From,
+		To:   params.To,
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+
+	stats, err := h.statisticsService.GetStatistics(ctx.Request().Context(), filter)
 	if err != nil {
-		return rpc_errors.RespondInternal(ctx, "")
+		return rpc_errors.RespondFromError(ctx, err)
 	}
 
 	assignmentsByUser := make([]UserAssignmentStat, len(stats.AssignmentsByUser))
@@ -54,10 +106,64 @@ func (h *Handler) StatisticsGet(ctx echo.Context) error {
 		}
 	}
 
+	mergesPerWeek := make([]WeeklyMergesStat, len(stats.MergesPerWeek))
+	for i, stat := range stats.MergesPerWeek {
+		mergesPerWeek[i] = WeeklyMergesStat{
+			WeekStart: stat.WeekStart,
+			Count:     stat.Count,
+		}
+	}
+
+	reassignmentsByUser := make([]UserReassignmentStat, len(stats.ReassignmentsByUser))
+	for i, stat := range stats.ReassignmentsByUser {
+		reassignmentsByUser[i] = UserReassignmentStat{
+			UserID:         stat.UserID,
+			ReassignedFrom: stat.ReassignedFrom,
+			ReassignedTo:   stat.ReassignedTo,
+		}
+	}
+
+	openReviewLoad := make([]ReviewerLoadStat, len(stats.OpenReviewLoad))
+	for i, stat := range stats.OpenReviewLoad {
+		openReviewLoad[i] = ReviewerLoadStat{
+			UserID:         stat.UserID,
+			OpenReviews:    stat.OpenReviews,
+			PendingReviews: stat.PendingReviews,
+		}
+	}
+
+	prsWithoutReviewers := make([]PRWithoutReviewersStat, len(stats.PRsWithoutReviewers))
+	for i, stat := range stats.PRsWithoutReviewers {
+		prsWithoutReviewers[i] = PRWithoutReviewersStat{
+			PullRequestID:   stat.PullRequestID,
+			PullRequestName: stat.Name,
+			AuthorID:        stat.AuthorID,
+			Status:          stat.Status,
+			CreatedAt:       stat.CreatedAt,
+		}
+	}
+
 	response := StatisticsResponse{
-		AssignmentsByUser: assignmentsByUser,
-		AssignmentsByPR:   assignmentsByPR,
+		From:                stats.From,
+		To:                  stats.To,
+		AssignmentsByUser:   assignmentsByUser,
+		AssignmentsByPR:     assignmentsByPR,
+		TimeToMerge:         toDurationStat(stats.TimeToMerge),
+		ReviewLatency:       toDurationStat(stats.ReviewLatency),
+		MergesPerWeek:       mergesPerWeek,
+		ReassignmentsByUser: reassignmentsByUser,
+		OpenReviewLoad:      openReviewLoad,
+		PRsWithoutReviewers: prsWithoutReviewers,
 	}
 
 	return ctx.JSON(http.StatusOK, response)
 }
+
+func toDurationStat(p pull_request.DurationPercentiles) DurationStat {
+	return DurationStat{
+		Count:      p.Count,
+		P50Seconds: p.P50,
+		P90Seconds: p.P90,
+		P95Seconds: p.P95,
+	}
+}
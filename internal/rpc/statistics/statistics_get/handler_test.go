@@ -0,0 +1,110 @@
+package statistics_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/statistics/statistics_get/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/get_statistics"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func newContext() (echo.Context, *httptest.ResponseRecorder) {
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/statistics", nil)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_StatisticsGet(t *testing.T) {
+	from := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 13, 0, 0, 0, 0, time.UTC)
+
+	t.Run("successful get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockstatisticsService(ctrl)
+		handler := New(mockService)
+		c, rec := newContext()
+
+		mockService.EXPECT().
+			GetStatistics(gomock.Any(), get_statistics.Filter{From: &from, To: &to, TeamName: "backend"}).
+			Return(get_statistics.Report{
+				From: from,
+				To:   to,
+				Statistics: &pull_request.Statistics{
+					AssignmentsByUser:   []pull_request.UserAssignmentStats{{UserID: "u2", Count: 3}},
+					AssignmentsByPR:     []pull_request.PRAssignmentStats{{PullRequestID: "pr-1", Count: 2}},
+					TimeToMerge:         pull_request.DurationPercentiles{Count: 2, P50: ptr.To(3600.0), P90: ptr.To(7200.0), P95: ptr.To(7200.0)},
+					MergesPerWeek:       []pull_request.WeeklyMerges{{WeekStart: from, Count: 2}},
+					ReassignmentsByUser: []pull_request.UserReassignmentStats{{UserID: "u2", ReassignedFrom: 1}},
+					OpenReviewLoad:      []pull_request.ReviewerLoad{{UserID: "u3", OpenReviews: 2, PendingReviews: 1}},
+					PRsWithoutReviewers: []pull_request.PRWithoutReviewers{{PullRequestID: "pr-9", Name: "Lonely", AuthorID: "u1", Status: "OPEN", CreatedAt: from}},
+				},
+			}, nil)
+
+		err := handler.StatisticsGet(c, generated.GetStatisticsParams{From: &from, To: &to, TeamName: ptr.To("backend")})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response StatisticsResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, from, response.From)
+		assert.Equal(t, []UserAssignmentStat{{UserID: "u2", Count: 3}}, response.AssignmentsByUser)
+		assert.Equal(t, 2, response.TimeToMerge.Count)
+		assert.Equal(t, 3600.0, *response.TimeToMerge.P50Seconds)
+		assert.Equal(t, 0, response.ReviewLatency.Count)
+		assert.Nil(t, response.ReviewLatency.P50Seconds)
+		assert.Equal(t, []WeeklyMergesStat{{WeekStart: from, Count: 2}}, response.MergesPerWeek)
+		assert.Equal(t, []ReviewerLoadStat{{UserID: "u3", OpenReviews: 2, PendingReviews: 1}}, response.OpenReviewLoad)
+		assert.Equal(t, "Lonely", response.PRsWithoutReviewers[0].PullRequestName)
+	})
+
+	t.Run("invalid window", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockstatisticsService(ctrl)
+		handler := New(mockService)
+		c, rec := newContext()
+
+		mockService.EXPECT().
+			GetStatistics(gomock.Any(), get_statistics.Filter{From: &to, To: &from}).
+			Return(get_statistics.Report{}, rpc_errors.NewBadRequest("from must be before to"))
+
+		err := handler.StatisticsGet(c, generated.GetStatisticsParams{From: &to, To: &from})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockstatisticsService(ctrl)
+		handler := New(mockService)
+		c, rec := newContext()
+
+		mockService.EXPECT().
+			GetStatistics(gomock.Any(), get_statistics.Filter{}).
+			Return(get_statistics.Report{}, errors.New("db down"))
+
+		err := handler.StatisticsGet(c, generated.GetStatisticsParams{})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
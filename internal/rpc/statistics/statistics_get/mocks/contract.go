@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	get_statistics "github.com/loloneme/potential-waffle/internal/usecase/get_statistics"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockstatisticsService is a mock of statisticsService interface.
+type MockstatisticsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockstatisticsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockstatisticsServiceMockRecorder is the mock recorder for MockstatisticsService.
+type MockstatisticsServiceMockRecorder struct {
+	mock *MockstatisticsService
+}
+
+// NewMockstatisticsService creates a new mock instance.
+func NewMockstatisticsService(ctrl *gomock.Controller) *MockstatisticsService {
+	mock := &MockstatisticsService{ctrl: ctrl}
+	mock.recorder = &MockstatisticsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockstatisticsService) EXPECT() *MockstatisticsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetStatistics mocks base method.
+func (m *MockstatisticsService) GetStatistics(ctx context.Context, filter get_statistics.Filter) (get_statistics.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatistics", ctx, filter)
+	ret0, _ := ret[0].(get_statistics.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatistics indicates an expected call of GetStatistics.
+func (mr *MockstatisticsServiceMockRecorder) GetStatistics(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatistics", reflect.TypeOf((*MockstatisticsService)(nil).GetStatistics), ctx, filter)
+}
@@ -9,5 +9,5 @@ import (
 )
 
 type createTeamService interface {
-	CreateTeam(ctx context.Context, team *models.Team) (models.Team, error)
+	CreateTeam(ctx context.Context, team *models.Team, settings models.TeamSettings) (models.Team, error)
 }
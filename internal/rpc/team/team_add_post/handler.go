@@ -28,7 +28,7 @@ func (h *Handler) TeamAddPost(ctx echo.Context) error {
 
 	teamModel := converter.ToModelTeam(input)
 
-	createdTeam, err := h.createTeamService.CreateTeam(ctx.Request().Context(), teamModel)
+	createdTeam, err := h.createTeamService.CreateTeam(ctx.Request().Context(), teamModel, converter.ToModelTeamSettings(input.Settings))
 	if err != nil {
 		return rpc_errors.RespondFromError(ctx, err)
 	}
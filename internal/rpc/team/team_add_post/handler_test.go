@@ -45,7 +45,7 @@ func TestHandler_TeamAddPost(t *testing.T) {
 		}
 
 		mockService.EXPECT().
-			CreateTeam(gomock.Any(), gomock.Any()).
+			CreateTeam(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(expectedTeam, nil)
 
 		err := handler.TeamAddPost(c)
@@ -95,7 +95,7 @@ func TestHandler_TeamAddPost(t *testing.T) {
 		c, rec := makeTestRequest(e, validTeamJSON)
 
 		mockService.EXPECT().
-			CreateTeam(gomock.Any(), gomock.Any()).
+			CreateTeam(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(models.Team{}, rpc_errors.NewTeamExists("team_name already exists"))
 
 		err := handler.TeamAddPost(c)
@@ -42,16 +42,16 @@ func (m *MockcreateTeamService) EXPECT() *MockcreateTeamServiceMockRecorder {
 }
 
 // CreateTeam mocks base method.
-func (m *MockcreateTeamService) CreateTeam(ctx context.Context, team *models.Team) (models.Team, error) {
+func (m *MockcreateTeamService) CreateTeam(ctx context.Context, team *models.Team, settings models.TeamSettings) (models.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTeam", ctx, team)
+	ret := m.ctrl.Call(m, "CreateTeam", ctx, team, settings)
 	ret0, _ := ret[0].(models.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateTeam indicates an expected call of CreateTeam.
-func (mr *MockcreateTeamServiceMockRecorder) CreateTeam(ctx, team any) *gomock.Call {
+func (mr *MockcreateTeamServiceMockRecorder) CreateTeam(ctx, team, settings any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockcreateTeamService)(nil).CreateTeam), ctx, team)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTeam", reflect.TypeOf((*MockcreateTeamService)(nil).CreateTeam), ctx, team, settings)
 }
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_archive_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type archiveTeamService interface {
+	ArchiveTeam(ctx context.Context, teamName, actor string) (models.Team, error)
+}
@@ -0,0 +1,40 @@
+package team_archive_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	archiveTeamService archiveTeamService
+}
+
+func New(archiveTeamService archiveTeamService) *Handler {
+	return &Handler{
+		archiveTeamService: archiveTeamService,
+	}
+}
+
+func (h *Handler) TeamArchivePost(ctx echo.Context) error {
+	var input generated.PostTeamArchiveJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	archived, err := h.archiveTeamService.ArchiveTeam(ctx.Request().Context(), input.TeamName, principal.Subject)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team": converter.ToOpenAPITeam(archived),
+	})
+}
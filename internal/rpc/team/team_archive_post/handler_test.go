@@ -0,0 +1,104 @@
+package team_archive_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_archive_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validArchiveJSON = `{"team_name":"legacy"}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/team/archive", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_TeamArchivePost(t *testing.T) {
+	t.Run("successful archive", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockarchiveTeamService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validArchiveJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "root", Role: auth.RoleAdmin})))
+
+		archivedAt := time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC)
+		mockService.EXPECT().
+			ArchiveTeam(gomock.Any(), "legacy", "root").
+			Return(models.Team{TeamName: "legacy", ArchivedAt: &archivedAt}, nil)
+
+		err := handler.TeamArchivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Team generated.Team `json:"team"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "legacy", response.Team.TeamName)
+		assert.NotNil(t, response.Team.ArchivedAt)
+		assert.True(t, archivedAt.Equal(*response.Team.ArchivedAt))
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockarchiveTeamService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "invalid json")
+
+		err := handler.TeamArchivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("team has active members", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockarchiveTeamService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validArchiveJSON)
+
+		mockService.EXPECT().
+			ArchiveTeam(gomock.Any(), "legacy", "").
+			Return(models.Team{}, rpc_errors.NewTeamNotEmpty("team has active members: u1"))
+
+		err := handler.TeamArchivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.TEAMNOTEMPTY, response.Error.Code)
+		assert.Equal(t, "team has active members: u1", response.Error.Message)
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockarchiveTeamService is a mock of archiveTeamService interface.
+type MockarchiveTeamService struct {
+	ctrl     *gomock.Controller
+	recorder *MockarchiveTeamServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockarchiveTeamServiceMockRecorder is the mock recorder for MockarchiveTeamService.
+type MockarchiveTeamServiceMockRecorder struct {
+	mock *MockarchiveTeamService
+}
+
+// NewMockarchiveTeamService creates a new mock instance.
+func NewMockarchiveTeamService(ctrl *gomock.Controller) *MockarchiveTeamService {
+	mock := &MockarchiveTeamService{ctrl: ctrl}
+	mock.recorder = &MockarchiveTeamServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockarchiveTeamService) EXPECT() *MockarchiveTeamServiceMockRecorder {
+	return m.recorder
+}
+
+// ArchiveTeam mocks base method.
+func (m *MockarchiveTeamService) ArchiveTeam(ctx context.Context, teamName, actor string) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveTeam", ctx, teamName, actor)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveTeam indicates an expected call of ArchiveTeam.
+func (mr *MockarchiveTeamServiceMockRecorder) ArchiveTeam(ctx, teamName, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveTeam", reflect.TypeOf((*MockarchiveTeamService)(nil).ArchiveTeam), ctx, teamName, actor)
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_code_owners_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type teamRepo interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	GetCodeOwners(ctx context.Context, teamName string) (models.CodeOwners, error)
+}
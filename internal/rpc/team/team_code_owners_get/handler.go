@@ -0,0 +1,41 @@
+package team_code_owners_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	teamRepo teamRepo
+}
+
+func New(teamRepo teamRepo) *Handler {
+	return &Handler{
+		teamRepo: teamRepo,
+	}
+}
+
+func (h *Handler) TeamCodeOwnersGet(ctx echo.Context, params generated.GetTeamCodeOwnersParams) error {
+	if _, err := h.teamRepo.FindTeamByID(ctx.Request().Context(), params.TeamName); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	doc, err := h.teamRepo.GetCodeOwners(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	rules, err := codeowners.Parse(doc.Document)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"code_owners": converter.ToOpenAPICodeOwners(doc, rules),
+	})
+}
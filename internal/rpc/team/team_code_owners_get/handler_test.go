@@ -0,0 +1,114 @@
+package team_code_owners_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_code_owners_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/team/codeOwners?team_name=backend", nil)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_TeamCodeOwnersGet(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "backend").Return(models.Team{TeamName: "backend"}, nil)
+		mockRepo.EXPECT().GetCodeOwners(gomock.Any(), "backend").Return(models.CodeOwners{
+			TeamName: "backend",
+			Document: "# payments\n/payments/ @u7\n*.md @acme/docs\n",
+		}, nil)
+
+		err := handler.TeamCodeOwnersGet(c, generated.GetTeamCodeOwnersParams{TeamName: "backend"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			CodeOwners generated.CodeOwners `json:"code_owners"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, []generated.CodeOwnerRule{
+			{Line: 2, Pattern: "/payments/", Users: []string{"u7"}, Teams: []string{}},
+			{Line: 3, Pattern: "*.md", Users: []string{}, Teams: []string{"docs"}},
+		}, response.CodeOwners.Rules)
+	})
+
+	t.Run("no document", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "backend").Return(models.Team{TeamName: "backend"}, nil)
+		mockRepo.EXPECT().GetCodeOwners(gomock.Any(), "backend").Return(models.CodeOwners{TeamName: "backend"}, nil)
+
+		err := handler.TeamCodeOwnersGet(c, generated.GetTeamCodeOwnersParams{TeamName: "backend"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"rules":[]`)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "backend").Return(models.Team{}, team.ErrNotFound)
+
+		err := handler.TeamCodeOwnersGet(c, generated.GetTeamCodeOwnersParams{TeamName: "backend"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "backend").Return(models.Team{TeamName: "backend"}, nil)
+		mockRepo.EXPECT().GetCodeOwners(gomock.Any(), "backend").Return(models.CodeOwners{}, errors.New("db is down"))
+
+		err := handler.TeamCodeOwnersGet(c, generated.GetTeamCodeOwnersParams{TeamName: "backend"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
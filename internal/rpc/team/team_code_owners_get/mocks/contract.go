@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockteamRepo is a mock of teamRepo interface.
+type MockteamRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockteamRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockteamRepoMockRecorder is the mock recorder for MockteamRepo.
+type MockteamRepoMockRecorder struct {
+	mock *MockteamRepo
+}
+
+// NewMockteamRepo creates a new mock instance.
+func NewMockteamRepo(ctrl *gomock.Controller) *MockteamRepo {
+	mock := &MockteamRepo{ctrl: ctrl}
+	mock.recorder = &MockteamRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockteamRepo) EXPECT() *MockteamRepoMockRecorder {
+	return m.recorder
+}
+
+// FindTeamByID mocks base method.
+func (m *MockteamRepo) FindTeamByID(ctx context.Context, teamName string) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTeamByID", ctx, teamName)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTeamByID indicates an expected call of FindTeamByID.
+func (mr *MockteamRepoMockRecorder) FindTeamByID(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamByID", reflect.TypeOf((*MockteamRepo)(nil).FindTeamByID), ctx, teamName)
+}
+
+// GetCodeOwners mocks base method.
+func (m *MockteamRepo) GetCodeOwners(ctx context.Context, teamName string) (models.CodeOwners, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCodeOwners", ctx, teamName)
+	ret0, _ := ret[0].(models.CodeOwners)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCodeOwners indicates an expected call of GetCodeOwners.
+func (mr *MockteamRepoMockRecorder) GetCodeOwners(ctx, teamName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCodeOwners", reflect.TypeOf((*MockteamRepo)(nil).GetCodeOwners), ctx, teamName)
+}
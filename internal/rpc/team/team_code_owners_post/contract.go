@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_code_owners_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type setCodeOwnersService interface {
+	SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, []codeowners.Rule, error)
+}
@@ -0,0 +1,42 @@
+package team_code_owners_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	setCodeOwnersService setCodeOwnersService
+}
+
+func New(setCodeOwnersService setCodeOwnersService) *Handler {
+	return &Handler{
+		setCodeOwnersService: setCodeOwnersService,
+	}
+}
+
+func (h *Handler) TeamCodeOwnersPost(ctx echo.Context) error {
+	var input generated.PostTeamCodeOwnersJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx.Request().Context()); ok && !principal.CanManageTeam(input.TeamName) {
+		return rpc_errors.RespondForbidden(ctx, "team leads can only manage their own team")
+	}
+
+	doc, rules, err := h.setCodeOwnersService.SetCodeOwners(ctx.Request().Context(), input.TeamName, input.Document)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"code_owners": converter.ToOpenAPICodeOwners(doc, rules),
+	})
+}
@@ -0,0 +1,122 @@
+package team_code_owners_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_code_owners_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+const document = "/payments/ @u7 @acme/payments\n"
+
+var validCodeOwnersJSON = `{"team_name":"backend","document":"/payments/ @u7 @acme/payments\n"}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/team/codeOwners", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_TeamCodeOwnersPost(t *testing.T) {
+	t.Run("successful upload", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetCodeOwnersService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validCodeOwnersJSON)
+
+		mockService.EXPECT().
+			SetCodeOwners(gomock.Any(), "backend", document).
+			Return(
+				models.CodeOwners{TeamName: "backend", Document: document},
+				[]codeowners.Rule{{Line: 1, Pattern: "/payments/", Users: []string{"u7"}, Teams: []string{"payments"}}},
+				nil,
+			)
+
+		err := handler.TeamCodeOwnersPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			CodeOwners generated.CodeOwners `json:"code_owners"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "backend", response.CodeOwners.TeamName)
+		assert.Equal(t, document, response.CodeOwners.Document)
+		assert.Equal(t, []generated.CodeOwnerRule{
+			{Line: 1, Pattern: "/payments/", Users: []string{"u7"}, Teams: []string{"payments"}},
+		}, response.CodeOwners.Rules)
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetCodeOwnersService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "invalid json")
+
+		err := handler.TeamCodeOwnersPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid document", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetCodeOwnersService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validCodeOwnersJSON)
+
+		mockService.EXPECT().
+			SetCodeOwners(gomock.Any(), "backend", document).
+			Return(models.CodeOwners{}, nil, rpc_errors.NewBadRequest("line 1: user u7 not found"))
+
+		err := handler.TeamCodeOwnersPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "line 1: user u7 not found")
+	})
+
+	t.Run("forbidden - team lead of another team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetCodeOwnersService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validCodeOwnersJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "frontend"})))
+
+		err := handler.TeamCodeOwnersPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
@@ -0,0 +1,59 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	codeowners "github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksetCodeOwnersService is a mock of setCodeOwnersService interface.
+type MocksetCodeOwnersService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetCodeOwnersServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocksetCodeOwnersServiceMockRecorder is the mock recorder for MocksetCodeOwnersService.
+type MocksetCodeOwnersServiceMockRecorder struct {
+	mock *MocksetCodeOwnersService
+}
+
+// NewMocksetCodeOwnersService creates a new mock instance.
+func NewMocksetCodeOwnersService(ctrl *gomock.Controller) *MocksetCodeOwnersService {
+	mock := &MocksetCodeOwnersService{ctrl: ctrl}
+	mock.recorder = &MocksetCodeOwnersServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetCodeOwnersService) EXPECT() *MocksetCodeOwnersServiceMockRecorder {
+	return m.recorder
+}
+
+// SetCodeOwners mocks base method.
+func (m *MocksetCodeOwnersService) SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, []codeowners.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCodeOwners", ctx, teamName, document)
+	ret0, _ := ret[0].(models.CodeOwners)
+	ret1, _ := ret[1].([]codeowners.Rule)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SetCodeOwners indicates an expected call of SetCodeOwners.
+func (mr *MocksetCodeOwnersServiceMockRecorder) SetCodeOwners(ctx, teamName, document any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCodeOwners", reflect.TypeOf((*MocksetCodeOwnersService)(nil).SetCodeOwners), ctx, teamName, document)
+}
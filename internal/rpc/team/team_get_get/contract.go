@@ -14,5 +14,5 @@ type userRepo interface {
 }
 
 type teamRepo interface {
-	Exists(ctx context.Context, teamName string) (bool, error)
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
 }
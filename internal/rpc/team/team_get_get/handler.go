@@ -1,12 +1,13 @@
 package team_get_get
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
-	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	team_repo "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 )
@@ -24,25 +25,21 @@ func New(userRepo userRepo, teamRepo teamRepo) *Handler {
 }
 
 func (h *Handler) TeamGetGet(ctx echo.Context, params generated.GetTeamGetParams) error {
-	teamExists, err := h.teamRepo.Exists(ctx.Request().Context(), params.TeamName)
+	team, err := h.teamRepo.FindTeamByID(ctx.Request().Context(), params.TeamName)
 	if err != nil {
+		if errors.Is(err, team_repo.ErrNotFound) {
+			return rpc_errors.RespondNotFound(ctx, "team not found")
+		}
 		return rpc_errors.RespondInternal(ctx, "")
 	}
 
-	if !teamExists {
-		return rpc_errors.RespondNotFound(ctx, "team not found")
-	}
-
 	spec := user_spec.NewGetUsersByTeamNameSpec(params.TeamName)
 	users, err := h.userRepo.Find(ctx.Request().Context(), spec)
 	if err != nil {
 		return rpc_errors.RespondInternal(ctx, "")
 	}
 
-	team := models.Team{
-		TeamName: params.TeamName,
-		Members:  users,
-	}
+	team.Members = users
 
 	return ctx.JSON(http.StatusOK, converter.ToOpenAPITeam(team))
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/rpc/team/team_get_get/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -33,8 +34,8 @@ func TestHandler_TeamGetGet(t *testing.T) {
 		}
 
 		mockTeamRepo.EXPECT().
-			Exists(gomock.Any(), "team-1").
-			Return(true, nil)
+			FindTeamByID(gomock.Any(), "team-1").
+			Return(models.Team{TeamName: "team-1", AssignmentStrategy: "least_loaded"}, nil)
 
 		expectedUsers := []models.User{
 			{ID: "u1", Username: "user1", IsActive: true, TeamName: "team-1"},
@@ -55,6 +56,8 @@ func TestHandler_TeamGetGet(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "team-1", response.TeamName)
 		assert.Len(t, response.Members, 2)
+		assert.NotNil(t, response.Settings)
+		assert.Equal(t, generated.LeastLoaded, *response.Settings.AssignmentStrategy)
 	})
 
 	t.Run("team not found", func(t *testing.T) {
@@ -75,8 +78,8 @@ func TestHandler_TeamGetGet(t *testing.T) {
 		}
 
 		mockTeamRepo.EXPECT().
-			Exists(gomock.Any(), "team-1").
-			Return(false, nil)
+			FindTeamByID(gomock.Any(), "team-1").
+			Return(models.Team{}, team.ErrNotFound)
 
 		err := handler.TeamGetGet(c, params)
 
@@ -89,7 +92,7 @@ func TestHandler_TeamGetGet(t *testing.T) {
 		assert.Equal(t, generated.NOTFOUND, response.Error.Code)
 	})
 
-	t.Run("internal error - team exists check fails", func(t *testing.T) {
+	t.Run("internal error - team lookup fails", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -107,8 +110,8 @@ func TestHandler_TeamGetGet(t *testing.T) {
 		}
 
 		mockTeamRepo.EXPECT().
-			Exists(gomock.Any(), "team-1").
-			Return(false, assert.AnError)
+			FindTeamByID(gomock.Any(), "team-1").
+			Return(models.Team{}, assert.AnError)
 
 		err := handler.TeamGetGet(c, params)
 
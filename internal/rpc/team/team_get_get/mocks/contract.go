@@ -81,17 +81,17 @@ func (m *MockteamRepo) EXPECT() *MockteamRepoMockRecorder {
 	return m.recorder
 }
 
-// Exists mocks base method.
-func (m *MockteamRepo) Exists(ctx context.Context, teamName string) (bool, error) {
+// FindTeamByID mocks base method.
+func (m *MockteamRepo) FindTeamByID(ctx context.Context, teamName string) (models.Team, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Exists", ctx, teamName)
-	ret0, _ := ret[0].(bool)
+	ret := m.ctrl.Call(m, "FindTeamByID", ctx, teamName)
+	ret0, _ := ret[0].(models.Team)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Exists indicates an expected call of Exists.
-func (mr *MockteamRepoMockRecorder) Exists(ctx, teamName any) *gomock.Call {
+// FindTeamByID indicates an expected call of FindTeamByID.
+func (mr *MockteamRepoMockRecorder) FindTeamByID(ctx, teamName any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockteamRepo)(nil).Exists), ctx, teamName)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTeamByID", reflect.TypeOf((*MockteamRepo)(nil).FindTeamByID), ctx, teamName)
 }
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_history_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type teamRepo interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	GetTeamEvents(ctx context.Context, teamName string) ([]models.TeamEvent, error)
+}
@@ -0,0 +1,36 @@
+package team_history_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	teamRepo teamRepo
+}
+
+func New(teamRepo teamRepo) *Handler {
+	return &Handler{
+		teamRepo: teamRepo,
+	}
+}
+
+func (h *Handler) TeamHistoryGet(ctx echo.Context, params generated.GetTeamHistoryParams) error {
+	if _, err := h.teamRepo.FindTeamByID(ctx.Request().Context(), params.TeamName); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	events, err := h.teamRepo.GetTeamEvents(ctx.Request().Context(), params.TeamName)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team_name": params.TeamName,
+		"events":    converter.ToOpenAPITeamEvents(events),
+	})
+}
@@ -0,0 +1,115 @@
+package team_history_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_history_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/team/history?team_name=core", nil)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_TeamHistoryGet(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		events := []models.TeamEvent{
+			{
+				ID:        1,
+				TeamName:  "core",
+				Type:      models.TeamEventRenamed,
+				OldValue:  "backend",
+				NewValue:  "core",
+				Actor:     "root",
+				CreatedAt: time.Date(2025, 11, 3, 10, 0, 0, 0, time.UTC),
+			},
+			{
+				ID:        2,
+				TeamName:  "core",
+				Type:      models.TeamEventMemberJoined,
+				UserID:    "u5",
+				OldValue:  "platform",
+				CreatedAt: time.Date(2025, 11, 3, 11, 0, 0, 0, time.UTC),
+			},
+		}
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "core").Return(models.Team{TeamName: "core"}, nil)
+		mockRepo.EXPECT().GetTeamEvents(gomock.Any(), "core").Return(events, nil)
+
+		err := handler.TeamHistoryGet(c, generated.GetTeamHistoryParams{TeamName: "core"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			TeamName string                `json:"team_name"`
+			Events   []generated.TeamEvent `json:"events"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "core", response.TeamName)
+		assert.Len(t, response.Events, 2)
+		assert.Equal(t, generated.RENAMED, response.Events[0].EventType)
+		assert.Equal(t, "backend", *response.Events[0].OldValue)
+		assert.Equal(t, "u5", *response.Events[1].UserId)
+		assert.Nil(t, response.Events[1].Actor)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "core").Return(models.Team{}, team.ErrNotFound)
+
+		err := handler.TeamHistoryGet(c, generated.GetTeamHistoryParams{TeamName: "core"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockteamRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().FindTeamByID(gomock.Any(), "core").Return(models.Team{TeamName: "core"}, nil)
+		mockRepo.EXPECT().GetTeamEvents(gomock.Any(), "core").Return(nil, errors.New("db is down"))
+
+		err := handler.TeamHistoryGet(c, generated.GetTeamHistoryParams{TeamName: "core"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
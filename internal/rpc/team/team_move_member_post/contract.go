@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_move_member_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/usecase/move_team_member"
+)
+
+type moveTeamMemberService interface {
+	MoveTeamMember(ctx context.Context, userID, teamName, actor string) (move_team_member.Result, error)
+}
@@ -0,0 +1,60 @@
+package team_move_member_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	moveTeamMemberService moveTeamMemberService
+}
+
+func New(moveTeamMemberService moveTeamMemberService) *Handler {
+	return &Handler{
+		moveTeamMemberService: moveTeamMemberService,
+	}
+}
+
+func (h *Handler) TeamMoveMemberPost(ctx echo.Context) error {
+	var input generated.PostTeamMoveMemberJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	result, err := h.moveTeamMemberService.MoveTeamMember(ctx.Request().Context(), input.UserId, input.TeamName, principal.Subject)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	reassignments := make([]generated.Reassignment, 0, len(result.Reassignments))
+	for _, r := range result.Reassignments {
+		reassignment := generated.Reassignment{
+			PullRequestId: r.PRID,
+			OldUserId:     input.UserId,
+			NewUserId:     r.NewReviewerID,
+		}
+		if r.SourceTeam != "" {
+			reassignment.SourceTeam = &r.SourceTeam
+		}
+		reassignments = append(reassignments, reassignment)
+	}
+
+	kept := result.KeptPRIDs
+	if kept == nil {
+		kept = []string{}
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user":                  converter.ToUser(result.User),
+		"reassignments":         reassignments,
+		"kept_pull_request_ids": kept,
+	})
+}
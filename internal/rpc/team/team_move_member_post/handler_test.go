@@ -0,0 +1,137 @@
+package team_move_member_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_move_member_post/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/move_team_member"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validMoveJSON = `{"user_id":"u2","team_name":"platform"}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/team/moveMember", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_TeamMoveMemberPost(t *testing.T) {
+	t.Run("successful move", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockmoveTeamMemberService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validMoveJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "root", Role: auth.RoleAdmin})))
+
+		mockService.EXPECT().
+			MoveTeamMember(gomock.Any(), "u2", "platform", "root").
+			Return(move_team_member.Result{
+				User: models.User{ID: "u2", Username: "user2", IsActive: true, TeamName: "platform"},
+				Reassignments: []move_team_member.Reassignment{
+					{PRID: "pr-1", NewReviewerID: "u3"},
+				},
+				KeptPRIDs: []string{"pr-2"},
+			}, nil)
+
+		err := handler.TeamMoveMemberPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			User               generated.User           `json:"user"`
+			Reassignments      []generated.Reassignment `json:"reassignments"`
+			KeptPullRequestIds []string                 `json:"kept_pull_request_ids"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "platform", response.User.TeamName)
+		assert.Len(t, response.Reassignments, 1)
+		assert.Equal(t, "u2", response.Reassignments[0].OldUserId)
+		assert.Equal(t, "u3", response.Reassignments[0].NewUserId)
+		assert.Nil(t, response.Reassignments[0].SourceTeam)
+		assert.Equal(t, []string{"pr-2"}, response.KeptPullRequestIds)
+	})
+
+	t.Run("nothing to reassign", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockmoveTeamMemberService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validMoveJSON)
+
+		mockService.EXPECT().
+			MoveTeamMember(gomock.Any(), "u2", "platform", "").
+			Return(move_team_member.Result{
+				User: models.User{ID: "u2", Username: "user2", IsActive: true, TeamName: "platform"},
+			}, nil)
+
+		err := handler.TeamMoveMemberPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"reassignments":[]`)
+		assert.Contains(t, rec.Body.String(), `"kept_pull_request_ids":[]`)
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockmoveTeamMemberService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "invalid json")
+
+		err := handler.TeamMoveMemberPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("target team archived", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockmoveTeamMemberService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validMoveJSON)
+
+		mockService.EXPECT().
+			MoveTeamMember(gomock.Any(), "u2", "platform", "").
+			Return(move_team_member.Result{}, rpc_errors.NewTeamArchived("cannot move users into an archived team"))
+
+		err := handler.TeamMoveMemberPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.TEAMARCHIVED, response.Error.Code)
+	})
+}
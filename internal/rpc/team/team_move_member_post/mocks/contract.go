@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	move_team_member "github.com/loloneme/potential-waffle/internal/usecase/move_team_member"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockmoveTeamMemberService is a mock of moveTeamMemberService interface.
+type MockmoveTeamMemberService struct {
+	ctrl     *gomock.Controller
+	recorder *MockmoveTeamMemberServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockmoveTeamMemberServiceMockRecorder is the mock recorder for MockmoveTeamMemberService.
+type MockmoveTeamMemberServiceMockRecorder struct {
+	mock *MockmoveTeamMemberService
+}
+
+// NewMockmoveTeamMemberService creates a new mock instance.
+func NewMockmoveTeamMemberService(ctrl *gomock.Controller) *MockmoveTeamMemberService {
+	mock := &MockmoveTeamMemberService{ctrl: ctrl}
+	mock.recorder = &MockmoveTeamMemberServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmoveTeamMemberService) EXPECT() *MockmoveTeamMemberServiceMockRecorder {
+	return m.recorder
+}
+
+// MoveTeamMember mocks base method.
+func (m *MockmoveTeamMemberService) MoveTeamMember(ctx context.Context, userID, teamName, actor string) (move_team_member.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveTeamMember", ctx, userID, teamName, actor)
+	ret0, _ := ret[0].(move_team_member.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveTeamMember indicates an expected call of MoveTeamMember.
+func (mr *MockmoveTeamMemberServiceMockRecorder) MoveTeamMember(ctx, userID, teamName, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveTeamMember", reflect.TypeOf((*MockmoveTeamMemberService)(nil).MoveTeamMember), ctx, userID, teamName, actor)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_rename_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type renameTeamService interface {
+	RenameTeam(ctx context.Context, teamName, newTeamName, actor string) (models.Team, error)
+}
@@ -0,0 +1,40 @@
+package team_rename_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	renameTeamService renameTeamService
+}
+
+func New(renameTeamService renameTeamService) *Handler {
+	return &Handler{
+		renameTeamService: renameTeamService,
+	}
+}
+
+func (h *Handler) TeamRenamePost(ctx echo.Context) error {
+	var input generated.PostTeamRenameJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	renamed, err := h.renameTeamService.RenameTeam(ctx.Request().Context(), input.TeamName, input.NewTeamName, principal.Subject)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team": converter.ToOpenAPITeam(renamed),
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockrenameTeamService is a mock of renameTeamService interface.
+type MockrenameTeamService struct {
+	ctrl     *gomock.Controller
+	recorder *MockrenameTeamServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockrenameTeamServiceMockRecorder is the mock recorder for MockrenameTeamService.
+type MockrenameTeamServiceMockRecorder struct {
+	mock *MockrenameTeamService
+}
+
+// NewMockrenameTeamService creates a new mock instance.
+func NewMockrenameTeamService(ctrl *gomock.Controller) *MockrenameTeamService {
+	mock := &MockrenameTeamService{ctrl: ctrl}
+	mock.recorder = &MockrenameTeamServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockrenameTeamService) EXPECT() *MockrenameTeamServiceMockRecorder {
+	return m.recorder
+}
+
+// RenameTeam mocks base method.
+func (m *MockrenameTeamService) RenameTeam(ctx context.Context, teamName, newTeamName, actor string) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTeam", ctx, teamName, newTeamName, actor)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RenameTeam indicates an expected call of RenameTeam.
+func (mr *MockrenameTeamServiceMockRecorder) RenameTeam(ctx, teamName, newTeamName, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTeam", reflect.TypeOf((*MockrenameTeamService)(nil).RenameTeam), ctx, teamName, newTeamName, actor)
+}
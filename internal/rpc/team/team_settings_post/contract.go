@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package team_settings_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/usecase/update_team_settings"
+)
+
+type updateTeamSettingsService interface {
+	UpdateTeamSettings(ctx context.Context, teamName string, settings update_team_settings.Settings) (models.Team, error)
+}
@@ -0,0 +1,55 @@
+package team_settings_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/update_team_settings"
+)
+
+type Handler struct {
+	updateTeamSettingsService updateTeamSettingsService
+}
+
+func New(updateTeamSettingsService updateTeamSettingsService) *Handler {
+	return &Handler{
+		updateTeamSettingsService: updateTeamSettingsService,
+	}
+}
+
+func (h *Handler) TeamSettingsPost(ctx echo.Context) error {
+	var input generated.PostTeamSettingsJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	if principal, ok := auth.PrincipalFromContext(ctx.Request().Context()); ok && !principal.CanManageTeam(input.TeamName) {
+		return rpc_errors.RespondForbidden(ctx, "team leads can only manage their own team")
+	}
+
+	var settings update_team_settings.Settings
+	if input.Settings.AssignmentStrategy != nil {
+		strategy := string(*input.Settings.AssignmentStrategy)
+		settings.AssignmentStrategy = &strategy
+	}
+	settings.RequiredReviewers = input.Settings.RequiredReviewers
+	settings.MaxReviewers = input.Settings.MaxReviewers
+	settings.RequiredApprovals = input.Settings.RequiredApprovals
+	settings.MaxOpenReviews = input.Settings.MaxOpenReviews
+	settings.ReviewSLAHours = input.Settings.ReviewSlaHours
+	settings.FallbackTeams = input.Settings.FallbackTeams
+
+	updatedTeam, err := h.updateTeamSettingsService.UpdateTeamSettings(ctx.Request().Context(), input.TeamName, settings)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"team": converter.ToOpenAPITeam(updatedTeam),
+	})
+}
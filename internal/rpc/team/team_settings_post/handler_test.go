@@ -0,0 +1,149 @@
+package team_settings_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/team/team_settings_post/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/update_team_settings"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validSettingsJSON = `{"team_name":"team-1","settings":{"assignment_strategy":"round_robin","required_reviewers":1,"max_reviewers":3,"max_open_reviews":8,"review_sla_hours":24,"fallback_teams":["team-2"]}}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/team/settings", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_TeamSettingsPost(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockupdateTeamSettingsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSettingsJSON)
+
+		expectedTeam := models.Team{
+			TeamName:           "team-1",
+			AssignmentStrategy: "round_robin",
+			RequiredReviewers:  1,
+			MaxReviewers:       3,
+			MaxOpenReviews:     8,
+			ReviewSLAHours:     24,
+			FallbackTeams:      []string{"team-2"},
+			Members: []models.User{
+				{ID: "u1", Username: "user1", IsActive: true, TeamName: "team-1"},
+			},
+		}
+
+		mockService.EXPECT().
+			UpdateTeamSettings(gomock.Any(), "team-1", update_team_settings.Settings{
+				AssignmentStrategy: ptr.To("round_robin"),
+				RequiredReviewers:  ptr.To(1),
+				MaxReviewers:       ptr.To(3),
+				MaxOpenReviews:     ptr.To(8),
+				ReviewSLAHours:     ptr.To(24),
+				FallbackTeams:      ptr.To([]string{"team-2"}),
+			}).
+			Return(expectedTeam, nil)
+
+		err := handler.TeamSettingsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Team generated.Team `json:"team"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "team-1", response.Team.TeamName)
+		assert.Len(t, response.Team.Members, 1)
+		assert.NotNil(t, response.Team.Settings)
+		assert.Equal(t, generated.RoundRobin, *response.Team.Settings.AssignmentStrategy)
+		assert.Equal(t, 1, *response.Team.Settings.RequiredReviewers)
+		assert.Equal(t, 3, *response.Team.Settings.MaxReviewers)
+		assert.Equal(t, 8, *response.Team.Settings.MaxOpenReviews)
+		assert.Equal(t, 24, *response.Team.Settings.ReviewSlaHours)
+		assert.Equal(t, []string{"team-2"}, *response.Team.Settings.FallbackTeams)
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockupdateTeamSettingsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, "invalid json")
+
+		err := handler.TeamSettingsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockupdateTeamSettingsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSettingsJSON)
+
+		mockService.EXPECT().
+			UpdateTeamSettings(gomock.Any(), "team-1", gomock.Any()).
+			Return(models.Team{}, rpc_errors.NewNotFound("team not found"))
+
+		err := handler.TeamSettingsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.NOTFOUND, response.Error.Code)
+	})
+	t.Run("forbidden - team lead of another team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockupdateTeamSettingsService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSettingsJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "team-2"})))
+
+		err := handler.TeamSettingsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.FORBIDDEN, response.Error.Code)
+	})
+}
@@ -0,0 +1,58 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	update_team_settings "github.com/loloneme/potential-waffle/internal/usecase/update_team_settings"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockupdateTeamSettingsService is a mock of updateTeamSettingsService interface.
+type MockupdateTeamSettingsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockupdateTeamSettingsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockupdateTeamSettingsServiceMockRecorder is the mock recorder for MockupdateTeamSettingsService.
+type MockupdateTeamSettingsServiceMockRecorder struct {
+	mock *MockupdateTeamSettingsService
+}
+
+// NewMockupdateTeamSettingsService creates a new mock instance.
+func NewMockupdateTeamSettingsService(ctrl *gomock.Controller) *MockupdateTeamSettingsService {
+	mock := &MockupdateTeamSettingsService{ctrl: ctrl}
+	mock.recorder = &MockupdateTeamSettingsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockupdateTeamSettingsService) EXPECT() *MockupdateTeamSettingsServiceMockRecorder {
+	return m.recorder
+}
+
+// UpdateTeamSettings mocks base method.
+func (m *MockupdateTeamSettingsService) UpdateTeamSettings(ctx context.Context, teamName string, settings update_team_settings.Settings) (models.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamSettings", ctx, teamName, settings)
+	ret0, _ := ret[0].(models.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTeamSettings indicates an expected call of UpdateTeamSettings.
+func (mr *MockupdateTeamSettingsServiceMockRecorder) UpdateTeamSettings(ctx, teamName, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamSettings", reflect.TypeOf((*MockupdateTeamSettingsService)(nil).UpdateTeamSettings), ctx, teamName, settings)
+}
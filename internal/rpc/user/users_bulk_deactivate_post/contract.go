@@ -9,5 +9,5 @@ import (
 )
 
 type bulkDeactivateService interface {
-	BulkDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string) (bulk_deactivate_team.BulkDeactivateResult, error)
+	BulkDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string, opts bulk_deactivate_team.Options, actor string) (bulk_deactivate_team.BulkDeactivateResult, error)
 }
@@ -5,7 +5,9 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
 )
 
 type Handler struct {
@@ -25,22 +27,51 @@ func (h *Handler) UsersBulkDeactivatePost(ctx echo.Context) error {
 		return rpc_errors.RespondBadRequest(ctx, "")
 	}
 
-	result, err := h.bulkDeactivateService.BulkDeactivateTeamUsers(ctx.Request().Context(), input.TeamName, input.UserIds)
+	opts := bulk_deactivate_team.Options{
+		DryRun:               input.DryRun != nil && *input.DryRun,
+		FallbackToOtherTeams: input.FallbackToOtherTeams != nil && *input.FallbackToOtherTeams,
+		RemoveUnresolved:     input.RemoveUnresolved != nil && *input.RemoveUnresolved,
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	result, err := h.bulkDeactivateService.BulkDeactivateTeamUsers(ctx.Request().Context(), input.TeamName, input.UserIds, opts, principal.Subject)
 	if err != nil {
 		return rpc_errors.RespondFromError(ctx, err)
 	}
 
 	reassignments := make([]generated.Reassignment, 0, len(result.Reassignments))
 	for _, r := range result.Reassignments {
-		reassignments = append(reassignments, generated.Reassignment{
+		reassignment := generated.Reassignment{
 			PullRequestId: r.PRID,
 			OldUserId:     r.OldReviewerID,
 			NewUserId:     r.NewReviewerID,
+		}
+		if r.SourceTeam != "" {
+			reassignment.SourceTeam = &r.SourceTeam
+		}
+		reassignments = append(reassignments, reassignment)
+	}
+
+	unresolved := make([]generated.UnresolvedSlot, 0, len(result.Unresolved))
+	for _, u := range result.Unresolved {
+		unresolved = append(unresolved, generated.UnresolvedSlot{
+			PullRequestId: u.PRID,
+			UserId:        u.ReviewerID,
+			Reason:        generated.UnresolvedSlotReason(u.Reason),
+			Removed:       u.Removed,
 		})
 	}
 
+	deactivatedUserIDs := result.DeactivatedUserIDs
+	if deactivatedUserIDs == nil {
+		deactivatedUserIDs = []string{}
+	}
+
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"deactivated_user_ids": result.DeactivatedUserIDs,
+		"deactivated_user_ids": deactivatedUserIDs,
 		"reassignments":        reassignments,
+		"unresolved":           unresolved,
+		"dry_run":              opts.DryRun,
 	})
 }
@@ -0,0 +1,146 @@
+package users_bulk_deactivate_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_bulk_deactivate_post/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/users/bulkDeactivate", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+type bulkDeactivateResponse struct {
+	DeactivatedUserIDs []string                   `json:"deactivated_user_ids"`
+	Reassignments      []generated.Reassignment   `json:"reassignments"`
+	Unresolved         []generated.UnresolvedSlot `json:"unresolved"`
+	DryRun             bool                       `json:"dry_run"`
+}
+
+func TestHandler_UsersBulkDeactivatePost(t *testing.T) {
+	t.Run("dry run returns the plan", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockbulkDeactivateService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"team_name":"backend","user_ids":["u2","u3"],"dry_run":true}`)
+
+		mockService.EXPECT().
+			BulkDeactivateTeamUsers(gomock.Any(), "backend", []string{"u2", "u3"}, bulk_deactivate_team.Options{DryRun: true}, "").
+			Return(bulk_deactivate_team.BulkDeactivateResult{
+				DeactivatedUserIDs: []string{"u2", "u3"},
+				Reassignments: []bulk_deactivate_team.ReassignmentResult{
+					{PRID: "pr-1", OldReviewerID: "u2", NewReviewerID: "u4", SourceTeam: "backend"},
+				},
+				Unresolved: []bulk_deactivate_team.UnresolvedSlot{
+					{PRID: "pr-2", ReviewerID: "u3", Reason: bulk_deactivate_team.UnresolvedAllCandidatesOnPR},
+				},
+			}, nil)
+
+		err := handler.UsersBulkDeactivatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response bulkDeactivateResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.True(t, response.DryRun)
+		assert.Equal(t, []string{"u2", "u3"}, response.DeactivatedUserIDs)
+		assert.Len(t, response.Reassignments, 1)
+		assert.Equal(t, "u4", response.Reassignments[0].NewUserId)
+		assert.Equal(t, []generated.UnresolvedSlot{
+			{PullRequestId: "pr-2", UserId: "u3", Reason: generated.ALLCANDIDATESONPR},
+		}, response.Unresolved)
+	})
+
+	t.Run("options are passed to the service", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockbulkDeactivateService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"team_name":"backend","user_ids":["u2"],"fallback_to_other_teams":true,"remove_unresolved":true}`)
+
+		mockService.EXPECT().
+			BulkDeactivateTeamUsers(gomock.Any(), "backend", []string{"u2"}, bulk_deactivate_team.Options{
+				FallbackToOtherTeams: true,
+				RemoveUnresolved:     true,
+			}, "").
+			Return(bulk_deactivate_team.BulkDeactivateResult{
+				DeactivatedUserIDs: []string{"u2"},
+				Unresolved: []bulk_deactivate_team.UnresolvedSlot{
+					{PRID: "pr-1", ReviewerID: "u2", Reason: bulk_deactivate_team.UnresolvedNoCandidates, Removed: true},
+				},
+			}, nil)
+
+		err := handler.UsersBulkDeactivatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response bulkDeactivateResponse
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, []generated.UnresolvedSlot{
+			{PullRequestId: "pr-1", UserId: "u2", Reason: generated.NOACTIVECANDIDATES, Removed: true},
+		}, response.Unresolved)
+	})
+
+	t.Run("nothing to deactivate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockbulkDeactivateService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"team_name":"backend","user_ids":[]}`)
+
+		mockService.EXPECT().
+			BulkDeactivateTeamUsers(gomock.Any(), "backend", []string{}, bulk_deactivate_team.Options{}, "").
+			Return(bulk_deactivate_team.BulkDeactivateResult{}, nil)
+
+		err := handler.UsersBulkDeactivatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"deactivated_user_ids":[],"reassignments":[],"unresolved":[],"dry_run":false}`, rec.Body.String())
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockbulkDeactivateService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"team_name":"ghost","user_ids":["u1"]}`)
+
+		mockService.EXPECT().
+			BulkDeactivateTeamUsers(gomock.Any(), "ghost", []string{"u1"}, bulk_deactivate_team.Options{}, "").
+			Return(bulk_deactivate_team.BulkDeactivateResult{}, rpc_errors.NewNotFound("team not found"))
+
+		err := handler.UsersBulkDeactivatePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
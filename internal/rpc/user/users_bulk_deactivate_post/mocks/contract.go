@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	bulk_deactivate_team "github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockbulkDeactivateService is a mock of bulkDeactivateService interface.
+type MockbulkDeactivateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockbulkDeactivateServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockbulkDeactivateServiceMockRecorder is the mock recorder for MockbulkDeactivateService.
+type MockbulkDeactivateServiceMockRecorder struct {
+	mock *MockbulkDeactivateService
+}
+
+// NewMockbulkDeactivateService creates a new mock instance.
+func NewMockbulkDeactivateService(ctrl *gomock.Controller) *MockbulkDeactivateService {
+	mock := &MockbulkDeactivateService{ctrl: ctrl}
+	mock.recorder = &MockbulkDeactivateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockbulkDeactivateService) EXPECT() *MockbulkDeactivateServiceMockRecorder {
+	return m.recorder
+}
+
+// BulkDeactivateTeamUsers mocks base method.
+func (m *MockbulkDeactivateService) BulkDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string, opts bulk_deactivate_team.Options, actor string) (bulk_deactivate_team.BulkDeactivateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkDeactivateTeamUsers", ctx, teamName, userIDs, opts, actor)
+	ret0, _ := ret[0].(bulk_deactivate_team.BulkDeactivateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkDeactivateTeamUsers indicates an expected call of BulkDeactivateTeamUsers.
+func (mr *MockbulkDeactivateServiceMockRecorder) BulkDeactivateTeamUsers(ctx, teamName, userIDs, opts, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkDeactivateTeamUsers", reflect.TypeOf((*MockbulkDeactivateService)(nil).BulkDeactivateTeamUsers), ctx, teamName, userIDs, opts, actor)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_history_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type prRepo interface {
+	GetAssignmentEventsByUser(ctx context.Context, userID string) ([]models.AssignmentEvent, error)
+}
@@ -0,0 +1,32 @@
+package users_history_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	prRepo prRepo
+}
+
+func New(prRepo prRepo) *Handler {
+	return &Handler{
+		prRepo: prRepo,
+	}
+}
+
+func (h *Handler) UsersHistoryGet(ctx echo.Context, params generated.GetUsersHistoryParams) error {
+	events, err := h.prRepo.GetAssignmentEventsByUser(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user_id": params.UserId,
+		"events":  converter.ToOpenAPIAssignmentEvents(events),
+	})
+}
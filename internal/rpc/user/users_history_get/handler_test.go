@@ -0,0 +1,81 @@
+package users_history_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_history_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_UsersHistoryGet(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockprRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/users/history?user_id=u2", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		events := []models.AssignmentEvent{
+			{
+				ID:            1,
+				PullRequestID: "pr-1001",
+				Type:          models.AssignmentEventDeactivationReassign,
+				OldReviewerID: "u2",
+				NewReviewerID: "u3",
+				Reason:        "reviewer deactivated",
+				CreatedAt:     time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC),
+			},
+		}
+
+		mockRepo.EXPECT().GetAssignmentEventsByUser(gomock.Any(), "u2").Return(events, nil)
+
+		err := handler.UsersHistoryGet(c, generated.GetUsersHistoryParams{UserId: "u2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			UserID string                      `json:"user_id"`
+			Events []generated.AssignmentEvent `json:"events"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, "u2", response.UserID)
+		assert.Len(t, response.Events, 1)
+		assert.Equal(t, generated.DEACTIVATIONREASSIGN, response.Events[0].EventType)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockprRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/users/history?user_id=u2", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().GetAssignmentEventsByUser(gomock.Any(), "u2").Return(nil, errors.New("db is down"))
+
+		err := handler.UsersHistoryGet(c, generated.GetUsersHistoryParams{UserId: "u2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockprRepo is a mock of prRepo interface.
+type MockprRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockprRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockprRepoMockRecorder is the mock recorder for MockprRepo.
+type MockprRepoMockRecorder struct {
+	mock *MockprRepo
+}
+
+// NewMockprRepo creates a new mock instance.
+func NewMockprRepo(ctrl *gomock.Controller) *MockprRepo {
+	mock := &MockprRepo{ctrl: ctrl}
+	mock.recorder = &MockprRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockprRepo) EXPECT() *MockprRepoMockRecorder {
+	return m.recorder
+}
+
+// GetAssignmentEventsByUser mocks base method.
+func (m *MockprRepo) GetAssignmentEventsByUser(ctx context.Context, userID string) ([]models.AssignmentEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignmentEventsByUser", ctx, userID)
+	ret0, _ := ret[0].([]models.AssignmentEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignmentEventsByUser indicates an expected call of GetAssignmentEventsByUser.
+func (mr *MockprRepoMockRecorder) GetAssignmentEventsByUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignmentEventsByUser", reflect.TypeOf((*MockprRepo)(nil).GetAssignmentEventsByUser), ctx, userID)
+}
@@ -5,10 +5,13 @@ package users_set_is_active_post
 import (
 	"context"
 
-	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
-	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_is_active"
 )
 
+type setIsActiveService interface {
+	SetIsActive(ctx context.Context, userID string, isActive, dryRun bool, actor string) (set_is_active.Result, error)
+}
+
 type userRepo interface {
-	UserUpdate(ctx context.Context, spec user.UpdateSpecification) (models.User, error)
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
 }
@@ -5,18 +5,21 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
-	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	"github.com/loloneme/potential-waffle/internal/rpc/access"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 )
 
 type Handler struct {
-	userRepo userRepo
+	setIsActiveService setIsActiveService
+	userRepo           userRepo
 }
 
-func New(userRepo userRepo) *Handler {
+func New(setIsActiveService setIsActiveService, userRepo userRepo) *Handler {
 	return &Handler{
-		userRepo: userRepo,
+		setIsActiveService: setIsActiveService,
+		userRepo:           userRepo,
 	}
 }
 
@@ -27,13 +30,46 @@ func (h *Handler) UsersSetIsActivePost(ctx echo.Context) error {
 		return rpc_errors.RespondBadRequest(ctx, "")
 	}
 
-	spec := user_spec.NewSetIsActiveSpecification(input.UserId, input.IsActive)
-	updatedUser, err := h.userRepo.UserUpdate(ctx.Request().Context(), spec)
+	if err := access.CheckTeamMember(ctx.Request().Context(), h.userRepo, input.UserId); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	principal, _ := auth.PrincipalFromContext(ctx.Request().Context())
+
+	dryRun := input.DryRun != nil && *input.DryRun
+
+	result, err := h.setIsActiveService.SetIsActive(ctx.Request().Context(), input.UserId, input.IsActive, dryRun, principal.Subject)
 	if err != nil {
 		return rpc_errors.RespondFromError(ctx, err)
 	}
 
+	reassignments := make([]generated.Reassignment, 0, len(result.Reassignments))
+	for _, r := range result.Reassignments {
+		reassignment := generated.Reassignment{
+			PullRequestId: r.PRID,
+			OldUserId:     r.OldReviewerID,
+			NewUserId:     r.NewReviewerID,
+		}
+		if r.SourceTeam != "" {
+			reassignment.SourceTeam = &r.SourceTeam
+		}
+		reassignments = append(reassignments, reassignment)
+	}
+
+	unresolved := make([]generated.UnresolvedSlot, 0, len(result.Unresolved))
+	for _, u := range result.Unresolved {
+		unresolved = append(unresolved, generated.UnresolvedSlot{
+			PullRequestId: u.PRID,
+			UserId:        u.ReviewerID,
+			Reason:        generated.UnresolvedSlotReason(u.Reason),
+			Removed:       u.Removed,
+		})
+	}
+
 	return ctx.JSON(http.StatusOK, map[string]interface{}{
-		"user": converter.ToUser(updatedUser),
+		"user":          converter.ToUser(result.User),
+		"reassignments": reassignments,
+		"unresolved":    unresolved,
+		"dry_run":       dryRun,
 	})
 }
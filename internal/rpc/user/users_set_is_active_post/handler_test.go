@@ -9,9 +9,12 @@ import (
 
 	"github.com/labstack/echo/v4"
 	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_is_active_post/mocks"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_is_active"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -30,8 +33,8 @@ func TestHandler_UsersSetIsActivePost(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		mockRepo := mocks.NewMockuserRepo(ctrl)
-		handler := New(mockRepo)
+		mockService := mocks.NewMocksetIsActiveService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
 
 		e := echo.New()
 		c, rec := makeTestRequest(e, validSetIsActiveJSON)
@@ -43,9 +46,14 @@ func TestHandler_UsersSetIsActivePost(t *testing.T) {
 			TeamName: "team-1",
 		}
 
-		mockRepo.EXPECT().
-			UserUpdate(gomock.Any(), gomock.Any()).
-			Return(expectedUser, nil)
+		mockService.EXPECT().
+			SetIsActive(gomock.Any(), "u1", false, false, "").
+			Return(set_is_active.Result{
+				User: expectedUser,
+				Reassignments: []bulk_deactivate_team.ReassignmentResult{
+					{PRID: "pr-1", OldReviewerID: "u1", NewReviewerID: "u2"},
+				},
+			}, nil)
 
 		err := handler.UsersSetIsActivePost(c)
 
@@ -56,18 +64,84 @@ func TestHandler_UsersSetIsActivePost(t *testing.T) {
 		err = json.Unmarshal(rec.Body.Bytes(), &response)
 		assert.NoError(t, err)
 		assert.Contains(t, response, "user")
+		assert.Equal(t, false, response["dry_run"])
 
 		userData := response["user"].(map[string]interface{})
 		assert.Equal(t, "u1", userData["user_id"])
 		assert.Equal(t, false, userData["is_active"])
+
+		reassignments := response["reassignments"].([]interface{})
+		assert.Len(t, reassignments, 1)
+		assert.Equal(t, "u2", reassignments[0].(map[string]interface{})["new_user_id"])
+	})
+
+	t.Run("dry run", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetIsActiveService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"user_id":"u1","is_active":false,"dry_run":true}`)
+
+		mockService.EXPECT().
+			SetIsActive(gomock.Any(), "u1", false, true, "").
+			Return(set_is_active.Result{User: models.User{ID: "u1"}}, nil)
+
+		err := handler.UsersSetIsActivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response map[string]interface{}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, true, response["dry_run"])
+		assert.Empty(t, response["reassignments"])
+	})
+
+	t.Run("deactivation nobody can take over", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetIsActiveService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetIsActiveJSON)
+
+		mockService.EXPECT().
+			SetIsActive(gomock.Any(), "u1", false, false, "").
+			Return(set_is_active.Result{
+				User: models.User{ID: "u1"},
+				Unresolved: []bulk_deactivate_team.UnresolvedSlot{
+					{PRID: "pr-1", ReviewerID: "u1", Reason: bulk_deactivate_team.UnresolvedNoCandidates},
+				},
+			}, nil)
+
+		err := handler.UsersSetIsActivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Reassignments []generated.Reassignment   `json:"reassignments"`
+			Unresolved    []generated.UnresolvedSlot `json:"unresolved"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Empty(t, response.Reassignments)
+		assert.Equal(t, []generated.UnresolvedSlot{
+			{PullRequestId: "pr-1", UserId: "u1", Reason: generated.NOACTIVECANDIDATES},
+		}, response.Unresolved)
 	})
 
 	t.Run("bad request - invalid JSON", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		mockRepo := mocks.NewMockuserRepo(ctrl)
-		handler := New(mockRepo)
+		handler := New(mocks.NewMocksetIsActiveService(ctrl), mocks.NewMockuserRepo(ctrl))
 
 		e := echo.New()
 		c, rec := makeTestRequest(e, "invalid json")
@@ -87,15 +161,15 @@ func TestHandler_UsersSetIsActivePost(t *testing.T) {
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
-		mockRepo := mocks.NewMockuserRepo(ctrl)
-		handler := New(mockRepo)
+		mockService := mocks.NewMocksetIsActiveService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
 
 		e := echo.New()
 		c, rec := makeTestRequest(e, validSetIsActiveJSON)
 
-		mockRepo.EXPECT().
-			UserUpdate(gomock.Any(), gomock.Any()).
-			Return(models.User{}, rpc_errors.NewNotFound("user not found"))
+		mockService.EXPECT().
+			SetIsActive(gomock.Any(), "u1", false, false, "").
+			Return(set_is_active.Result{}, rpc_errors.NewNotFound("user not found"))
 
 		err := handler.UsersSetIsActivePost(c)
 
@@ -107,4 +181,52 @@ func TestHandler_UsersSetIsActivePost(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, generated.NOTFOUND, response.Error.Code)
 	})
+	t.Run("team lead deactivates own team member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetIsActiveService(ctrl)
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockService, mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetIsActiveJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "team-1"})))
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u1").Return("team-1", nil)
+		mockService.EXPECT().
+			SetIsActive(gomock.Any(), "u1", false, false, "lead").
+			Return(set_is_active.Result{User: models.User{ID: "u1", TeamName: "team-1"}}, nil)
+
+		err := handler.UsersSetIsActivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("forbidden - team lead of another team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mocks.NewMocksetIsActiveService(ctrl), mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetIsActiveJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "team-2"})))
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u1").Return("team-1", nil)
+
+		err := handler.UsersSetIsActivePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+
+		var response generated.ErrorResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.FORBIDDEN, response.Error.Code)
+	})
 }
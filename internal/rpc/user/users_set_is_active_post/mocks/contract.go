@@ -13,11 +13,49 @@ import (
 	context "context"
 	reflect "reflect"
 
-	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
-	user "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	set_is_active "github.com/loloneme/potential-waffle/internal/usecase/set_is_active"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MocksetIsActiveService is a mock of setIsActiveService interface.
+type MocksetIsActiveService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetIsActiveServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocksetIsActiveServiceMockRecorder is the mock recorder for MocksetIsActiveService.
+type MocksetIsActiveServiceMockRecorder struct {
+	mock *MocksetIsActiveService
+}
+
+// NewMocksetIsActiveService creates a new mock instance.
+func NewMocksetIsActiveService(ctrl *gomock.Controller) *MocksetIsActiveService {
+	mock := &MocksetIsActiveService{ctrl: ctrl}
+	mock.recorder = &MocksetIsActiveServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetIsActiveService) EXPECT() *MocksetIsActiveServiceMockRecorder {
+	return m.recorder
+}
+
+// SetIsActive mocks base method.
+func (m *MocksetIsActiveService) SetIsActive(ctx context.Context, userID string, isActive, dryRun bool, actor string) (set_is_active.Result, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIsActive", ctx, userID, isActive, dryRun, actor)
+	ret0, _ := ret[0].(set_is_active.Result)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIsActive indicates an expected call of SetIsActive.
+func (mr *MocksetIsActiveServiceMockRecorder) SetIsActive(ctx, userID, isActive, dryRun, actor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIsActive", reflect.TypeOf((*MocksetIsActiveService)(nil).SetIsActive), ctx, userID, isActive, dryRun, actor)
+}
+
 // MockuserRepo is a mock of userRepo interface.
 type MockuserRepo struct {
 	ctrl     *gomock.Controller
@@ -42,17 +80,17 @@ func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
 	return m.recorder
 }
 
-// UserUpdate mocks base method.
-func (m *MockuserRepo) UserUpdate(ctx context.Context, spec user.UpdateSpecification) (models.User, error) {
+// GetUserTeamName mocks base method.
+func (m *MockuserRepo) GetUserTeamName(ctx context.Context, userID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UserUpdate", ctx, spec)
-	ret0, _ := ret[0].(models.User)
+	ret := m.ctrl.Call(m, "GetUserTeamName", ctx, userID)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UserUpdate indicates an expected call of UserUpdate.
-func (mr *MockuserRepoMockRecorder) UserUpdate(ctx, spec any) *gomock.Call {
+// GetUserTeamName indicates an expected call of GetUserTeamName.
+func (mr *MockuserRepoMockRecorder) GetUserTeamName(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UserUpdate", reflect.TypeOf((*MockuserRepo)(nil).UserUpdate), ctx, spec)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTeamName", reflect.TypeOf((*MockuserRepo)(nil).GetUserTeamName), ctx, userID)
 }
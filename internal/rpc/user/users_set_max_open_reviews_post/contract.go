@@ -0,0 +1,17 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_set_max_open_reviews_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type setMaxOpenReviewsService interface {
+	SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (models.User, error)
+}
+
+type userRepo interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
@@ -0,0 +1,44 @@
+package users_set_max_open_reviews_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	"github.com/loloneme/potential-waffle/internal/rpc/access"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	setMaxOpenReviewsService setMaxOpenReviewsService
+	userRepo                 userRepo
+}
+
+func New(setMaxOpenReviewsService setMaxOpenReviewsService, userRepo userRepo) *Handler {
+	return &Handler{
+		setMaxOpenReviewsService: setMaxOpenReviewsService,
+		userRepo:                 userRepo,
+	}
+}
+
+func (h *Handler) UsersSetMaxOpenReviewsPost(ctx echo.Context) error {
+	var input generated.PostUsersSetMaxOpenReviewsJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	if err := access.CheckTeamMember(ctx.Request().Context(), h.userRepo, input.UserId); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	updated, err := h.setMaxOpenReviewsService.SetMaxOpenReviews(ctx.Request().Context(), input.UserId, input.MaxOpenReviews)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user": converter.ToUser(updated),
+	})
+}
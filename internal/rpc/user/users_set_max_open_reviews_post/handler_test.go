@@ -0,0 +1,118 @@
+package users_set_max_open_reviews_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_max_open_reviews_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validSetMaxOpenReviewsJSON = `{"user_id":"u2","max_open_reviews":5}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/users/setMaxOpenReviews", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_UsersSetMaxOpenReviewsPost(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetMaxOpenReviewsService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetMaxOpenReviewsJSON)
+
+		mockService.EXPECT().
+			SetMaxOpenReviews(gomock.Any(), "u2", ptr.To(5)).
+			Return(models.User{ID: "u2", Username: "Bob", IsActive: true, TeamName: "backend", MaxOpenReviews: ptr.To(5)}, nil)
+
+		err := handler.UsersSetMaxOpenReviewsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			User generated.User `json:"user"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, ptr.To(5), response.User.MaxOpenReviews)
+	})
+
+	t.Run("clearing the limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetMaxOpenReviewsService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"user_id":"u2","max_open_reviews":null}`)
+
+		mockService.EXPECT().
+			SetMaxOpenReviews(gomock.Any(), "u2", nil).
+			Return(models.User{ID: "u2", Username: "Bob", IsActive: true, TeamName: "backend"}, nil)
+
+		err := handler.UsersSetMaxOpenReviewsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"max_open_reviews":null`)
+	})
+
+	t.Run("negative limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetMaxOpenReviewsService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"user_id":"u2","max_open_reviews":-1}`)
+
+		mockService.EXPECT().
+			SetMaxOpenReviews(gomock.Any(), "u2", ptr.To(-1)).
+			Return(models.User{}, rpc_errors.NewBadRequest("max_open_reviews cannot be negative"))
+
+		err := handler.UsersSetMaxOpenReviewsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("forbidden - team lead of another team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mocks.NewMocksetMaxOpenReviewsService(ctrl), mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetMaxOpenReviewsJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "frontend"})))
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u2").Return("backend", nil)
+
+		err := handler.UsersSetMaxOpenReviewsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
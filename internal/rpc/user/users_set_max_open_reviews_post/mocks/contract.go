@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksetMaxOpenReviewsService is a mock of setMaxOpenReviewsService interface.
+type MocksetMaxOpenReviewsService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetMaxOpenReviewsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocksetMaxOpenReviewsServiceMockRecorder is the mock recorder for MocksetMaxOpenReviewsService.
+type MocksetMaxOpenReviewsServiceMockRecorder struct {
+	mock *MocksetMaxOpenReviewsService
+}
+
+// NewMocksetMaxOpenReviewsService creates a new mock instance.
+func NewMocksetMaxOpenReviewsService(ctrl *gomock.Controller) *MocksetMaxOpenReviewsService {
+	mock := &MocksetMaxOpenReviewsService{ctrl: ctrl}
+	mock.recorder = &MocksetMaxOpenReviewsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetMaxOpenReviewsService) EXPECT() *MocksetMaxOpenReviewsServiceMockRecorder {
+	return m.recorder
+}
+
+// SetMaxOpenReviews mocks base method.
+func (m *MocksetMaxOpenReviewsService) SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (models.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetMaxOpenReviews", ctx, userID, maxOpenReviews)
+	ret0, _ := ret[0].(models.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetMaxOpenReviews indicates an expected call of SetMaxOpenReviews.
+func (mr *MocksetMaxOpenReviewsServiceMockRecorder) SetMaxOpenReviews(ctx, userID, maxOpenReviews any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetMaxOpenReviews", reflect.TypeOf((*MocksetMaxOpenReviewsService)(nil).SetMaxOpenReviews), ctx, userID, maxOpenReviews)
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetUserTeamName mocks base method.
+func (m *MockuserRepo) GetUserTeamName(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTeamName", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTeamName indicates an expected call of GetUserTeamName.
+func (mr *MockuserRepoMockRecorder) GetUserTeamName(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTeamName", reflect.TypeOf((*MockuserRepo)(nil).GetUserTeamName), ctx, userID)
+}
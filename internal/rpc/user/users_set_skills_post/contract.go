@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_set_skills_post
+
+import "context"
+
+type setUserSkillsService interface {
+	SetUserSkills(ctx context.Context, userID string, tags []string) ([]string, error)
+}
+
+type userRepo interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
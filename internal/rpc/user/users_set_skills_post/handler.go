@@ -0,0 +1,44 @@
+package users_set_skills_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	"github.com/loloneme/potential-waffle/internal/rpc/access"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	setUserSkillsService setUserSkillsService
+	userRepo             userRepo
+}
+
+func New(setUserSkillsService setUserSkillsService, userRepo userRepo) *Handler {
+	return &Handler{
+		setUserSkillsService: setUserSkillsService,
+		userRepo:             userRepo,
+	}
+}
+
+func (h *Handler) UsersSetSkillsPost(ctx echo.Context) error {
+	var input generated.PostUsersSetSkillsJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	if err := access.CheckTeamMember(ctx.Request().Context(), h.userRepo, input.UserId); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	skills, err := h.setUserSkillsService.SetUserSkills(ctx.Request().Context(), input.UserId, input.Skills)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"skills": converter.ToOpenAPIUserSkills(input.UserId, skills),
+	})
+}
@@ -0,0 +1,154 @@
+package users_set_skills_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_set_skills_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validSetSkillsJSON = `{"user_id":"u2","skills":["Go","sql"]}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/users/setSkills", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_UsersSetSkillsPost(t *testing.T) {
+	t.Run("successful update", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetUserSkillsService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetSkillsJSON)
+
+		mockService.EXPECT().
+			SetUserSkills(gomock.Any(), "u2", []string{"Go", "sql"}).
+			Return([]string{"go", "sql"}, nil)
+
+		err := handler.UsersSetSkillsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Skills generated.UserSkills `json:"skills"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.UserSkills{UserId: "u2", Skills: []string{"go", "sql"}}, response.Skills)
+	})
+
+	t.Run("invalid tag", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetUserSkillsService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"user_id":"u2","skills":["front end"]}`)
+
+		mockService.EXPECT().
+			SetUserSkills(gomock.Any(), "u2", []string{"front end"}).
+			Return(nil, rpc_errors.NewBadRequest(`skill "front end" must contain only letters, digits and "+#._-"`))
+
+		err := handler.UsersSetSkillsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetUserSkillsService(ctrl)
+		handler := New(mockService, mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetSkillsJSON)
+
+		mockService.EXPECT().
+			SetUserSkills(gomock.Any(), "u2", []string{"Go", "sql"}).
+			Return(nil, rpc_errors.NewNotFound("user not found"))
+
+		err := handler.UsersSetSkillsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("team lead updates own team member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMocksetUserSkillsService(ctrl)
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockService, mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetSkillsJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "backend"})))
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u2").Return("backend", nil)
+		mockService.EXPECT().
+			SetUserSkills(gomock.Any(), "u2", []string{"Go", "sql"}).
+			Return([]string{"go", "sql"}, nil)
+
+		err := handler.UsersSetSkillsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("forbidden - team lead of another team", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mocks.NewMocksetUserSkillsService(ctrl), mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSetSkillsJSON)
+		c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(),
+			auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "frontend"})))
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u2").Return("backend", nil)
+
+		err := handler.UsersSetSkillsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("invalid request body", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler := New(mocks.NewMocksetUserSkillsService(ctrl), mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"user_id":`)
+
+		err := handler.UsersSetSkillsPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
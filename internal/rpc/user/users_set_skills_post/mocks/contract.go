@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocksetUserSkillsService is a mock of setUserSkillsService interface.
+type MocksetUserSkillsService struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetUserSkillsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocksetUserSkillsServiceMockRecorder is the mock recorder for MocksetUserSkillsService.
+type MocksetUserSkillsServiceMockRecorder struct {
+	mock *MocksetUserSkillsService
+}
+
+// NewMocksetUserSkillsService creates a new mock instance.
+func NewMocksetUserSkillsService(ctrl *gomock.Controller) *MocksetUserSkillsService {
+	mock := &MocksetUserSkillsService{ctrl: ctrl}
+	mock.recorder = &MocksetUserSkillsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetUserSkillsService) EXPECT() *MocksetUserSkillsServiceMockRecorder {
+	return m.recorder
+}
+
+// SetUserSkills mocks base method.
+func (m *MocksetUserSkillsService) SetUserSkills(ctx context.Context, userID string, tags []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserSkills", ctx, userID, tags)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserSkills indicates an expected call of SetUserSkills.
+func (mr *MocksetUserSkillsServiceMockRecorder) SetUserSkills(ctx, userID, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserSkills", reflect.TypeOf((*MocksetUserSkillsService)(nil).SetUserSkills), ctx, userID, tags)
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetUserTeamName mocks base method.
+func (m *MockuserRepo) GetUserTeamName(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTeamName", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTeamName indicates an expected call of GetUserTeamName.
+func (mr *MockuserRepoMockRecorder) GetUserTeamName(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTeamName", reflect.TypeOf((*MockuserRepo)(nil).GetUserTeamName), ctx, userID)
+}
@@ -0,0 +1,14 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_skills_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type userRepo interface {
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
+	GetUserSkills(ctx context.Context, userID string) ([]string, error)
+}
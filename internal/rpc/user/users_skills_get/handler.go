@@ -0,0 +1,35 @@
+package users_skills_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	userRepo userRepo
+}
+
+func New(userRepo userRepo) *Handler {
+	return &Handler{
+		userRepo: userRepo,
+	}
+}
+
+func (h *Handler) UsersSkillsGet(ctx echo.Context, params generated.GetUsersSkillsParams) error {
+	if _, err := h.userRepo.GetUserByID(ctx.Request().Context(), params.UserId); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	skills, err := h.userRepo.GetUserSkills(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"skills": converter.ToOpenAPIUserSkills(params.UserId, skills),
+	})
+}
@@ -0,0 +1,108 @@
+package users_skills_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_skills_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodGet, "/users/skills?user_id=u2", nil)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_UsersSkillsGet(t *testing.T) {
+	t.Run("successful get", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().GetUserByID(gomock.Any(), "u2").Return(models.User{ID: "u2"}, nil)
+		mockRepo.EXPECT().GetUserSkills(gomock.Any(), "u2").Return([]string{"go", "sql"}, nil)
+
+		err := handler.UsersSkillsGet(c, generated.GetUsersSkillsParams{UserId: "u2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			Skills generated.UserSkills `json:"skills"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, generated.UserSkills{UserId: "u2", Skills: []string{"go", "sql"}}, response.Skills)
+	})
+
+	t.Run("no skills", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().GetUserByID(gomock.Any(), "u2").Return(models.User{ID: "u2"}, nil)
+		mockRepo.EXPECT().GetUserSkills(gomock.Any(), "u2").Return(nil, nil)
+
+		err := handler.UsersSkillsGet(c, generated.GetUsersSkillsParams{UserId: "u2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"skills":[]`)
+	})
+
+	t.Run("user not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().GetUserByID(gomock.Any(), "u2").Return(models.User{}, user.ErrNotFound)
+
+		err := handler.UsersSkillsGet(c, generated.GetUsersSkillsParams{UserId: "u2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e)
+
+		mockRepo.EXPECT().GetUserByID(gomock.Any(), "u2").Return(models.User{ID: "u2"}, nil)
+		mockRepo.EXPECT().GetUserSkills(gomock.Any(), "u2").Return(nil, errors.New("db is down"))
+
+		err := handler.UsersSkillsGet(c, generated.GetUsersSkillsParams{UserId: "u2"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
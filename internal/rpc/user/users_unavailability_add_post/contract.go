@@ -0,0 +1,17 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_unavailability_add_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type createUnavailabilityService interface {
+	CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error)
+}
+
+type userRepo interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
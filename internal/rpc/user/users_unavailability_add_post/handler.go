@@ -0,0 +1,57 @@
+package users_unavailability_add_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/rpc/access"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	createUnavailabilityService createUnavailabilityService
+	userRepo                    userRepo
+}
+
+func New(createUnavailabilityService createUnavailabilityService, userRepo userRepo) *Handler {
+	return &Handler{
+		createUnavailabilityService: createUnavailabilityService,
+		userRepo:                    userRepo,
+	}
+}
+
+func (h *Handler) UsersUnavailabilityAddPost(ctx echo.Context) error {
+	var input generated.PostUsersUnavailabilityAddJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	if err := access.CheckUser(ctx.Request().Context(), h.userRepo, input.UserId); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	period := models.Unavailability{
+		UserID:   input.UserId,
+		StartsAt: input.StartsAt,
+		EndsAt:   input.EndsAt,
+	}
+	if input.Reason != nil {
+		period.Reason = *input.Reason
+	}
+	if input.ReassignReviews != nil {
+		period.ReassignReviews = *input.ReassignReviews
+	}
+
+	created, err := h.createUnavailabilityService.CreateUnavailability(ctx.Request().Context(), period)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]interface{}{
+		"unavailability": converter.ToOpenAPIUnavailability(created),
+	})
+}
@@ -0,0 +1,134 @@
+package users_unavailability_add_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_add_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validAddJSON = `{"user_id":"u1","starts_at":"2025-11-03T00:00:00Z","ends_at":"2025-11-17T00:00:00+03:00","reason":"vacation","reassign_reviews":true}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/users/unavailability/add", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func withPrincipal(c echo.Context, p auth.Principal) {
+	c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), p)))
+}
+
+func TestHandler_UsersUnavailabilityAddPost(t *testing.T) {
+	startsAt := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+	endsAt := time.Date(2025, 11, 16, 21, 0, 0, 0, time.UTC)
+
+	t.Run("member registers own vacation", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateUnavailabilityService(ctrl)
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockService, mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validAddJSON)
+		withPrincipal(c, auth.Principal{Subject: "u1", Role: auth.RoleMember})
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u1").Return("backend", nil)
+		mockService.EXPECT().
+			CreateUnavailability(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ any, u models.Unavailability) (models.Unavailability, error) {
+				assert.Equal(t, "u1", u.UserID)
+				assert.True(t, u.StartsAt.Equal(startsAt))
+				assert.True(t, u.EndsAt.Equal(endsAt))
+				assert.Equal(t, "vacation", u.Reason)
+				assert.True(t, u.ReassignReviews)
+
+				u.ID = 7
+				u.StartsAt, u.EndsAt = startsAt, endsAt
+				return u, nil
+			})
+
+		err := handler.UsersUnavailabilityAddPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+
+		var response struct {
+			Unavailability generated.Unavailability `json:"unavailability"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, int64(7), response.Unavailability.UnavailabilityId)
+		assert.Equal(t, endsAt, response.Unavailability.EndsAt)
+		assert.Nil(t, response.Unavailability.ReassignedAt)
+	})
+
+	t.Run("member cannot manage another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateUnavailabilityService(ctrl)
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockService, mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validAddJSON)
+		withPrincipal(c, auth.Principal{Subject: "u2", Role: auth.RoleMember})
+
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u1").Return("backend", nil)
+
+		err := handler.UsersUnavailabilityAddPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("invalid period", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateUnavailabilityService(ctrl)
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockService, mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validAddJSON)
+
+		mockService.EXPECT().
+			CreateUnavailability(gomock.Any(), gomock.Any()).
+			Return(models.Unavailability{}, rpc_errors.NewBadRequest("ends_at must be after starts_at"))
+
+		err := handler.UsersUnavailabilityAddPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		handler := New(mocks.NewMockcreateUnavailabilityService(ctrl), mocks.NewMockuserRepo(ctrl))
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"user_id":`)
+
+		err := handler.UsersUnavailabilityAddPost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcreateUnavailabilityService is a mock of createUnavailabilityService interface.
+type MockcreateUnavailabilityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcreateUnavailabilityServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockcreateUnavailabilityServiceMockRecorder is the mock recorder for MockcreateUnavailabilityService.
+type MockcreateUnavailabilityServiceMockRecorder struct {
+	mock *MockcreateUnavailabilityService
+}
+
+// NewMockcreateUnavailabilityService creates a new mock instance.
+func NewMockcreateUnavailabilityService(ctrl *gomock.Controller) *MockcreateUnavailabilityService {
+	mock := &MockcreateUnavailabilityService{ctrl: ctrl}
+	mock.recorder = &MockcreateUnavailabilityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcreateUnavailabilityService) EXPECT() *MockcreateUnavailabilityServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateUnavailability mocks base method.
+func (m *MockcreateUnavailabilityService) CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUnavailability", ctx, u)
+	ret0, _ := ret[0].(models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUnavailability indicates an expected call of CreateUnavailability.
+func (mr *MockcreateUnavailabilityServiceMockRecorder) CreateUnavailability(ctx, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUnavailability", reflect.TypeOf((*MockcreateUnavailabilityService)(nil).CreateUnavailability), ctx, u)
+}
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// GetUserTeamName mocks base method.
+func (m *MockuserRepo) GetUserTeamName(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTeamName", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTeamName indicates an expected call of GetUserTeamName.
+func (mr *MockuserRepoMockRecorder) GetUserTeamName(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTeamName", reflect.TypeOf((*MockuserRepo)(nil).GetUserTeamName), ctx, userID)
+}
@@ -0,0 +1,15 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_unavailability_delete_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type userRepo interface {
+	GetUnavailability(ctx context.Context, unavailabilityID int64) (models.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, unavailabilityID int64) error
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
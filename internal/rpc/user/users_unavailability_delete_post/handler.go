@@ -0,0 +1,51 @@
+package users_unavailability_delete_post
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/rpc/access"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	userRepo userRepo
+}
+
+func New(userRepo userRepo) *Handler {
+	return &Handler{
+		userRepo: userRepo,
+	}
+}
+
+func (h *Handler) UsersUnavailabilityDeletePost(ctx echo.Context) error {
+	var input generated.PostUsersUnavailabilityDeleteJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	period, err := h.userRepo.GetUnavailability(ctx.Request().Context(), input.UnavailabilityId)
+	if err != nil {
+		if errors.Is(err, user.ErrUnavailabilityNotFound) {
+			return rpc_errors.RespondNotFound(ctx, "unavailability not found")
+		}
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	if err := access.CheckUser(ctx.Request().Context(), h.userRepo, period.UserID); err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	if err := h.userRepo.DeleteUnavailability(ctx.Request().Context(), input.UnavailabilityId); err != nil {
+		if errors.Is(err, user.ErrUnavailabilityNotFound) {
+			return rpc_errors.RespondNotFound(ctx, "unavailability not found")
+		}
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,107 @@
+package users_unavailability_delete_post
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/auth"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_delete_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/users/unavailability/delete", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func withPrincipal(c echo.Context, p auth.Principal) {
+	c.SetRequest(c.Request().WithContext(auth.WithPrincipal(c.Request().Context(), p)))
+}
+
+func TestHandler_UsersUnavailabilityDeletePost(t *testing.T) {
+	t.Run("successful delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"unavailability_id":3}`)
+
+		mockRepo.EXPECT().GetUnavailability(gomock.Any(), int64(3)).Return(models.Unavailability{ID: 3, UserID: "u1"}, nil)
+		mockRepo.EXPECT().DeleteUnavailability(gomock.Any(), int64(3)).Return(nil)
+
+		err := handler.UsersUnavailabilityDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("team lead deletes period of own member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"unavailability_id":3}`)
+		withPrincipal(c, auth.Principal{Subject: "lead", Role: auth.RoleTeamLead, TeamName: "backend"})
+
+		mockRepo.EXPECT().GetUnavailability(gomock.Any(), int64(3)).Return(models.Unavailability{ID: 3, UserID: "u1"}, nil)
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u1").Return("backend", nil)
+		mockRepo.EXPECT().DeleteUnavailability(gomock.Any(), int64(3)).Return(nil)
+
+		err := handler.UsersUnavailabilityDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("member cannot delete period of another user", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"unavailability_id":3}`)
+		withPrincipal(c, auth.Principal{Subject: "u2", Role: auth.RoleMember})
+
+		mockRepo.EXPECT().GetUnavailability(gomock.Any(), int64(3)).Return(models.Unavailability{ID: 3, UserID: "u1"}, nil)
+		mockRepo.EXPECT().GetUserTeamName(gomock.Any(), "u1").Return("backend", nil)
+
+		err := handler.UsersUnavailabilityDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("period not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"unavailability_id":3}`)
+
+		mockRepo.EXPECT().GetUnavailability(gomock.Any(), int64(3)).Return(models.Unavailability{}, user.ErrUnavailabilityNotFound)
+
+		err := handler.UsersUnavailabilityDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockuserRepo is a mock of userRepo interface.
+type MockuserRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockuserRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockuserRepoMockRecorder is the mock recorder for MockuserRepo.
+type MockuserRepoMockRecorder struct {
+	mock *MockuserRepo
+}
+
+// NewMockuserRepo creates a new mock instance.
+func NewMockuserRepo(ctrl *gomock.Controller) *MockuserRepo {
+	mock := &MockuserRepo{ctrl: ctrl}
+	mock.recorder = &MockuserRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockuserRepo) EXPECT() *MockuserRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteUnavailability mocks base method.
+func (m *MockuserRepo) DeleteUnavailability(ctx context.Context, unavailabilityID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnavailability", ctx, unavailabilityID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnavailability indicates an expected call of DeleteUnavailability.
+func (mr *MockuserRepoMockRecorder) DeleteUnavailability(ctx, unavailabilityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnavailability", reflect.TypeOf((*MockuserRepo)(nil).DeleteUnavailability), ctx, unavailabilityID)
+}
+
+// GetUnavailability mocks base method.
+func (m *MockuserRepo) GetUnavailability(ctx context.Context, unavailabilityID int64) (models.Unavailability, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnavailability", ctx, unavailabilityID)
+	ret0, _ := ret[0].(models.Unavailability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnavailability indicates an expected call of GetUnavailability.
+func (mr *MockuserRepoMockRecorder) GetUnavailability(ctx, unavailabilityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnavailability", reflect.TypeOf((*MockuserRepo)(nil).GetUnavailability), ctx, unavailabilityID)
+}
+
+// GetUserTeamName mocks base method.
+func (m *MockuserRepo) GetUserTeamName(ctx context.Context, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserTeamName", ctx, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserTeamName indicates an expected call of GetUserTeamName.
+func (mr *MockuserRepoMockRecorder) GetUserTeamName(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserTeamName", reflect.TypeOf((*MockuserRepo)(nil).GetUserTeamName), ctx, userID)
+}
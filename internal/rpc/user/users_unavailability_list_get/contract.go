@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package users_unavailability_list_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type userRepo interface {
+	ListUnavailability(ctx context.Context, userID string) ([]models.Unavailability, error)
+}
@@ -0,0 +1,32 @@
+package users_unavailability_list_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	userRepo userRepo
+}
+
+func New(userRepo userRepo) *Handler {
+	return &Handler{
+		userRepo: userRepo,
+	}
+}
+
+func (h *Handler) UsersUnavailabilityListGet(ctx echo.Context, params generated.GetUsersUnavailabilityListParams) error {
+	periods, err := h.userRepo.ListUnavailability(ctx.Request().Context(), params.UserId)
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"user_id":        params.UserId,
+		"unavailability": converter.ToOpenAPIUnavailabilityList(periods),
+	})
+}
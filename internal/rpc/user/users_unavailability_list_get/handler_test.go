@@ -0,0 +1,73 @@
+package users_unavailability_list_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/rpc/user/users_unavailability_list_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_UsersUnavailabilityListGet(t *testing.T) {
+	t.Run("successful list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/users/unavailability/list?user_id=u1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		startsAt := time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)
+		mockRepo.EXPECT().
+			ListUnavailability(gomock.Any(), "u1").
+			Return([]models.Unavailability{
+				{ID: 1, UserID: "u1", StartsAt: startsAt, EndsAt: startsAt.Add(24 * time.Hour), Reason: "day off"},
+			}, nil)
+
+		err := handler.UsersUnavailabilityListGet(c, generated.GetUsersUnavailabilityListParams{UserId: "u1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var response struct {
+			UserID         string                     `json:"user_id"`
+			Unavailability []generated.Unavailability `json:"unavailability"`
+		}
+		assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "u1", response.UserID)
+		assert.Len(t, response.Unavailability, 1)
+		assert.Equal(t, "day off", response.Unavailability[0].Reason)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockuserRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/users/unavailability/list?user_id=u1", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().ListUnavailability(gomock.Any(), "u1").Return(nil, errors.New("db down"))
+
+		err := handler.UsersUnavailabilityListGet(c, generated.GetUsersUnavailabilityListParams{UserId: "u1"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
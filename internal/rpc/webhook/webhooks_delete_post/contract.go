@@ -0,0 +1,11 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package webhooks_delete_post
+
+import (
+	"context"
+)
+
+type webhookRepo interface {
+	DeleteSubscription(ctx context.Context, subscriptionID int64) error
+}
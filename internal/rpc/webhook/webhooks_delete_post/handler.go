@@ -0,0 +1,38 @@
+package webhooks_delete_post
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	webhook_repo "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/webhook"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	webhookRepo webhookRepo
+}
+
+func New(webhookRepo webhookRepo) *Handler {
+	return &Handler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (h *Handler) WebhooksDeletePost(ctx echo.Context) error {
+	var input generated.PostWebhooksDeleteJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	if err := h.webhookRepo.DeleteSubscription(ctx.Request().Context(), input.SubscriptionId); err != nil {
+		if errors.Is(err, webhook_repo.ErrNotFound) {
+			return rpc_errors.RespondNotFound(ctx, "webhook subscription not found")
+		}
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.NoContent(http.StatusNoContent)
+}
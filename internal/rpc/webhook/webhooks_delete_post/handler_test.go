@@ -0,0 +1,75 @@
+package webhooks_delete_post
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	webhook_repo "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/webhook"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_delete_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/delete", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_WebhooksDeletePost(t *testing.T) {
+	t.Run("successful delete", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockwebhookRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"subscription_id":1}`)
+
+		mockRepo.EXPECT().DeleteSubscription(gomock.Any(), int64(1)).Return(nil)
+
+		err := handler.WebhooksDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("subscription not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockwebhookRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"subscription_id":42}`)
+
+		mockRepo.EXPECT().DeleteSubscription(gomock.Any(), int64(42)).Return(webhook_repo.ErrNotFound)
+
+		err := handler.WebhooksDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockwebhookRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"subscription_id":"x"}`)
+
+		err := handler.WebhooksDeletePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
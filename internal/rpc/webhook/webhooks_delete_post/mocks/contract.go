@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookRepo is a mock of webhookRepo interface.
+type MockwebhookRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookRepoMockRecorder is the mock recorder for MockwebhookRepo.
+type MockwebhookRepoMockRecorder struct {
+	mock *MockwebhookRepo
+}
+
+// NewMockwebhookRepo creates a new mock instance.
+func NewMockwebhookRepo(ctrl *gomock.Controller) *MockwebhookRepo {
+	mock := &MockwebhookRepo{ctrl: ctrl}
+	mock.recorder = &MockwebhookRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookRepo) EXPECT() *MockwebhookRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockwebhookRepo) DeleteSubscription(ctx context.Context, subscriptionID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", ctx, subscriptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockwebhookRepoMockRecorder) DeleteSubscription(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockwebhookRepo)(nil).DeleteSubscription), ctx, subscriptionID)
+}
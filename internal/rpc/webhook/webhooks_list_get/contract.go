@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package webhooks_list_get
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type webhookRepo interface {
+	ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error)
+}
@@ -0,0 +1,30 @@
+package webhooks_list_get
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	webhookRepo webhookRepo
+}
+
+func New(webhookRepo webhookRepo) *Handler {
+	return &Handler{
+		webhookRepo: webhookRepo,
+	}
+}
+
+func (h *Handler) WebhooksListGet(ctx echo.Context) error {
+	subs, err := h.webhookRepo.ListSubscriptions(ctx.Request().Context())
+	if err != nil {
+		return rpc_errors.RespondInternal(ctx, err.Error())
+	}
+
+	return ctx.JSON(http.StatusOK, map[string]interface{}{
+		"subscriptions": converter.ToOpenAPIWebhookSubscriptions(subs),
+	})
+}
@@ -0,0 +1,78 @@
+package webhooks_list_get
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_list_get/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_WebhooksListGet(t *testing.T) {
+	t.Run("successful list", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockwebhookRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/webhooks/list", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		subs := []models.WebhookSubscription{
+			{
+				ID:        1,
+				URL:       "https://example.com/hook",
+				Secret:    "s3cret",
+				CreatedAt: time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC),
+			},
+		}
+
+		mockRepo.EXPECT().ListSubscriptions(gomock.Any()).Return(subs, nil)
+
+		err := handler.WebhooksListGet(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "s3cret")
+
+		var response struct {
+			Subscriptions []generated.WebhookSubscription `json:"subscriptions"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Len(t, response.Subscriptions, 1)
+		assert.Nil(t, response.Subscriptions[0].TeamName)
+		assert.Empty(t, response.Subscriptions[0].EventTypes)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockRepo := mocks.NewMockwebhookRepo(ctrl)
+		handler := New(mockRepo)
+
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodGet, "/webhooks/list", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+
+		mockRepo.EXPECT().ListSubscriptions(gomock.Any()).Return(nil, errors.New("db is down"))
+
+		err := handler.WebhooksListGet(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookRepo is a mock of webhookRepo interface.
+type MockwebhookRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookRepoMockRecorder is the mock recorder for MockwebhookRepo.
+type MockwebhookRepoMockRecorder struct {
+	mock *MockwebhookRepo
+}
+
+// NewMockwebhookRepo creates a new mock instance.
+func NewMockwebhookRepo(ctrl *gomock.Controller) *MockwebhookRepo {
+	mock := &MockwebhookRepo{ctrl: ctrl}
+	mock.recorder = &MockwebhookRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookRepo) EXPECT() *MockwebhookRepoMockRecorder {
+	return m.recorder
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockwebhookRepo) ListSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockwebhookRepoMockRecorder) ListSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockwebhookRepo)(nil).ListSubscriptions), ctx)
+}
@@ -0,0 +1,13 @@
+//go:generate mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+
+package webhooks_subscribe_post
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type createWebhookService interface {
+	CreateWebhook(ctx context.Context, sub models.WebhookSubscription) (models.WebhookSubscription, error)
+}
@@ -0,0 +1,51 @@
+package webhooks_subscribe_post
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/converter"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Handler struct {
+	createWebhookService createWebhookService
+}
+
+func New(createWebhookService createWebhookService) *Handler {
+	return &Handler{
+		createWebhookService: createWebhookService,
+	}
+}
+
+func (h *Handler) WebhooksSubscribePost(ctx echo.Context) error {
+	var input generated.PostWebhooksSubscribeJSONRequestBody
+
+	if err := ctx.Bind(&input); err != nil {
+		return rpc_errors.RespondBadRequest(ctx, "")
+	}
+
+	sub := models.WebhookSubscription{
+		URL:    input.Url,
+		Secret: input.Secret,
+	}
+	if input.TeamName != nil {
+		sub.TeamName = *input.TeamName
+	}
+	if input.EventTypes != nil {
+		for _, eventType := range *input.EventTypes {
+			sub.EventTypes = append(sub.EventTypes, string(eventType))
+		}
+	}
+
+	created, err := h.createWebhookService.CreateWebhook(ctx.Request().Context(), sub)
+	if err != nil {
+		return rpc_errors.RespondFromError(ctx, err)
+	}
+
+	return ctx.JSON(http.StatusCreated, map[string]interface{}{
+		"subscription": converter.ToOpenAPIWebhookSubscription(created),
+	})
+}
@@ -0,0 +1,127 @@
+package webhooks_subscribe_post
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	generated "github.com/loloneme/potential-waffle/internal/generated/openapi"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/rpc/webhook/webhooks_subscribe_post/mocks"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+var validSubscribeJSON = `{"url":"https://example.com/hook","secret":"s3cret","team_name":"backend","event_types":["ASSIGN"]}`
+
+func makeTestRequest(e *echo.Echo, body string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks/subscribe", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	return e.NewContext(req, rec), rec
+}
+
+func TestHandler_WebhooksSubscribePost(t *testing.T) {
+	t.Run("successful subscribe", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateWebhookService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSubscribeJSON)
+
+		mockService.EXPECT().
+			CreateWebhook(gomock.Any(), models.WebhookSubscription{
+				URL:        "https://example.com/hook",
+				Secret:     "s3cret",
+				TeamName:   "backend",
+				EventTypes: []string{"ASSIGN"},
+			}).
+			Return(models.WebhookSubscription{
+				ID:         1,
+				URL:        "https://example.com/hook",
+				Secret:     "s3cret",
+				TeamName:   "backend",
+				EventTypes: []string{"ASSIGN"},
+				CreatedAt:  time.Date(2025, 10, 24, 12, 0, 0, 0, time.UTC),
+			}, nil)
+
+		err := handler.WebhooksSubscribePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "s3cret")
+
+		var response struct {
+			Subscription generated.WebhookSubscription `json:"subscription"`
+		}
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), response.Subscription.SubscriptionId)
+		assert.Equal(t, "backend", *response.Subscription.TeamName)
+		assert.Equal(t, []generated.AssignmentEventType{generated.ASSIGN}, response.Subscription.EventTypes)
+	})
+
+	t.Run("bad request - invalid JSON", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateWebhookService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"url":`)
+
+		err := handler.WebhooksSubscribePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateWebhookService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, `{"url":"ftp://example.com","secret":"s3cret"}`)
+
+		mockService.EXPECT().
+			CreateWebhook(gomock.Any(), gomock.Any()).
+			Return(models.WebhookSubscription{}, rpc_errors.NewBadRequest("url must be an absolute http or https URL"))
+
+		err := handler.WebhooksSubscribePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("team not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mockService := mocks.NewMockcreateWebhookService(ctrl)
+		handler := New(mockService)
+
+		e := echo.New()
+		c, rec := makeTestRequest(e, validSubscribeJSON)
+
+		mockService.EXPECT().
+			CreateWebhook(gomock.Any(), gomock.Any()).
+			Return(models.WebhookSubscription{}, rpc_errors.NewNotFound("team not found"))
+
+		err := handler.WebhooksSubscribePost(c)
+
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
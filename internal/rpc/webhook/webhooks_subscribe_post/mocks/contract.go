@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: contract.go
+//
+// Generated by this command:
+//
+//	mockgen -source=contract.go -destination=mocks/contract.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockcreateWebhookService is a mock of createWebhookService interface.
+type MockcreateWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockcreateWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockcreateWebhookServiceMockRecorder is the mock recorder for MockcreateWebhookService.
+type MockcreateWebhookServiceMockRecorder struct {
+	mock *MockcreateWebhookService
+}
+
+// NewMockcreateWebhookService creates a new mock instance.
+func NewMockcreateWebhookService(ctrl *gomock.Controller) *MockcreateWebhookService {
+	mock := &MockcreateWebhookService{ctrl: ctrl}
+	mock.recorder = &MockcreateWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcreateWebhookService) EXPECT() *MockcreateWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateWebhook mocks base method.
+func (m *MockcreateWebhookService) CreateWebhook(ctx context.Context, sub models.WebhookSubscription) (models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhook", ctx, sub)
+	ret0, _ := ret[0].(models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhook indicates an expected call of CreateWebhook.
+func (mr *MockcreateWebhookServiceMockRecorder) CreateWebhook(ctx, sub any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockcreateWebhookService)(nil).CreateWebhook), ctx, sub)
+}
@@ -0,0 +1,21 @@
+package archive_team
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+)
+
+type teamRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	ArchiveTeam(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error)
+	InsertTeamEvents(ctx context.Context, tx *sqlx.Tx, events []models.TeamEvent) error
+}
+
+type userRepo interface {
+	Find(ctx context.Context, spec user.FindSpecification) ([]models.User, error)
+}
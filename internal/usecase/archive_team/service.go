@@ -0,0 +1,85 @@
+package archive_team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	teamRepo teamRepo
+	userRepo userRepo
+}
+
+func New(teamRepo teamRepo, userRepo userRepo) *Service {
+	return &Service{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+	}
+}
+
+// ArchiveTeam is the team deletion: the row stays so that historical PRs and
+// assignment events keep their references, but the team stops being offered
+// as a fallback. Active members have to be moved or deactivated first.
+// Archiving an archived team returns it unchanged.
+func (s *Service) ArchiveTeam(ctx context.Context, teamName, actor string) (models.Team, error) {
+	current, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return models.Team{}, rpc_errors.NewNotFound("team not found")
+		}
+		return models.Team{}, fmt.Errorf("find team: %w", err)
+	}
+
+	members, err := s.userRepo.Find(ctx, user_spec.NewGetUsersByTeamNameSpec(teamName))
+	if err != nil {
+		return models.Team{}, fmt.Errorf("find team members: %w", err)
+	}
+
+	if current.ArchivedAt != nil {
+		current.Members = members
+		return current, nil
+	}
+
+	var active []string
+	for _, m := range members {
+		if m.IsActive {
+			active = append(active, m.ID)
+		}
+	}
+	if len(active) > 0 {
+		return models.Team{}, rpc_errors.NewTeamNotEmpty(fmt.Sprintf("team has active members: %s", strings.Join(active, ", ")))
+	}
+
+	var archived models.Team
+	err = s.teamRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		archived, err = s.teamRepo.ArchiveTeam(ctx, tx, teamName)
+		if err != nil {
+			return err
+		}
+
+		return s.teamRepo.InsertTeamEvents(ctx, tx, []models.TeamEvent{{
+			TeamName: teamName,
+			Type:     models.TeamEventArchived,
+			Actor:    actor,
+		}})
+	})
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return models.Team{}, rpc_errors.NewNotFound("team not found")
+		}
+		return models.Team{}, fmt.Errorf("archive team: %w", err)
+	}
+
+	archived.FallbackTeams = current.FallbackTeams
+	archived.Members = members
+
+	return archived, nil
+}
@@ -0,0 +1,114 @@
+package archive_team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/archive_team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	teamRepo *team.Repository
+	service  *archive_team.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, team_fallbacks, team_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		service:  archive_team.New(teamRepo, userRepo),
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, fallbackTeams []string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		if len(fallbackTeams) > 0 {
+			if err := env.teamRepo.SetFallbackTeams(ctx, tx, teamName, fallbackTeams); err != nil {
+				return err
+			}
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_ArchiveTeam_RejectsActiveMembers(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "legacy", nil, "u1", "u2")
+
+	_, err := env.service.ArchiveTeam(env.ctx, "legacy", "admin")
+	var notEmpty *rpc_errors.TeamNotEmptyError
+	require.ErrorAs(t, err, &notEmpty)
+	assert.Contains(t, notEmpty.Message, "u1, u2")
+
+	stored, err := env.teamRepo.FindTeamByID(env.ctx, "legacy")
+	require.NoError(t, err)
+	assert.Nil(t, stored.ArchivedAt)
+}
+
+func TestService_ArchiveTeam(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "legacy", nil, "u1")
+	env.seedTeam(t, "backend", []string{"legacy"}, "b1")
+
+	_, err := env.userRepo.UserUpdate(env.ctx, user_spec.NewSetIsActiveSpecification("u1", false))
+	require.NoError(t, err)
+
+	archived, err := env.service.ArchiveTeam(env.ctx, "legacy", "admin")
+	require.NoError(t, err)
+	require.NotNil(t, archived.ArchivedAt)
+	assert.Len(t, archived.Members, 1)
+
+	fallbacks, err := env.teamRepo.GetFallbackTeams(env.ctx, "backend")
+	require.NoError(t, err)
+	assert.Empty(t, fallbacks)
+
+	again, err := env.service.ArchiveTeam(env.ctx, "legacy", "admin")
+	require.NoError(t, err)
+	assert.True(t, archived.ArchivedAt.Equal(*again.ArchivedAt))
+
+	events, err := env.teamRepo.GetTeamEvents(env.ctx, "legacy")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.TeamEventArchived, events[0].Type)
+	assert.Equal(t, "admin", events[0].Actor)
+}
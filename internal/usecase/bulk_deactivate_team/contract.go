@@ -9,6 +9,7 @@ import (
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 )
 
 type userRepo interface {
@@ -20,5 +21,13 @@ type prRepo interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
 	GetOpenPRsWithReviewers(ctx context.Context, reviewerIDs []string) (map[string][]string, error)
 	GetOpenPRsWithFullInfo(ctx context.Context, deactivatedReviewerIDs []string) (map[string]pull_request.PRFullInfo, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
 	BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, reassignments []pull_request.PRReassignments) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type reviewerSelector interface {
+	TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+	CrossTeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+	AtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
 }
@@ -4,43 +4,84 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"sort"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
 	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
-)
-
-const (
-	numberOfReviewers = 1
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 )
 
 type ReassignmentResult struct {
 	PRID          string
 	OldReviewerID string
 	NewReviewerID string
+	SourceTeam    string
+}
+
+const (
+	// UnresolvedNoCandidates means the pool had no active candidate at all.
+	UnresolvedNoCandidates = "NO_ACTIVE_CANDIDATES"
+	// UnresolvedAllCandidatesOnPR means every candidate is already the author
+	// or a reviewer of the PR.
+	UnresolvedAllCandidatesOnPR = "ALL_CANDIDATES_ON_PR"
+	// UnresolvedAllCandidatesAtCapacity means the candidates not yet on the PR
+	// have all reached their open review limit.
+	UnresolvedAllCandidatesAtCapacity = "ALL_CANDIDATES_AT_CAPACITY"
+)
+
+// UnresolvedSlot is a deactivated reviewer nobody could replace on a PR. The
+// reviewer stays assigned unless Removed is set.
+type UnresolvedSlot struct {
+	PRID       string
+	ReviewerID string
+	Reason     string
+	Removed    bool
 }
 
 type BulkDeactivateResult struct {
 	DeactivatedUserIDs []string
 	Reassignments      []ReassignmentResult
+	Unresolved         []UnresolvedSlot
+}
+
+// Options tune a deactivation run.
+type Options struct {
+	// DryRun computes the deactivations and reassignments without applying them
+	// or advancing the strategy state. With round_robin the plan is what the
+	// next real run does; random and weighted picks, and least_loaded ties, are
+	// drawn anew by the real run, so for them the plan is only an example.
+	DryRun bool
+	// FallbackToOtherTeams looks for replacements in every other team once the
+	// team and its fallback teams are exhausted.
+	FallbackToOtherTeams bool
+	// RemoveUnresolved takes deactivated reviewers without a replacement off
+	// their PRs instead of leaving them assigned.
+	RemoveUnresolved bool
 }
 
 type Service struct {
 	userRepo userRepo
 	prRepo   prRepo
+	selector reviewerSelector
 }
 
-func New(userRepo userRepo, prRepo prRepo) *Service {
+func New(userRepo userRepo, prRepo prRepo, selector reviewerSelector) *Service {
 	return &Service{
 		userRepo: userRepo,
 		prRepo:   prRepo,
+		selector: selector,
 	}
 }
 
-func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string) (BulkDeactivateResult, error) {
+func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string, opts Options, actor string) (BulkDeactivateResult, error) {
 	var res BulkDeactivateResult
 	if len(userIDs) == 0 {
 		return res, nil
@@ -55,11 +96,9 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string,
 	}
 
 	activeTeamUserMap := make(map[string]bool)
-	activeTeamUserIDs := make([]string, 0, len(users))
 	for _, u := range users {
 		if u.IsActive {
 			activeTeamUserMap[u.ID] = true
-			activeTeamUserIDs = append(activeTeamUserIDs, u.ID)
 		}
 	}
 
@@ -79,74 +118,81 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string,
 		return res, fmt.Errorf("get open PRs with full info: %w", err)
 	}
 
-	var reassignments []ReassignmentResult
-	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		deactivatedUserIDs, err := s.userRepo.BulkDeactivateUsers(ctx, tx, teamName, validUserIDs)
-		if err != nil {
-			return fmt.Errorf("bulk deactivate users: %w", err)
-		}
-
-		bulkReassignments := make([]pull_request.PRReassignments, 0)
-
-		for prID, prInfo := range prsInfo {
-			if len(prInfo.DeactivatedReviewers) == 0 {
-				continue
-			}
-
-			excludeSet := make(map[string]bool)
-			excludeSet[prInfo.AuthorID] = true
-			for _, reviewerID := range prInfo.AllReviewers {
-				excludeSet[reviewerID] = true
-			}
+	pickPool := s.selector.TeamPool
+	if opts.FallbackToOtherTeams {
+		pickPool = s.selector.CrossTeamPool
+	}
 
-			neededReviewers := len(prInfo.DeactivatedReviewers)
-			availableReviewers := make([]string, 0, neededReviewers)
-			for _, userID := range activeTeamUserIDs {
-				if !excludeSet[userID] {
-					availableReviewers = append(availableReviewers, userID)
-					if len(availableReviewers) >= neededReviewers {
-						break
-					}
-				}
-			}
+	pool, err := pickPool(ctx, teamName, validUserIDs)
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return res, rpc_errors.NewNotFound("team not found")
+		}
+		return res, fmt.Errorf("get team reviewer pool: %w", err)
+	}
 
-			reviewerMap := make(map[string]string)
-			availableIdx := 0
+	atCapacity, err := s.selector.AtCapacity(ctx, teamName, validUserIDs)
+	if err != nil {
+		return res, fmt.Errorf("get reviewers at capacity: %w", err)
+	}
 
-			for _, oldReviewerID := range prInfo.DeactivatedReviewers {
-				if availableIdx >= len(availableReviewers) {
-					break
-				}
+	if opts.DryRun {
+		plan := planReassignments(prsInfo, pool.Preview(), atCapacity, opts.RemoveUnresolved)
+		res.DeactivatedUserIDs = validUserIDs
+		res.Reassignments = plan.reassignments
+		res.Unresolved = plan.unresolved
+		return res, nil
+	}
 
-				newReviewerID := availableReviewers[availableIdx]
-				availableIdx++
+	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		// Plan against the PRs as they are under the row lock, so a PR merged or
+		// reassigned since it was read is left alone.
+		lockedInfo, err := pr_lifecycle.LockReviewable(ctx, tx, s.prRepo, prsInfo)
+		if err != nil {
+			return err
+		}
+		plan := planReassignments(lockedInfo, pool, atCapacity, opts.RemoveUnresolved)
 
-				reviewerMap[oldReviewerID] = newReviewerID
-				reassignments = append(reassignments, ReassignmentResult{
-					PRID:          prID,
-					OldReviewerID: oldReviewerID,
-					NewReviewerID: newReviewerID,
-				})
+		deactivatedUserIDs, err := s.userRepo.BulkDeactivateUsers(ctx, tx, teamName, validUserIDs)
+		if err != nil {
+			return fmt.Errorf("bulk deactivate users: %w", err)
+		}
 
-				excludeSet[newReviewerID] = true
+		if len(plan.bulk) > 0 {
+			if err := s.prRepo.BulkReassignReviewers(ctx, tx, plan.bulk); err != nil {
+				return fmt.Errorf("bulk reassign reviewers: %w", err)
 			}
+		}
 
-			if len(reviewerMap) > 0 {
-				bulkReassignments = append(bulkReassignments, pull_request.PRReassignments{
-					PRID:          prID,
-					Reassignments: reviewerMap,
+		events := make([]models.AssignmentEvent, 0, len(plan.reassignments)+len(plan.unresolved))
+		for _, r := range plan.reassignments {
+			events = append(events, models.AssignmentEvent{
+				PullRequestID: r.PRID,
+				Type:          models.AssignmentEventDeactivationReassign,
+				OldReviewerID: r.OldReviewerID,
+				NewReviewerID: r.NewReviewerID,
+				Actor:         actor,
+				Reason:        "reviewer deactivated",
+			})
+		}
+		for _, u := range plan.unresolved {
+			if u.Removed {
+				events = append(events, models.AssignmentEvent{
+					PullRequestID: u.PRID,
+					Type:          models.AssignmentEventUnassign,
+					OldReviewerID: u.ReviewerID,
+					Actor:         actor,
+					Reason:        "reviewer deactivated, no replacement",
 				})
 			}
 		}
-
-		if len(bulkReassignments) > 0 {
-			if err := s.prRepo.BulkReassignReviewers(ctx, tx, bulkReassignments); err != nil {
-				return fmt.Errorf("bulk reassign reviewers: %w", err)
-			}
+		if err := s.prRepo.InsertAssignmentEvents(ctx, tx, events); err != nil {
+			return fmt.Errorf("insert assignment events: %w", err)
 		}
 
 		res.DeactivatedUserIDs = deactivatedUserIDs
-		res.Reassignments = reassignments
+		res.Reassignments = plan.reassignments
+		res.Unresolved = plan.unresolved
 		return nil
 	})
 	if err != nil {
@@ -156,5 +202,95 @@ func (s *Service) BulkDeactivateTeamUsers(ctx context.Context, teamName string,
 		return BulkDeactivateResult{}, err
 	}
 
+	metrics.BulkDeactivations.Inc()
+	metrics.DeactivatedUsers.Add(float64(len(res.DeactivatedUserIDs)))
+	metrics.Reassignments.WithLabelValues(metrics.ReasonDeactivation).Add(float64(len(res.Reassignments)))
+
 	return res, nil
 }
+
+type reassignmentPlan struct {
+	bulk          []pull_request.PRReassignments
+	reassignments []ReassignmentResult
+	unresolved    []UnresolvedSlot
+}
+
+// planReassignments picks replacements for the deactivated reviewers of every
+// PR. PRs are handled in id order so that a dry run and the real run agree.
+// atCapacity are the candidates left out of the pool because they were already
+// at their open review limit.
+func planReassignments(prsInfo map[string]pull_request.PRFullInfo, pool *reviewer_selection.Pool, atCapacity []models.Candidate, removeUnresolved bool) reassignmentPlan {
+	prIDs := make([]string, 0, len(prsInfo))
+	for prID := range prsInfo {
+		prIDs = append(prIDs, prID)
+	}
+	sort.Strings(prIDs)
+
+	var plan reassignmentPlan
+	for _, prID := range prIDs {
+		prInfo := prsInfo[prID]
+		if len(prInfo.DeactivatedReviewers) == 0 {
+			continue
+		}
+
+		excludeIDs := append([]string{prInfo.AuthorID}, prInfo.AllReviewers...)
+		availableReviewers := pool.Pick(excludeIDs, len(prInfo.DeactivatedReviewers))
+
+		reviewerMap := make(map[string]models.Reviewer)
+		var removals []string
+		availableIdx := 0
+
+		for _, oldReviewerID := range prInfo.DeactivatedReviewers {
+			if availableIdx >= len(availableReviewers) {
+				plan.unresolved = append(plan.unresolved, UnresolvedSlot{
+					PRID:       prID,
+					ReviewerID: oldReviewerID,
+					Reason:     unresolvedReason(pool, atCapacity, excludeIDs),
+					Removed:    removeUnresolved,
+				})
+				if removeUnresolved {
+					removals = append(removals, oldReviewerID)
+				}
+				continue
+			}
+
+			newReviewer := availableReviewers[availableIdx]
+			availableIdx++
+
+			reviewerMap[oldReviewerID] = newReviewer
+			plan.reassignments = append(plan.reassignments, ReassignmentResult{
+				PRID:          prID,
+				OldReviewerID: oldReviewerID,
+				NewReviewerID: newReviewer.ReviewerID,
+				SourceTeam:    newReviewer.SourceTeam,
+			})
+		}
+
+		if len(reviewerMap) > 0 || len(removals) > 0 {
+			plan.bulk = append(plan.bulk, pull_request.PRReassignments{
+				PRID:          prID,
+				Reassignments: reviewerMap,
+				Removals:      removals,
+			})
+		}
+	}
+
+	return plan
+}
+
+// unresolvedReason explains why Pick came up short on a PR whose author and
+// reviewers are excludeIDs.
+func unresolvedReason(pool *reviewer_selection.Pool, atCapacity []models.Candidate, excludeIDs []string) string {
+	if pool.LimitedOut(excludeIDs) {
+		return UnresolvedAllCandidatesAtCapacity
+	}
+	for _, c := range atCapacity {
+		if !slices.Contains(excludeIDs, c.UserID) {
+			return UnresolvedAllCandidatesAtCapacity
+		}
+	}
+	if pool.Empty() {
+		return UnresolvedNoCandidates
+	}
+	return UnresolvedAllCandidatesOnPR
+}
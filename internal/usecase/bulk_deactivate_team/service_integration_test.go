@@ -0,0 +1,243 @@
+package bulk_deactivate_team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *bulk_deactivate_team.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := bulk_deactivate_team.New(userRepo, prRepo, reviewer_selection.New(teamRepo, prRepo))
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) seedPR(t *testing.T, prID, authorID string, reviewers ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		openStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: openStatus.ID,
+		}); err != nil {
+			return err
+		}
+
+		assigned := make([]models.Reviewer, len(reviewers))
+		for i, id := range reviewers {
+			assigned[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, assigned)
+	})
+	require.NoError(t, err)
+}
+
+func TestService_BulkDeactivateTeamUsers_DryRunChangesNothing(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "u2", "u3", "r4")
+	env.seedPR(t, "pr-1", "author", "u2", "u3")
+
+	result, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2", "u3"}, bulk_deactivate_team.Options{DryRun: true}, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, result.DeactivatedUserIDs)
+	require.Len(t, result.Reassignments, 1)
+	assert.Equal(t, "r4", result.Reassignments[0].NewReviewerID)
+	require.Len(t, result.Unresolved, 1)
+	assert.Equal(t, bulk_deactivate_team.UnresolvedSlot{
+		PRID:       "pr-1",
+		ReviewerID: "u3",
+		Reason:     bulk_deactivate_team.UnresolvedAllCandidatesOnPR,
+	}, result.Unresolved[0])
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"u2", "u3"}, pr.Reviewers)
+
+	for _, id := range []string{"u2", "u3"} {
+		stored, err := env.userRepo.GetUserByID(env.ctx, id)
+		require.NoError(t, err)
+		assert.True(t, stored.IsActive)
+	}
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestService_BulkDeactivateTeamUsers_MatchesDryRun(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "u2", "u3", "r4")
+	env.seedPR(t, "pr-1", "author", "u2", "u3")
+
+	planned, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2", "u3"}, bulk_deactivate_team.Options{DryRun: true}, "admin")
+	require.NoError(t, err)
+
+	result, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2", "u3"}, bulk_deactivate_team.Options{}, "admin")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, planned.DeactivatedUserIDs, result.DeactivatedUserIDs)
+	assert.Equal(t, planned.Reassignments, result.Reassignments)
+	assert.Equal(t, planned.Unresolved, result.Unresolved)
+
+	unresolvedID := result.Unresolved[0].ReviewerID
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"r4", unresolvedID}, pr.Reviewers)
+}
+
+func TestService_BulkDeactivateTeamUsers_DryRunKeepsRoundRobinCursor(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "u2", "r3", "r4", "r5")
+	_, err := env.db.ExecContext(env.ctx, "UPDATE teams SET assignment_strategy = 'round_robin' WHERE team_name = 'backend'")
+	require.NoError(t, err)
+	env.seedPR(t, "pr-1", "author", "u2")
+	env.seedPR(t, "pr-2", "author", "u2")
+
+	planned, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2"}, bulk_deactivate_team.Options{DryRun: true}, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, []bulk_deactivate_team.ReassignmentResult{
+		{PRID: "pr-1", OldReviewerID: "u2", NewReviewerID: "r3", SourceTeam: "backend"},
+		{PRID: "pr-2", OldReviewerID: "u2", NewReviewerID: "r4", SourceTeam: "backend"},
+	}, planned.Reassignments)
+
+	result, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2"}, bulk_deactivate_team.Options{}, "admin")
+	require.NoError(t, err)
+	assert.Equal(t, planned.Reassignments, result.Reassignments)
+}
+
+func TestService_BulkDeactivateTeamUsers_RemoveUnresolved(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "u2")
+	env.seedPR(t, "pr-1", "author", "u2")
+
+	result, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2"}, bulk_deactivate_team.Options{RemoveUnresolved: true}, "admin")
+	require.NoError(t, err)
+	assert.Empty(t, result.Reassignments)
+	assert.Equal(t, []bulk_deactivate_team.UnresolvedSlot{{
+		PRID:       "pr-1",
+		ReviewerID: "u2",
+		Reason:     bulk_deactivate_team.UnresolvedAllCandidatesOnPR,
+		Removed:    true,
+	}}, result.Unresolved)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Empty(t, pr.Reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventUnassign, events[0].Type)
+	assert.Equal(t, "u2", events[0].OldReviewerID)
+	assert.Equal(t, "admin", events[0].Actor)
+}
+
+func TestService_BulkDeactivateTeamUsers_AllCandidatesAtCapacity(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "u2", "busy")
+	env.seedPR(t, "pr-1", "author", "u2")
+	env.seedPR(t, "pr-2", "u2", "busy")
+
+	_, err := env.db.ExecContext(env.ctx, "UPDATE users SET max_open_reviews = 1 WHERE user_id = $1", "busy")
+	require.NoError(t, err)
+
+	result, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2"}, bulk_deactivate_team.Options{DryRun: true}, "admin")
+	require.NoError(t, err)
+	assert.Empty(t, result.Reassignments)
+	assert.Equal(t, []bulk_deactivate_team.UnresolvedSlot{{
+		PRID:       "pr-1",
+		ReviewerID: "u2",
+		Reason:     bulk_deactivate_team.UnresolvedAllCandidatesAtCapacity,
+	}}, result.Unresolved)
+}
+
+func TestService_BulkDeactivateTeamUsers_FallbackToOtherTeams(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "u2")
+	env.seedTeam(t, "frontend", "f1")
+	env.seedPR(t, "pr-1", "author", "u2")
+
+	result, err := env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2"}, bulk_deactivate_team.Options{DryRun: true}, "admin")
+	require.NoError(t, err)
+	assert.Empty(t, result.Reassignments)
+	require.Len(t, result.Unresolved, 1)
+	assert.False(t, result.Unresolved[0].Removed)
+
+	result, err = env.service.BulkDeactivateTeamUsers(env.ctx, "backend", []string{"u2"}, bulk_deactivate_team.Options{FallbackToOtherTeams: true}, "admin")
+	require.NoError(t, err)
+	assert.Empty(t, result.Unresolved)
+	assert.Equal(t, []bulk_deactivate_team.ReassignmentResult{{
+		PRID:          "pr-1",
+		OldReviewerID: "u2",
+		NewReviewerID: "f1",
+		SourceTeam:    "frontend",
+	}}, result.Reassignments)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"f1"}, pr.Reviewers)
+}
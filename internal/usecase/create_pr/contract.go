@@ -6,10 +6,12 @@ import (
 	"github.com/jmoiron/sqlx"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 )
 
 type userRepo interface {
 	GetUserTeamName(ctx context.Context, userID string) (string, error)
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
 }
 
 type prRepo interface {
@@ -18,7 +20,15 @@ type prRepo interface {
 	InsertPullRequest(ctx context.Context, tx *sqlx.Tx, pr *models.PullRequest) (models.PullRequest, error)
 	FindStatus(ctx context.Context, spec pull_request.FindSpecification) (*models.Status, error)
 
-	GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string, limit int) ([]string, error)
+	InsertChangedPaths(ctx context.Context, tx *sqlx.Tx, prID string, paths []string) error
+	InsertRequiredSkills(ctx context.Context, tx *sqlx.Tx, prID string, tags []string) error
 
-	InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []string) error
+	InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []models.Reviewer) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type reviewerSelector interface {
+	TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+	FillReviewers(ctx context.Context, pool *reviewer_selection.Pool, paths, requiredSkills []string, assigned []models.Reviewer, excludeIDs []string) (reviewer_selection.Fill, error)
+	AtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
 }
@@ -4,35 +4,57 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/skills"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
-)
-
-const (
-	numberOfReviewers = 2
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 )
 
 type Service struct {
 	userRepo userRepo
 	prRepo   prRepo
+	selector reviewerSelector
 }
 
-func New(userRepo userRepo, prRepo prRepo) *Service {
+func New(userRepo userRepo, prRepo prRepo, selector reviewerSelector) *Service {
 	return &Service{
 		userRepo: userRepo,
 		prRepo:   prRepo,
+		selector: selector,
 	}
 }
 
 func (s *Service) CreatePR(ctx context.Context, pr *models.PullRequest) (models.PullRequest, error) {
 	var createdPR models.PullRequest
 
-	err := s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+	requiredSkills, err := skills.Normalize(pr.RequiredSkills)
+	if err != nil {
+		return models.PullRequest{}, rpc_errors.NewBadRequest(err.Error())
+	}
+	pr.RequiredSkills = requiredSkills
+
+	switch pr.SkillMode {
+	case "":
+		pr.SkillMode = models.SkillModePrefer
+	case models.SkillModePrefer, models.SkillModeRequire:
+	default:
+		return models.PullRequest{}, rpc_errors.NewBadRequest(fmt.Sprintf("unknown skill mode %q", pr.SkillMode))
+	}
+
+	pr.RequestedReviewers, err = normalizeRequested(pr.RequestedReviewers, pr.AuthorID)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
 		foundStatus, err := s.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(pr.Status.Name))
 		if err != nil {
 			return fmt.Errorf("find status: %w", err)
@@ -47,15 +69,48 @@ func (s *Service) CreatePR(ctx context.Context, pr *models.PullRequest) (models.
 			return fmt.Errorf("get author team: %w", err)
 		}
 
-		reviewers, err := s.prRepo.GetAvailableReviewers(ctx, teamName, []string{pr.AuthorID}, numberOfReviewers)
-		if err != nil {
-			if errors.Is(err, pull_request.ErrReviewersNotFound) {
-				return rpc_errors.NewNotFound("no available reviewers found")
+		// Reviewers requested by the author come first, drafts get only them and
+		// the rest once they are marked ready for review. Then code owners of
+		// the changed paths, reviewers covering the required skills, and the
+		// team pool fills the remaining slots.
+		var requested, reviewers []models.Reviewer
+		var fill reviewer_selection.Fill
+		if pr.Status.Name != models.StatusDraft || len(pr.RequestedReviewers) > 0 {
+			pool, err := s.selector.TeamPool(ctx, teamName, []string{pr.AuthorID})
+			if err != nil {
+				if errors.Is(err, team.ErrNotFound) {
+					return rpc_errors.NewNotFound("author team not found")
+				}
+				return fmt.Errorf("get team reviewer pool: %w", err)
 			}
-			return fmt.Errorf("get available reviewers: %w", err)
-		}
-		if len(reviewers) == 0 {
-			return rpc_errors.NewNotFound("no available reviewers found")
+
+			required, maxReviewers := pool.Quota()
+			if len(pr.RequestedReviewers) > maxReviewers {
+				return rpc_errors.NewBadRequest(fmt.Sprintf("too many requested reviewers: team allows at most %d", maxReviewers))
+			}
+
+			requested, err = s.pickRequested(ctx, pool, teamName, pr)
+			if err != nil {
+				return err
+			}
+			reviewers = requested
+
+			if pr.Status.Name != models.StatusDraft {
+				fill, err = s.selector.FillReviewers(ctx, pool, pr.ChangedPaths, pr.RequiredSkills, requested, []string{pr.AuthorID})
+				if err != nil {
+					return err
+				}
+				if len(fill.MissingSkills) > 0 && pr.SkillMode == models.SkillModeRequire {
+					metrics.NoCandidate.WithLabelValues(metrics.OperationCreatePR).Inc()
+					return rpc_errors.NewNoCandidate(fmt.Sprintf("no available reviewer with skills: %s", strings.Join(fill.MissingSkills, ", ")))
+				}
+
+				reviewers = append(reviewers, fill.Reviewers()...)
+				if len(reviewers) < required {
+					return rpc_errors.NewNotFound(fmt.Sprintf("not enough available reviewers: team requires %d, found %d", required, len(reviewers)))
+				}
+			}
+			reviewers = pool.MatchSkills(reviewers, pr.RequiredSkills)
 		}
 
 		created, err := s.prRepo.InsertPullRequest(ctx, tx, pr)
@@ -72,10 +127,81 @@ func (s *Service) CreatePR(ctx context.Context, pr *models.PullRequest) (models.
 			return fmt.Errorf("insert reviewers: %w", err)
 		}
 
-		createdPR.Reviewers = reviewers
+		if err := s.prRepo.InsertChangedPaths(ctx, tx, pr.ID, pr.ChangedPaths); err != nil {
+			return fmt.Errorf("insert changed paths: %w", err)
+		}
+		if err := s.prRepo.InsertRequiredSkills(ctx, tx, pr.ID, pr.RequiredSkills); err != nil {
+			return fmt.Errorf("insert required skills: %w", err)
+		}
+
+		events := reviewer_selection.AssignEvents(pr.ID, requested, pr.AuthorID, "requested by author")
+		events = append(events, fill.Events(pr.ID, pr.AuthorID, "pull request created")...)
+		if err := s.prRepo.InsertAssignmentEvents(ctx, tx, events); err != nil {
+			return fmt.Errorf("insert assignment events: %w", err)
+		}
+
+		createdPR.Reviewers = reviewer_selection.ReviewerIDs(reviewers)
+		createdPR.Assignments = reviewers
 
 		return nil
 	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	metrics.PullRequestsCreated.Inc()
+	return createdPR, nil
+}
+
+// normalizeRequested drops duplicate requested reviewers, keeping the order.
+func normalizeRequested(ids []string, authorID string) ([]string, error) {
+	seen := make(map[string]bool, len(ids))
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == authorID {
+			return nil, rpc_errors.NewBadRequest("author cannot be a requested reviewer")
+		}
+		if !seen[id] {
+			seen[id] = true
+			res = append(res, id)
+		}
+	}
+	return res, nil
+}
 
-	return createdPR, err
+// pickRequested takes the requested reviewers from the pool and explains why
+// the first one it cannot offer is not allowed.
+func (s *Service) pickRequested(ctx context.Context, pool *reviewer_selection.Pool, teamName string, pr *models.PullRequest) ([]models.Reviewer, error) {
+	picked, rejected := pool.PickRequested(pr.RequestedReviewers)
+	if len(rejected) == 0 {
+		return picked, nil
+	}
+
+	id := rejected[0]
+	u, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return nil, rpc_errors.NewNotFound(fmt.Sprintf("requested reviewer %s not found", id))
+		}
+		return nil, fmt.Errorf("get requested reviewer: %w", err)
+	}
+	if !u.IsActive {
+		return nil, rpc_errors.NewBadRequest(fmt.Sprintf("requested reviewer %s is not active", id))
+	}
+	if !pool.HasTier(u.TeamName) {
+		return nil, rpc_errors.NewBadRequest(fmt.Sprintf("requested reviewer %s is not in team %s or its fallback teams", id, teamName))
+	}
+
+	atCapacity, err := s.selector.AtCapacity(ctx, teamName, []string{pr.AuthorID})
+	if err != nil {
+		return nil, fmt.Errorf("get reviewers at capacity: %w", err)
+	}
+	for _, c := range atCapacity {
+		if c.UserID == id {
+			metrics.NoCandidate.WithLabelValues(metrics.OperationCreatePR).Inc()
+			return nil, rpc_errors.NewNoCandidate(fmt.Sprintf("requested reviewer %s is at their open review limit (%d/%d)", id, c.OpenReviews, c.MaxOpenReviews))
+		}
+	}
+	metrics.NoCandidate.WithLabelValues(metrics.OperationCreatePR).Inc()
+	return nil, rpc_errors.NewNoCandidate(fmt.Sprintf("requested reviewer %s is unavailable", id))
 }
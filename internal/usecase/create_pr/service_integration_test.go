@@ -9,9 +9,11 @@ import (
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	"github.com/loloneme/potential-waffle/internal/usecase/create_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -37,10 +39,10 @@ func setupTest(t *testing.T) *testEnv {
 	userRepo := user.NewRepository(db)
 	prRepo := pull_request.NewRepository(db)
 	teamRepo := team.NewRepository(db)
-	service := create_pr.New(userRepo, prRepo)
+	service := create_pr.New(userRepo, prRepo, reviewer_selection.New(teamRepo, prRepo))
 
 	t.Cleanup(func() {
-		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, team_code_owners, user_skills RESTART IDENTITY CASCADE")
 		_ = db.Close()
 	})
 
@@ -59,7 +61,7 @@ func TestService_CreatePR_Successful(t *testing.T) {
 
 	teamName := "test-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -104,6 +106,16 @@ func TestService_CreatePR_Successful(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, reviewers, 2)
 	assert.NotContains(t, reviewers, authorID)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, pr.ID)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	for _, e := range events {
+		assert.Equal(t, models.AssignmentEventAssign, e.Type)
+		assert.Equal(t, authorID, e.Actor)
+		assert.Empty(t, e.OldReviewerID)
+		assert.Contains(t, reviewers, e.NewReviewerID)
+	}
 }
 
 func TestService_CreatePR_AuthorNotFound(t *testing.T) {
@@ -127,7 +139,7 @@ func TestService_CreatePR_NoAvailableReviewers(t *testing.T) {
 
 	teamName := "lonely-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -160,7 +172,7 @@ func TestService_CreatePR_AlreadyExists(t *testing.T) {
 
 	teamName := "team-2"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -206,7 +218,7 @@ func TestService_CreatePR_ReviewersAreActiveUsersOnly(t *testing.T) {
 
 	teamName := "mixed-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -240,3 +252,436 @@ func TestService_CreatePR_ReviewersAreActiveUsersOnly(t *testing.T) {
 	assert.Contains(t, createdPR.Reviewers, activeReviewerID)
 	assert.NotContains(t, createdPR.Reviewers, inactiveReviewerID)
 }
+
+func TestService_CreatePR_PrefersLeastLoadedReviewers(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "busy-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
+		return err
+	})
+	require.NoError(t, err)
+
+	authorID := "author-4"
+	busyReviewerID := "busy-reviewer"
+	freeReviewer1ID := "free-reviewer-1"
+	freeReviewer2ID := "free-reviewer-2"
+
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: authorID, Username: "author4", IsActive: true, TeamName: teamName},
+			{ID: busyReviewerID, Username: "busy", IsActive: true, TeamName: teamName},
+			{ID: freeReviewer1ID, Username: "free1", IsActive: true, TeamName: teamName},
+			{ID: freeReviewer2ID, Username: "free2", IsActive: true, TeamName: teamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
+		if err != nil {
+			return err
+		}
+
+		busyPR := &models.PullRequest{
+			ID:       "busy-pr",
+			Name:     "Busy PR",
+			AuthorID: freeReviewer1ID,
+			StatusID: foundStatus.ID,
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, busyPR); err != nil {
+			return err
+		}
+
+		return env.prRepo.InsertReviewers(ctx, tx, busyPR.ID, []models.Reviewer{{ReviewerID: busyReviewerID, SourceTeam: teamName}})
+	})
+	require.NoError(t, err)
+
+	pr := &models.PullRequest{
+		ID:       "pr-6",
+		Name:     "Test PR",
+		AuthorID: authorID,
+		Status:   &models.Status{Name: "OPEN"},
+	}
+
+	createdPR, err := env.service.CreatePR(env.ctx, pr)
+	require.NoError(t, err)
+
+	assert.Len(t, createdPR.Reviewers, 2)
+	assert.ElementsMatch(t, []string{freeReviewer1ID, freeReviewer2ID}, createdPR.Reviewers)
+	assert.NotContains(t, createdPR.Reviewers, busyReviewerID)
+}
+
+func TestService_CreatePR_UsesTeamMaxReviewers(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "big-team"
+	required, maxReviewers := 2, 3
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{RequiredReviewers: &required, MaxReviewers: &maxReviewers})
+		return err
+	})
+	require.NoError(t, err)
+
+	authorID := "big-author"
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+			{ID: "big-r1", Username: "r1", IsActive: true, TeamName: teamName},
+			{ID: "big-r2", Username: "r2", IsActive: true, TeamName: teamName},
+			{ID: "big-r3", Username: "r3", IsActive: true, TeamName: teamName},
+			{ID: "big-r4", Username: "r4", IsActive: true, TeamName: teamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:       "pr-big",
+		Name:     "Big PR",
+		AuthorID: authorID,
+		Status:   &models.Status{Name: "OPEN"},
+	})
+	require.NoError(t, err)
+	assert.Len(t, createdPR.Reviewers, 3)
+	assert.NotContains(t, createdPR.Reviewers, authorID)
+}
+
+func TestService_CreatePR_NotEnoughRequiredReviewers(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "strict-team"
+	required, maxReviewers := 2, 2
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{RequiredReviewers: &required, MaxReviewers: &maxReviewers})
+		return err
+	})
+	require.NoError(t, err)
+
+	authorID := "strict-author"
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+			{ID: "strict-r1", Username: "r1", IsActive: true, TeamName: teamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	_, err = env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:       "pr-strict",
+		Name:     "Strict PR",
+		AuthorID: authorID,
+		Status:   &models.Status{Name: "OPEN"},
+	})
+	require.Error(t, err)
+	var notFoundErr *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+
+	var count int
+	err = env.db.GetContext(env.ctx, &count, "SELECT COUNT(*) FROM pull_requests WHERE pr_id = $1", "pr-strict")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestService_CreatePR_FallsBackToBuddyTeam(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "tiny-team"
+	buddyTeamName := "buddy-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, buddyTeamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		return env.teamRepo.SetFallbackTeams(ctx, tx, teamName, []string{buddyTeamName})
+	})
+	require.NoError(t, err)
+
+	authorID := "tiny-author"
+	teammateID := "tiny-teammate"
+	buddyID := "buddy-1"
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+			{ID: teammateID, Username: "teammate", IsActive: true, TeamName: teamName},
+			{ID: buddyID, Username: "buddy", IsActive: true, TeamName: buddyTeamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:       "pr-tiny",
+		Name:     "Tiny PR",
+		AuthorID: authorID,
+		Status:   &models.Status{Name: "OPEN"},
+	})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{teammateID, buddyID}, createdPR.Reviewers)
+
+	storedPR, err := env.prRepo.GetPRByID(env.ctx, "pr-tiny")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []models.Reviewer{
+		{PullRequestID: "pr-tiny", ReviewerID: teammateID, SourceTeam: teamName},
+		{PullRequestID: "pr-tiny", ReviewerID: buddyID, SourceTeam: buddyTeamName},
+	}, storedPR.Assignments)
+}
+
+func TestService_CreatePR_DraftHasNoReviewers(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "draft-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "draft-author", Username: "author", IsActive: true, TeamName: teamName},
+			{ID: "draft-reviewer", Username: "reviewer", IsActive: true, TeamName: teamName},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:       "pr-draft",
+		Name:     "Draft PR",
+		AuthorID: "draft-author",
+		Status:   &models.Status{Name: models.StatusDraft},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusDraft, createdPR.Status.Name)
+	assert.Empty(t, createdPR.Reviewers)
+
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-draft")
+	require.NoError(t, err)
+	assert.Empty(t, reviewers)
+}
+
+func TestService_CreatePR_PicksCodeOwnersFirst(t *testing.T) {
+	env := setupTest(t)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "backend", models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "payments", models.TeamSettings{}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: "backend"},
+			{ID: "b1", Username: "b1", IsActive: true, TeamName: "backend"},
+			{ID: "b2", Username: "b2", IsActive: true, TeamName: "backend"},
+			{ID: "payer", Username: "payer", IsActive: true, TeamName: "payments"},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	_, err = env.teamRepo.SetCodeOwners(env.ctx, "backend", "/payments/ @acme/payments\n")
+	require.NoError(t, err)
+
+	createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:           "pr-pay",
+		Name:         "Payments PR",
+		AuthorID:     "author",
+		Status:       &models.Status{Name: "OPEN"},
+		ChangedPaths: []string{"payments/ledger.go", "cmd/main.go"},
+	})
+	require.NoError(t, err)
+	require.Len(t, createdPR.Assignments, 2)
+	assert.Equal(t, models.Reviewer{ReviewerID: "payer", SourceTeam: "payments"}, createdPR.Assignments[0])
+	assert.Contains(t, []string{"b1", "b2"}, createdPR.Assignments[1].ReviewerID)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-pay")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	reasons := map[string]string{}
+	for _, e := range events {
+		reasons[e.NewReviewerID] = e.Reason
+	}
+	assert.Equal(t, "code owner", reasons["payer"])
+}
+
+func TestService_CreatePR_PicksReviewersBySkills(t *testing.T) {
+	env := setupTest(t)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "backend", models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: "backend"},
+			{ID: "fe1", Username: "fe1", IsActive: true, TeamName: "backend"},
+			{ID: "fe2", Username: "fe2", IsActive: true, TeamName: "backend"},
+			{ID: "dba", Username: "dba", IsActive: true, TeamName: "backend"},
+		}); err != nil {
+			return err
+		}
+		if err := env.userRepo.SetUserSkills(ctx, tx, "fe1", []string{"frontend"}); err != nil {
+			return err
+		}
+		if err := env.userRepo.SetUserSkills(ctx, tx, "fe2", []string{"frontend"}); err != nil {
+			return err
+		}
+		return env.userRepo.SetUserSkills(ctx, tx, "dba", []string{"go", "sql"})
+	})
+	require.NoError(t, err)
+
+	createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:             "pr-sql",
+		Name:           "SQL migration",
+		AuthorID:       "author",
+		Status:         &models.Status{Name: "OPEN"},
+		RequiredSkills: []string{"SQL"},
+	})
+	require.NoError(t, err)
+	require.Len(t, createdPR.Assignments, 2)
+	assert.Equal(t, "dba", createdPR.Assignments[0].ReviewerID)
+	assert.Equal(t, []string{"sql"}, createdPR.Assignments[0].MatchedSkills)
+	assert.Empty(t, createdPR.Assignments[1].MatchedSkills)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-sql")
+	require.NoError(t, err)
+	reasons := map[string]string{}
+	for _, e := range events {
+		reasons[e.NewReviewerID] = e.Reason
+	}
+	assert.Equal(t, "required skills: sql", reasons["dba"])
+
+	t.Run("prefer mode falls back when nobody has the skill", func(t *testing.T) {
+		createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+			ID:             "pr-k8s",
+			Name:           "Helm chart",
+			AuthorID:       "author",
+			Status:         &models.Status{Name: "OPEN"},
+			RequiredSkills: []string{"k8s"},
+		})
+		require.NoError(t, err)
+		assert.Len(t, createdPR.Assignments, 2)
+	})
+
+	t.Run("require mode fails when nobody has the skill", func(t *testing.T) {
+		_, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+			ID:             "pr-k8s-required",
+			Name:           "Helm chart",
+			AuthorID:       "author",
+			Status:         &models.Status{Name: "OPEN"},
+			RequiredSkills: []string{"k8s"},
+			SkillMode:      models.SkillModeRequire,
+		})
+		var noCandidate *rpc_errors.NoCandidateError
+		require.ErrorAs(t, err, &noCandidate)
+	})
+}
+
+func TestService_CreatePR_RequestedReviewers(t *testing.T) {
+	env := setupTest(t)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "platform", models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "frontend", models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "backend", models.TeamSettings{}); err != nil {
+			return err
+		}
+		if err := env.teamRepo.SetFallbackTeams(ctx, tx, "backend", []string{"platform"}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: "backend"},
+			{ID: "b1", Username: "b1", IsActive: true, TeamName: "backend"},
+			{ID: "b2", Username: "b2", IsActive: true, TeamName: "backend"},
+			{ID: "gone", Username: "gone", IsActive: false, TeamName: "backend"},
+			{ID: "p1", Username: "p1", IsActive: true, TeamName: "platform"},
+			{ID: "f1", Username: "f1", IsActive: true, TeamName: "frontend"},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+		ID:                 "pr-requested",
+		Name:               "Requested",
+		AuthorID:           "author",
+		Status:             &models.Status{Name: "OPEN"},
+		RequestedReviewers: []string{"p1", "p1"},
+	})
+	require.NoError(t, err)
+	require.Len(t, createdPR.Assignments, 2)
+	assert.Equal(t, models.Reviewer{ReviewerID: "p1", SourceTeam: "platform"}, createdPR.Assignments[0])
+	assert.Equal(t, "backend", createdPR.Assignments[1].SourceTeam)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-requested")
+	require.NoError(t, err)
+	reasons := map[string]string{}
+	for _, e := range events {
+		reasons[e.NewReviewerID] = e.Reason
+	}
+	assert.Equal(t, "requested by author", reasons["p1"])
+	assert.Equal(t, "pull request created", reasons[createdPR.Assignments[1].ReviewerID])
+
+	t.Run("draft gets only the requested reviewers", func(t *testing.T) {
+		createdPR, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+			ID:                 "pr-requested-draft",
+			Name:               "Requested draft",
+			AuthorID:           "author",
+			Status:             &models.Status{Name: models.StatusDraft},
+			RequestedReviewers: []string{"b2"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"b2"}, createdPR.Reviewers)
+	})
+
+	for _, tc := range []struct {
+		name      string
+		requested []string
+		check     func(t *testing.T, err error)
+	}{
+		{"author", []string{"author"}, func(t *testing.T, err error) {
+			var badRequest *rpc_errors.BadRequestError
+			require.ErrorAs(t, err, &badRequest)
+		}},
+		{"inactive", []string{"gone"}, func(t *testing.T, err error) {
+			var badRequest *rpc_errors.BadRequestError
+			require.ErrorAs(t, err, &badRequest)
+			assert.Contains(t, err.Error(), "not active")
+		}},
+		{"outside of team and fallback teams", []string{"f1"}, func(t *testing.T, err error) {
+			var badRequest *rpc_errors.BadRequestError
+			require.ErrorAs(t, err, &badRequest)
+			assert.Contains(t, err.Error(), "fallback teams")
+		}},
+		{"too many", []string{"b1", "b2", "p1"}, func(t *testing.T, err error) {
+			var badRequest *rpc_errors.BadRequestError
+			require.ErrorAs(t, err, &badRequest)
+		}},
+		{"unknown", []string{"ghost"}, func(t *testing.T, err error) {
+			var notFound *rpc_errors.NotFoundError
+			require.ErrorAs(t, err, &notFound)
+		}},
+	} {
+		t.Run("rejects "+tc.name, func(t *testing.T) {
+			_, err := env.service.CreatePR(env.ctx, &models.PullRequest{
+				ID:                 "pr-rejected",
+				Name:               "Rejected",
+				AuthorID:           "author",
+				Status:             &models.Status{Name: "OPEN"},
+				RequestedReviewers: tc.requested,
+			})
+			tc.check(t, err)
+		})
+	}
+}
@@ -14,5 +14,7 @@ type userRepo interface {
 type teamRepo interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
 
-	CreateTeam(ctx context.Context, tx *sqlx.Tx, team models.Team) (models.Team, error)
+	CreateTeam(ctx context.Context, tx *sqlx.Tx, teamName string, settings models.TeamSettings) (models.Team, error)
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	SetFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbackTeams []string) error
 }
@@ -2,9 +2,14 @@ package create_team
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	team_repo "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 )
 
 type Service struct {
@@ -19,17 +24,47 @@ func New(teamRepo teamRepo, userRepo userRepo) *Service {
 	}
 }
 
-func (s *Service) CreateTeam(ctx context.Context, team *models.Team) (models.Team, error) {
+// CreateTeam creates the team with its members. Adding members to an existing
+// team upserts them; settings cannot be changed that way and are rejected.
+func (s *Service) CreateTeam(ctx context.Context, team *models.Team, settings models.TeamSettings) (models.Team, error) {
 	var createdTeam models.Team
 
-	err := s.teamRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		created, err := s.teamRepo.CreateTeam(ctx, tx, *team)
+	if err := validate(settings); err != nil {
+		return createdTeam, err
+	}
+
+	existing, err := s.teamRepo.FindTeamByID(ctx, team.TeamName)
+	if err != nil && !errors.Is(err, team_repo.ErrNotFound) {
+		return createdTeam, fmt.Errorf("find team: %w", err)
+	}
+	if err == nil {
+		if existing.ArchivedAt != nil {
+			return createdTeam, rpc_errors.NewTeamArchived("cannot add members to an archived team")
+		}
+		if settings != (models.TeamSettings{}) || len(team.FallbackTeams) > 0 {
+			return createdTeam, rpc_errors.NewTeamExists("team_name already exists, change its settings via /team/settings")
+		}
+	}
+
+	if err := reviewer_selection.ValidateFallbackTeams(ctx, s.teamRepo, team.TeamName, team.FallbackTeams); err != nil {
+		return createdTeam, err
+	}
+
+	err = s.teamRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		created, err := s.teamRepo.CreateTeam(ctx, tx, team.TeamName, settings)
 		if err != nil {
 			return err
 		}
 
 		createdTeam = created
 
+		if len(team.FallbackTeams) > 0 {
+			if err := s.teamRepo.SetFallbackTeams(ctx, tx, team.TeamName, team.FallbackTeams); err != nil {
+				return err
+			}
+			createdTeam.FallbackTeams = team.FallbackTeams
+		}
+
 		members, err := s.userRepo.UpsertUsers(ctx, tx, team.Members)
 		if err != nil {
 			return err
@@ -42,3 +77,37 @@ func (s *Service) CreateTeam(ctx context.Context, team *models.Team) (models.Tea
 
 	return createdTeam, err
 }
+
+func validate(settings models.TeamSettings) error {
+	if settings.AssignmentStrategy != nil && !reviewer_selection.IsKnownStrategy(*settings.AssignmentStrategy) {
+		return rpc_errors.NewBadRequest("unknown assignment strategy")
+	}
+
+	required, maxReviewers := reviewer_selection.DefaultRequiredReviewers, reviewer_selection.DefaultMaxReviewers
+	if settings.RequiredReviewers != nil {
+		required = *settings.RequiredReviewers
+	}
+	if settings.MaxReviewers != nil {
+		maxReviewers = *settings.MaxReviewers
+	}
+
+	if required < 0 || maxReviewers < 1 {
+		return rpc_errors.NewBadRequest("reviewer counts must be positive")
+	}
+	if required > maxReviewers {
+		return rpc_errors.NewBadRequest("required_reviewers cannot exceed max_reviewers")
+	}
+
+	if settings.RequiredApprovals != nil && (*settings.RequiredApprovals < 0 || *settings.RequiredApprovals > maxReviewers) {
+		return rpc_errors.NewBadRequest("required_approvals must be between 0 and max_reviewers")
+	}
+
+	if settings.MaxOpenReviews != nil && *settings.MaxOpenReviews < 0 {
+		return rpc_errors.NewBadRequest("max_open_reviews cannot be negative")
+	}
+	if settings.ReviewSLAHours != nil && *settings.ReviewSLAHours < 0 {
+		return rpc_errors.NewBadRequest("review_sla_hours cannot be negative")
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	"github.com/loloneme/potential-waffle/internal/usecase/create_team"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -63,7 +64,7 @@ func TestService_CreateTeam_Successful(t *testing.T) {
 		Members:  members,
 	}
 
-	createdTeam, err := env.service.CreateTeam(env.ctx, team)
+	createdTeam, err := env.service.CreateTeam(env.ctx, team, models.TeamSettings{})
 	require.NoError(t, err)
 
 	assert.Equal(t, teamName, createdTeam.TeamName)
@@ -95,7 +96,7 @@ func TestService_CreateTeam_EmptyMembers(t *testing.T) {
 		Members:  []models.User{},
 	}
 
-	createdTeam, err := env.service.CreateTeam(env.ctx, team)
+	createdTeam, err := env.service.CreateTeam(env.ctx, team, models.TeamSettings{})
 	require.NoError(t, err)
 
 	assert.Equal(t, teamName, createdTeam.TeamName)
@@ -119,7 +120,7 @@ func TestService_CreateTeam_TeamAlreadyExists(t *testing.T) {
 		Members:  members,
 	}
 
-	_, err := env.service.CreateTeam(env.ctx, team)
+	_, err := env.service.CreateTeam(env.ctx, team, models.TeamSettings{})
 	require.NoError(t, err)
 
 	newMembers := []models.User{
@@ -132,7 +133,7 @@ func TestService_CreateTeam_TeamAlreadyExists(t *testing.T) {
 		Members:  newMembers,
 	}
 
-	createdTeam, err := env.service.CreateTeam(env.ctx, team2)
+	createdTeam, err := env.service.CreateTeam(env.ctx, team2, models.TeamSettings{})
 	require.NoError(t, err)
 
 	assert.Equal(t, teamName, createdTeam.TeamName)
@@ -150,7 +151,7 @@ func TestService_CreateTeam_MultipleTeams(t *testing.T) {
 		},
 	}
 
-	createdTeam1, err := env.service.CreateTeam(env.ctx, team1)
+	createdTeam1, err := env.service.CreateTeam(env.ctx, team1, models.TeamSettings{})
 	require.NoError(t, err)
 	assert.Equal(t, team1Name, createdTeam1.TeamName)
 
@@ -162,7 +163,7 @@ func TestService_CreateTeam_MultipleTeams(t *testing.T) {
 		},
 	}
 
-	createdTeam2, err := env.service.CreateTeam(env.ctx, team2)
+	createdTeam2, err := env.service.CreateTeam(env.ctx, team2, models.TeamSettings{})
 	require.NoError(t, err)
 	assert.Equal(t, team2Name, createdTeam2.TeamName)
 
@@ -174,3 +175,146 @@ func TestService_CreateTeam_MultipleTeams(t *testing.T) {
 	require.NoError(t, err)
 	assert.True(t, exists2)
 }
+
+func TestService_CreateTeam_WithAssignmentStrategy(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "strategy-team"
+	team := &models.Team{
+		TeamName: teamName,
+		Members: []models.User{
+			{ID: "user-1", Username: "user1", IsActive: true, TeamName: teamName},
+		},
+	}
+
+	strategy := "round_robin"
+	createdTeam, err := env.service.CreateTeam(env.ctx, team, models.TeamSettings{AssignmentStrategy: &strategy})
+	require.NoError(t, err)
+	assert.Equal(t, "round_robin", createdTeam.AssignmentStrategy)
+
+	foundTeam, err := env.teamRepo.FindTeamByID(env.ctx, teamName)
+	require.NoError(t, err)
+	assert.Equal(t, "round_robin", foundTeam.AssignmentStrategy)
+}
+
+func TestService_CreateTeam_UnknownAssignmentStrategy(t *testing.T) {
+	env := setupTest(t)
+
+	strategy := "alphabetical"
+	_, err := env.service.CreateTeam(env.ctx, &models.Team{TeamName: "bad-strategy-team"}, models.TeamSettings{AssignmentStrategy: &strategy})
+	require.Error(t, err)
+	var badRequestErr *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestService_CreateTeam_RequiredExceedsMaxReviewers(t *testing.T) {
+	env := setupTest(t)
+
+	required, maxReviewers := 3, 2
+	_, err := env.service.CreateTeam(env.ctx, &models.Team{TeamName: "bad-counts-team"}, models.TeamSettings{
+		RequiredReviewers: &required,
+		MaxReviewers:      &maxReviewers,
+	})
+	require.Error(t, err)
+	var badRequestErr *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestService_CreateTeam_KeepsExplicitZeroSettings(t *testing.T) {
+	env := setupTest(t)
+
+	required, approvals := 0, 0
+	createdTeam, err := env.service.CreateTeam(env.ctx, &models.Team{TeamName: "optional-review-team"}, models.TeamSettings{
+		RequiredReviewers: &required,
+		RequiredApprovals: &approvals,
+	})
+	require.NoError(t, err)
+	assert.Zero(t, createdTeam.RequiredReviewers)
+	assert.Equal(t, 2, createdTeam.MaxReviewers)
+
+	foundTeam, err := env.teamRepo.FindTeamByID(env.ctx, "optional-review-team")
+	require.NoError(t, err)
+	assert.Zero(t, foundTeam.RequiredReviewers)
+}
+
+func TestService_CreateTeam_SettingsOfExistingTeam(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.CreateTeam(env.ctx, &models.Team{TeamName: "backend"}, models.TeamSettings{})
+	require.NoError(t, err)
+
+	maxReviewers := 3
+	_, err = env.service.CreateTeam(env.ctx, &models.Team{TeamName: "backend"}, models.TeamSettings{MaxReviewers: &maxReviewers})
+	require.Error(t, err)
+	var teamExistsErr *rpc_errors.TeamExistsError
+	assert.ErrorAs(t, err, &teamExistsErr)
+
+	foundTeam, err := env.teamRepo.FindTeamByID(env.ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, 2, foundTeam.MaxReviewers)
+}
+
+func TestService_CreateTeam_UnknownFallbackTeam(t *testing.T) {
+	env := setupTest(t)
+
+	team := &models.Team{
+		TeamName:      "orphan-team",
+		FallbackTeams: []string{"missing-team"},
+	}
+
+	_, err := env.service.CreateTeam(env.ctx, team, models.TeamSettings{})
+	require.Error(t, err)
+	var badRequestErr *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+
+	exists, err := env.teamRepo.Exists(env.ctx, "orphan-team")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func (env *testEnv) archiveTeam(t *testing.T, teamName string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.ArchiveTeam(ctx, tx, teamName)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_CreateTeam_ArchivedTeam(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.CreateTeam(env.ctx, &models.Team{TeamName: "legacy"}, models.TeamSettings{})
+	require.NoError(t, err)
+	env.archiveTeam(t, "legacy")
+
+	_, err = env.service.CreateTeam(env.ctx, &models.Team{
+		TeamName: "legacy",
+		Members: []models.User{
+			{ID: "user-1", Username: "user1", IsActive: true, TeamName: "legacy"},
+		},
+	}, models.TeamSettings{})
+	require.Error(t, err)
+	var archivedErr *rpc_errors.TeamArchivedError
+	assert.ErrorAs(t, err, &archivedErr)
+
+	_, err = env.userRepo.GetUserByID(env.ctx, "user-1")
+	assert.Error(t, err)
+}
+
+func TestService_CreateTeam_ArchivedFallbackTeam(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.CreateTeam(env.ctx, &models.Team{TeamName: "legacy"}, models.TeamSettings{})
+	require.NoError(t, err)
+	env.archiveTeam(t, "legacy")
+
+	_, err = env.service.CreateTeam(env.ctx, &models.Team{
+		TeamName:      "backend",
+		FallbackTeams: []string{"legacy"},
+	}, models.TeamSettings{})
+	require.Error(t, err)
+	var archivedErr *rpc_errors.TeamArchivedError
+	assert.ErrorAs(t, err, &archivedErr)
+}
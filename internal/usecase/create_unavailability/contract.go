@@ -0,0 +1,12 @@
+package create_unavailability
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type userRepo interface {
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
+	CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error)
+}
@@ -0,0 +1,50 @@
+package create_unavailability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	userRepo userRepo
+}
+
+func New(userRepo userRepo) *Service {
+	return &Service{
+		userRepo: userRepo,
+	}
+}
+
+// CreateUnavailability registers a period during which the user is not picked
+// as a reviewer. The period may have started already but must not be over.
+func (s *Service) CreateUnavailability(ctx context.Context, u models.Unavailability) (models.Unavailability, error) {
+	u.StartsAt = u.StartsAt.UTC()
+	u.EndsAt = u.EndsAt.UTC()
+
+	if !u.EndsAt.After(u.StartsAt) {
+		return models.Unavailability{}, rpc_errors.NewBadRequest("ends_at must be after starts_at")
+	}
+	if !u.EndsAt.After(time.Now()) {
+		return models.Unavailability{}, rpc_errors.NewBadRequest("unavailability period is already over")
+	}
+
+	if _, err := s.userRepo.GetUserByID(ctx, u.UserID); err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return models.Unavailability{}, rpc_errors.NewNotFound("user not found")
+		}
+		return models.Unavailability{}, fmt.Errorf("get user: %w", err)
+	}
+
+	created, err := s.userRepo.CreateUnavailability(ctx, u)
+	if err != nil {
+		return models.Unavailability{}, fmt.Errorf("create unavailability: %w", err)
+	}
+
+	return created, nil
+}
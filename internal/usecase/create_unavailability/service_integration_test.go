@@ -0,0 +1,136 @@
+package create_unavailability_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/create_unavailability"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	teamRepo *team.Repository
+	service  *create_unavailability.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := create_unavailability.New(userRepo)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, user_unavailability RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_CreateUnavailability_Successful(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	startsAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	endsAt := startsAt.Add(7 * 24 * time.Hour)
+
+	created, err := env.service.CreateUnavailability(env.ctx, models.Unavailability{
+		UserID:          "u1",
+		StartsAt:        startsAt.In(time.FixedZone("MSK", 3*60*60)),
+		EndsAt:          endsAt,
+		Reason:          "vacation",
+		ReassignReviews: true,
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, created.ID)
+	assert.True(t, created.StartsAt.Equal(startsAt))
+	assert.True(t, created.EndsAt.Equal(endsAt))
+	assert.Nil(t, created.ReassignedAt)
+
+	periods, err := env.userRepo.ListUnavailability(env.ctx, "u1")
+	require.NoError(t, err)
+	require.Len(t, periods, 1)
+	assert.Equal(t, created.ID, periods[0].ID)
+	assert.Equal(t, "vacation", periods[0].Reason)
+	assert.True(t, periods[0].ReassignReviews)
+}
+
+func TestService_CreateUnavailability_Validation(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		startsAt time.Time
+		endsAt   time.Time
+	}{
+		{name: "ends before start", startsAt: now.Add(48 * time.Hour), endsAt: now.Add(24 * time.Hour)},
+		{name: "empty period", startsAt: now.Add(24 * time.Hour), endsAt: now.Add(24 * time.Hour)},
+		{name: "already over", startsAt: now.Add(-48 * time.Hour), endsAt: now.Add(-24 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := env.service.CreateUnavailability(env.ctx, models.Unavailability{
+				UserID:   "u1",
+				StartsAt: tt.startsAt,
+				EndsAt:   tt.endsAt,
+			})
+			var badRequest *rpc_errors.BadRequestError
+			assert.ErrorAs(t, err, &badRequest)
+		})
+	}
+}
+
+func TestService_CreateUnavailability_UserNotFound(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.CreateUnavailability(env.ctx, models.Unavailability{
+		UserID:   "ghost",
+		StartsAt: time.Now(),
+		EndsAt:   time.Now().Add(time.Hour),
+	})
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
@@ -0,0 +1,18 @@
+package create_webhook
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type webhookRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	CreateSubscription(ctx context.Context, tx *sqlx.Tx, sub models.WebhookSubscription) (models.WebhookSubscription, error)
+}
+
+type teamRepo interface {
+	Exists(ctx context.Context, teamName string) (bool, error)
+}
@@ -0,0 +1,80 @@
+package create_webhook
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+var knownEventTypes = []string{
+	models.AssignmentEventAssign,
+	models.AssignmentEventUnassign,
+	models.AssignmentEventReassign,
+	models.AssignmentEventDeactivationReassign,
+	models.AssignmentEventMerge,
+}
+
+type Service struct {
+	webhookRepo webhookRepo
+	teamRepo    teamRepo
+}
+
+func New(webhookRepo webhookRepo, teamRepo teamRepo) *Service {
+	return &Service{
+		webhookRepo: webhookRepo,
+		teamRepo:    teamRepo,
+	}
+}
+
+func (s *Service) CreateWebhook(ctx context.Context, sub models.WebhookSubscription) (models.WebhookSubscription, error) {
+	if err := validate(sub); err != nil {
+		return models.WebhookSubscription{}, err
+	}
+
+	if sub.TeamName != "" {
+		exists, err := s.teamRepo.Exists(ctx, sub.TeamName)
+		if err != nil {
+			return models.WebhookSubscription{}, fmt.Errorf("check team exists: %w", err)
+		}
+		if !exists {
+			return models.WebhookSubscription{}, rpc_errors.NewNotFound("team not found")
+		}
+	}
+
+	slices.Sort(sub.EventTypes)
+	sub.EventTypes = slices.Compact(sub.EventTypes)
+
+	var created models.WebhookSubscription
+	err := s.webhookRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		var err error
+		created, err = s.webhookRepo.CreateSubscription(ctx, tx, sub)
+		return err
+	})
+	if err != nil {
+		return models.WebhookSubscription{}, fmt.Errorf("create webhook subscription: %w", err)
+	}
+
+	return created, nil
+}
+
+func validate(sub models.WebhookSubscription) error {
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return rpc_errors.NewBadRequest("url must be an absolute http or https URL")
+	}
+	if sub.Secret == "" {
+		return rpc_errors.NewBadRequest("secret must not be empty")
+	}
+	for _, eventType := range sub.EventTypes {
+		if !slices.Contains(knownEventTypes, eventType) {
+			return rpc_errors.NewBadRequest(fmt.Sprintf("unknown event type %q", eventType))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,188 @@
+package create_webhook_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/webhook"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/create_webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo    *user.Repository
+	prRepo      *pull_request.Repository
+	teamRepo    *team.Repository
+	webhookRepo *webhook.Repository
+	service     *create_webhook.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	webhookRepo := webhook.NewRepository(db)
+	service := create_webhook.New(webhookRepo, teamRepo)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, webhook_subscriptions RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:         ctx,
+		db:          db,
+		userRepo:    userRepo,
+		prRepo:      prRepo,
+		teamRepo:    teamRepo,
+		webhookRepo: webhookRepo,
+		service:     service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_CreateWebhook_Successful(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	sub, err := env.service.CreateWebhook(env.ctx, models.WebhookSubscription{
+		URL:        "https://example.com/hook",
+		Secret:     "s3cret",
+		TeamName:   "backend",
+		EventTypes: []string{models.AssignmentEventReassign, models.AssignmentEventAssign, models.AssignmentEventAssign},
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, sub.ID)
+	assert.Equal(t, []string{models.AssignmentEventAssign, models.AssignmentEventReassign}, sub.EventTypes)
+
+	subs, err := env.webhookRepo.ListSubscriptions(env.ctx)
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, "backend", subs[0].TeamName)
+	assert.Equal(t, []string{models.AssignmentEventAssign, models.AssignmentEventReassign}, subs[0].EventTypes)
+}
+
+func TestService_CreateWebhook_Validation(t *testing.T) {
+	env := setupTest(t)
+
+	tests := map[string]models.WebhookSubscription{
+		"relative url":       {URL: "/hook", Secret: "s3cret"},
+		"unsupported scheme": {URL: "ftp://example.com/hook", Secret: "s3cret"},
+		"empty secret":       {URL: "https://example.com/hook"},
+		"unknown event type": {URL: "https://example.com/hook", Secret: "s3cret", EventTypes: []string{"CLOSE"}},
+	}
+	for name, sub := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := env.service.CreateWebhook(env.ctx, sub)
+			var badRequest *rpc_errors.BadRequestError
+			assert.ErrorAs(t, err, &badRequest)
+		})
+	}
+}
+
+func TestService_CreateWebhook_TeamNotFound(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.CreateWebhook(env.ctx, models.WebhookSubscription{
+		URL:      "https://example.com/hook",
+		Secret:   "s3cret",
+		TeamName: "missing",
+	})
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
+
+func TestService_CreateWebhook_EventsAreQueuedForMatchingSubscriptions(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "reviewer")
+	env.seedTeam(t, "frontend", "other")
+
+	matching := []models.WebhookSubscription{
+		{URL: "https://example.com/all", Secret: "s3cret"},
+		{URL: "https://example.com/backend", Secret: "s3cret", TeamName: "backend", EventTypes: []string{models.AssignmentEventAssign}},
+	}
+	skipped := []models.WebhookSubscription{
+		{URL: "https://example.com/frontend", Secret: "s3cret", TeamName: "frontend"},
+		{URL: "https://example.com/merges", Secret: "s3cret", EventTypes: []string{models.AssignmentEventMerge}},
+	}
+	for _, sub := range append(matching, skipped...) {
+		_, err := env.service.CreateWebhook(env.ctx, sub)
+		require.NoError(t, err)
+	}
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		openStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       "pr-1",
+			Name:     "Webhook PR",
+			AuthorID: "author",
+			StatusID: openStatus.ID,
+		}); err != nil {
+			return err
+		}
+		return env.prRepo.InsertAssignmentEvents(ctx, tx, []models.AssignmentEvent{{
+			PullRequestID: "pr-1",
+			Type:          models.AssignmentEventAssign,
+			NewReviewerID: "reviewer",
+			Actor:         "author",
+			Reason:        "pull request created",
+		}})
+	})
+	require.NoError(t, err)
+
+	deliveries, err := env.webhookRepo.ClaimDeliveries(env.ctx, 10, 5, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, deliveries, len(matching))
+
+	urls := make([]string, len(deliveries))
+	for i, d := range deliveries {
+		urls[i] = d.URL
+		assert.Equal(t, "backend", d.TeamName)
+		assert.Equal(t, "pr-1", d.PullRequestID)
+		assert.Equal(t, "reviewer", d.NewReviewerID)
+	}
+	assert.ElementsMatch(t, []string{"https://example.com/all", "https://example.com/backend"}, urls)
+
+	claimedAgain, err := env.webhookRepo.ClaimDeliveries(env.ctx, 10, 5, time.Minute)
+	require.NoError(t, err)
+	assert.Empty(t, claimedAgain, "claimed deliveries are leased")
+}
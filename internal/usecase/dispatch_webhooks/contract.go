@@ -0,0 +1,14 @@
+package dispatch_webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type webhookRepo interface {
+	ClaimDeliveries(ctx context.Context, limit, maxAttempts int, lease time.Duration) ([]models.WebhookDelivery, error)
+	MarkDelivered(ctx context.Context, deliveryID int64) error
+	MarkFailed(ctx context.Context, deliveryID int64, retryAfter time.Duration, lastError string) error
+}
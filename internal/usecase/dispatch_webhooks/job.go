@@ -0,0 +1,35 @@
+package dispatch_webhooks
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/worker"
+)
+
+type Config struct {
+	Interval    time.Duration `env:"WEBHOOK_DISPATCH_INTERVAL" envDefault:"5s"`
+	Timeout     time.Duration `env:"WEBHOOK_TIMEOUT" envDefault:"10s"`
+	BatchSize   int           `env:"WEBHOOK_BATCH_SIZE" envDefault:"50"`
+	MaxAttempts int           `env:"WEBHOOK_MAX_ATTEMPTS" envDefault:"10"`
+	RetryBase   time.Duration `env:"WEBHOOK_RETRY_BASE" envDefault:"10s"`
+	RetryMax    time.Duration `env:"WEBHOOK_RETRY_MAX" envDefault:"1h"`
+}
+
+func LoadConfig() (*Config, error) {
+	cfg := &Config{}
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Job delivers the pending webhooks.
+func (s *Service) Job() worker.Job {
+	return worker.Job{
+		Name:   "dispatch webhooks",
+		Report: "delivered %d webhooks",
+		Run:    s.Dispatch,
+	}
+}
@@ -0,0 +1,150 @@
+package dispatch_webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+type Payload struct {
+	DeliveryID    int64     `json:"delivery_id"`
+	EventID       int64     `json:"event_id"`
+	EventType     string    `json:"event_type"`
+	PullRequestID string    `json:"pull_request_id"`
+	TeamName      string    `json:"team_name"`
+	OldReviewerID string    `json:"old_reviewer_id,omitempty"`
+	NewReviewerID string    `json:"new_reviewer_id,omitempty"`
+	Actor         string    `json:"actor,omitempty"`
+	Reason        string    `json:"reason"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+type Service struct {
+	webhookRepo webhookRepo
+	client      *http.Client
+	cfg         Config
+}
+
+func New(webhookRepo webhookRepo, client *http.Client, cfg Config) *Service {
+	return &Service{
+		webhookRepo: webhookRepo,
+		client:      client,
+		cfg:         cfg,
+	}
+}
+
+// Dispatch sends one batch of due deliveries and returns how many succeeded.
+// Failed deliveries are rescheduled with exponential backoff.
+//
+// The claim lease covers every delivery of the batch timing out in turn. If
+// the batch still runs late, the deliveries that could not finish before the
+// lease expires are left unsent: another dispatcher may already have claimed
+// them again.
+func (s *Service) Dispatch(ctx context.Context) (int, error) {
+	lease := time.Duration(s.cfg.BatchSize)*s.cfg.Timeout + s.cfg.RetryBase
+	deadline := time.Now().Add(lease)
+	deliveries, err := s.webhookRepo.ClaimDeliveries(ctx, s.cfg.BatchSize, s.cfg.MaxAttempts, lease)
+	if err != nil {
+		return 0, fmt.Errorf("claim webhook deliveries: %w", err)
+	}
+
+	delivered := 0
+	for _, d := range deliveries {
+		if time.Now().Add(s.cfg.Timeout).After(deadline) {
+			break
+		}
+
+		if err := s.send(ctx, d); err != nil {
+			retryAfter := Backoff(s.cfg.RetryBase, s.cfg.RetryMax, d.Attempts)
+			if err := s.webhookRepo.MarkFailed(ctx, d.ID, retryAfter, err.Error()); err != nil {
+				return delivered, fmt.Errorf("mark webhook delivery failed: %w", err)
+			}
+			continue
+		}
+
+		if err := s.webhookRepo.MarkDelivered(ctx, d.ID); err != nil {
+			return delivered, fmt.Errorf("mark webhook delivered: %w", err)
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
+
+func (s *Service) send(ctx context.Context, d models.WebhookDelivery) error {
+	body, err := json.Marshal(Payload{
+		DeliveryID:    d.ID,
+		EventID:       d.AssignmentEvent.ID,
+		EventType:     d.Type,
+		PullRequestID: d.PullRequestID,
+		TeamName:      d.TeamName,
+		OldReviewerID: d.OldReviewerID,
+		NewReviewerID: d.NewReviewerID,
+		Actor:         d.Actor,
+		Reason:        d.Reason,
+		CreatedAt:     d.CreatedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, Sign(d.Secret, body))
+	req.Header.Set(EventHeader, d.Type)
+	req.Header.Set(DeliveryHeader, strconv.FormatInt(d.ID, 10))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the X-Webhook-Signature value for body: "sha256=" followed by
+// the hex-encoded HMAC-SHA256 of body keyed with secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns base * 2^attempts, capped at max.
+func Backoff(base, max time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 0; i < attempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return min(delay, max)
+}
@@ -0,0 +1,138 @@
+package dispatch_webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWebhookRepo struct {
+	deliveries []models.WebhookDelivery
+	delivered  []int64
+	failed     map[int64]time.Duration
+	lease      time.Duration
+	// markDelay slows MarkDelivered down, standing in for a slow database.
+	markDelay time.Duration
+}
+
+func (r *fakeWebhookRepo) ClaimDeliveries(_ context.Context, _, _ int, lease time.Duration) ([]models.WebhookDelivery, error) {
+	r.lease = lease
+	return r.deliveries, nil
+}
+
+func (r *fakeWebhookRepo) MarkDelivered(_ context.Context, deliveryID int64) error {
+	time.Sleep(r.markDelay)
+	r.delivered = append(r.delivered, deliveryID)
+	return nil
+}
+
+func (r *fakeWebhookRepo) MarkFailed(_ context.Context, deliveryID int64, retryAfter time.Duration, _ string) error {
+	r.failed[deliveryID] = retryAfter
+	return nil
+}
+
+func TestService_Dispatch(t *testing.T) {
+	var received []*http.Request
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = append(received, r)
+		bodies = append(bodies, body)
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	event := models.AssignmentEvent{
+		ID:            7,
+		PullRequestID: "pr-1",
+		Type:          models.AssignmentEventAssign,
+		NewReviewerID: "u2",
+		Actor:         "u1",
+		Reason:        "pull request created",
+	}
+	repo := &fakeWebhookRepo{
+		deliveries: []models.WebhookDelivery{
+			{ID: 1, URL: server.URL + "/ok", Secret: "s3cret", TeamName: "backend", AssignmentEvent: event},
+			{ID: 2, Attempts: 2, URL: server.URL + "/fail", Secret: "s3cret", TeamName: "backend", AssignmentEvent: event},
+		},
+		failed: make(map[int64]time.Duration),
+	}
+	cfg := Config{Timeout: time.Second, BatchSize: 10, MaxAttempts: 5, RetryBase: 10 * time.Second, RetryMax: time.Hour}
+
+	delivered, err := New(repo, server.Client(), cfg).Dispatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, []int64{1}, repo.delivered)
+	assert.Equal(t, map[int64]time.Duration{2: 40 * time.Second}, repo.failed)
+
+	require.Len(t, received, 2)
+	assert.Equal(t, Sign("s3cret", bodies[0]), received[0].Header.Get(SignatureHeader))
+	assert.Equal(t, models.AssignmentEventAssign, received[0].Header.Get(EventHeader))
+	assert.Equal(t, "1", received[0].Header.Get(DeliveryHeader))
+
+	var payload Payload
+	require.NoError(t, json.Unmarshal(bodies[0], &payload))
+	assert.Equal(t, int64(7), payload.EventID)
+	assert.Equal(t, "pr-1", payload.PullRequestID)
+	assert.Equal(t, "backend", payload.TeamName)
+	assert.Equal(t, "u2", payload.NewReviewerID)
+}
+
+func TestService_Dispatch_BatchOutlivesLease(t *testing.T) {
+	var received []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = append(received, r.Header.Get(DeliveryHeader))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	repo := &fakeWebhookRepo{
+		deliveries: []models.WebhookDelivery{
+			{ID: 1, URL: server.URL, Secret: "s3cret"},
+			{ID: 2, URL: server.URL, Secret: "s3cret"},
+		},
+		failed:    make(map[int64]time.Duration),
+		markDelay: 80 * time.Millisecond,
+	}
+	cfg := Config{Timeout: 50 * time.Millisecond, BatchSize: 2, MaxAttempts: 5, RetryBase: 10 * time.Millisecond, RetryMax: time.Second}
+
+	delivered, err := New(repo, server.Client(), cfg).Dispatch(context.Background())
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, repo.lease, time.Duration(cfg.BatchSize)*cfg.Timeout)
+
+	// Sending the second delivery could outlast the lease, so it is left for
+	// whichever dispatcher claims it next instead of being sent twice.
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, []int64{1}, repo.delivered)
+	assert.Empty(t, repo.failed)
+	assert.Equal(t, []string{"1"}, received)
+}
+
+func TestSign(t *testing.T) {
+	assert.Equal(t,
+		"sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8",
+		Sign("key", []byte("The quick brown fox jumps over the lazy dog")),
+	)
+}
+
+func TestBackoff(t *testing.T) {
+	base, max := 10*time.Second, time.Minute
+
+	assert.Equal(t, 10*time.Second, Backoff(base, max, 0))
+	assert.Equal(t, 20*time.Second, Backoff(base, max, 1))
+	assert.Equal(t, 40*time.Second, Backoff(base, max, 2))
+	assert.Equal(t, time.Minute, Backoff(base, max, 3))
+	assert.Equal(t, time.Minute, Backoff(base, max, 50))
+}
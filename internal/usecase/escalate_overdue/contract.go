@@ -0,0 +1,27 @@
+package escalate_overdue
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type prRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	GetOverdueReviews(ctx context.Context, teamName string, unescalated bool) ([]models.OverdueReview, error)
+	MarkReviewEscalated(ctx context.Context, tx *sqlx.Tx, prID, reviewerID string) (bool, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+	InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []models.Reviewer) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type reviewerSelector interface {
+	TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+}
+
+type reassigner interface {
+	ReassignOverdue(ctx context.Context, prID, oldReviewerID, actor string) (models.PullRequest, string, error)
+}
@@ -0,0 +1,35 @@
+package escalate_overdue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/worker"
+)
+
+type Config struct {
+	Interval time.Duration `env:"OVERDUE_ESCALATION_INTERVAL" envDefault:"5m"`
+	Action   string        `env:"OVERDUE_ESCALATION_ACTION" envDefault:"none"`
+}
+
+func LoadConfig() (*Config, error) {
+	cfg := &Config{}
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+	if !IsKnownAction(cfg.Action) {
+		return nil, fmt.Errorf("unknown overdue escalation action %q", cfg.Action)
+	}
+
+	return cfg, nil
+}
+
+// Job escalates the overdue reviews.
+func (s *Service) Job() worker.Job {
+	return worker.Job{
+		Name:   "escalate overdue reviews",
+		Report: "escalated %d overdue reviews",
+		Run:    s.Escalate,
+	}
+}
@@ -0,0 +1,208 @@
+package escalate_overdue
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+// Escalation actions taken on top of flagging an overdue review.
+const (
+	ActionNone        = "none"
+	ActionAddReviewer = "add_reviewer"
+	ActionReassign    = "reassign"
+)
+
+const (
+	escalationReason = "review overdue"
+	// systemActor is recorded as the actor of the escalation assignments.
+	systemActor = "system:escalation"
+)
+
+func IsKnownAction(action string) bool {
+	switch action {
+	case ActionNone, ActionAddReviewer, ActionReassign:
+		return true
+	}
+	return false
+}
+
+type Service struct {
+	prRepo     prRepo
+	selector   reviewerSelector
+	reassigner reassigner
+	action     string
+}
+
+func New(prRepo prRepo, selector reviewerSelector, reassigner reassigner, action string) *Service {
+	return &Service{
+		prRepo:     prRepo,
+		selector:   selector,
+		reassigner: reassigner,
+		action:     action,
+	}
+}
+
+// ListOverdue returns the current overdue reviews of teamName's pull requests,
+// or of all teams when teamName is empty, including the escalated ones.
+func (s *Service) ListOverdue(ctx context.Context, teamName string) ([]models.OverdueReview, error) {
+	reviews, err := s.prRepo.GetOverdueReviews(ctx, teamName, false)
+	if err != nil {
+		return nil, fmt.Errorf("get overdue reviews: %w", err)
+	}
+	return reviews, nil
+}
+
+// Escalate flags every overdue review once and applies the configured action:
+// a second reviewer is added to the PR or the reviewer is reassigned. Reviews
+// nobody can take over stay flagged only. A review that fails to be escalated
+// does not stop the others: the number of escalated reviews is returned
+// together with the joined errors.
+func (s *Service) Escalate(ctx context.Context) (int, error) {
+	reviews, err := s.prRepo.GetOverdueReviews(ctx, "", true)
+	if err != nil {
+		return 0, fmt.Errorf("get overdue reviews: %w", err)
+	}
+
+	total := 0
+	pools := make(map[string]*reviewer_selection.Pool)
+	var errs []error
+	for _, review := range reviews {
+		var action string
+		switch s.action {
+		case ActionAddReviewer:
+			action, err = s.addReviewer(ctx, review, pools)
+		case ActionReassign:
+			action, err = s.reassign(ctx, review)
+		default:
+			action, err = s.flag(ctx, review)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("escalate review of %s on %s: %w", review.ReviewerID, review.PullRequestID, err))
+			continue
+		}
+		if action == "" {
+			continue
+		}
+
+		metrics.OverdueEscalations.WithLabelValues(action).Inc()
+		total++
+	}
+
+	return total, errors.Join(errs...)
+}
+
+// The escalation methods return the action taken, or an empty string when the
+// review had already been flagged by another instance of the service.
+
+func (s *Service) flag(ctx context.Context, review models.OverdueReview) (string, error) {
+	var claimed bool
+	err := s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		var err error
+		claimed, err = s.prRepo.MarkReviewEscalated(ctx, tx, review.PullRequestID, review.ReviewerID)
+		return err
+	})
+	if err != nil || !claimed {
+		return "", err
+	}
+	return ActionNone, nil
+}
+
+func (s *Service) addReviewer(ctx context.Context, review models.OverdueReview, pools map[string]*reviewer_selection.Pool) (string, error) {
+	pool, ok := pools[review.TeamName]
+	if !ok {
+		var err error
+		pool, err = s.selector.TeamPool(ctx, review.TeamName, nil)
+		if err != nil {
+			return "", fmt.Errorf("get team reviewer pool: %w", err)
+		}
+		pools[review.TeamName] = pool
+	}
+
+	var (
+		claimed bool
+		added   []models.Reviewer
+	)
+	err := s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		// Re-read the PR under the row lock so a concurrent status or reviewer
+		// change wins.
+		locked, err := s.prRepo.GetPRByIDForUpdate(ctx, tx, review.PullRequestID)
+		if err != nil {
+			if errors.Is(err, pull_request.ErrPRNotFound) {
+				return nil
+			}
+			return fmt.Errorf("lock PR: %w", err)
+		}
+		if !pr_lifecycle.IsReviewable(locked.Status.Name) {
+			return nil
+		}
+
+		claimed, err = s.prRepo.MarkReviewEscalated(ctx, tx, review.PullRequestID, review.ReviewerID)
+		if err != nil {
+			return fmt.Errorf("mark escalated: %w", err)
+		}
+		if !claimed {
+			return nil
+		}
+
+		// PRs are normally filled up to max_reviewers already, so escalation
+		// may go one reviewer beyond it, but only once per PR.
+		if _, maxReviewers := pool.Quota(); len(locked.Reviewers) > maxReviewers {
+			return nil
+		}
+		excludeIDs := append([]string{locked.AuthorID}, locked.Reviewers...)
+		added = pool.Pick(excludeIDs, 1)
+		if len(added) == 0 {
+			return nil
+		}
+
+		if err := s.prRepo.InsertReviewers(ctx, tx, review.PullRequestID, added); err != nil {
+			return fmt.Errorf("insert reviewers: %w", err)
+		}
+		return s.prRepo.InsertAssignmentEvents(ctx, tx, reviewer_selection.AssignEvents(review.PullRequestID, added, systemActor, escalationReason))
+	})
+	if err != nil || !claimed {
+		return "", err
+	}
+
+	if len(added) == 0 {
+		return ActionNone, nil
+	}
+	return ActionAddReviewer, nil
+}
+
+// reassign hands the review over to another reviewer. The review is flagged
+// only when the reassignment was refused, so that a failed attempt is retried
+// on the next run. A successful one removes the assignment, and with it the
+// need for the flag.
+func (s *Service) reassign(ctx context.Context, review models.OverdueReview) (string, error) {
+	if _, _, err := s.reassigner.ReassignOverdue(ctx, review.PullRequestID, review.ReviewerID, systemActor); err != nil {
+		if isRejected(err) {
+			return s.flag(ctx, review)
+		}
+		return "", fmt.Errorf("reassign reviewer: %w", err)
+	}
+	return ActionReassign, nil
+}
+
+// isRejected reports whether the reassignment was refused by the domain rules,
+// e.g. there is no candidate or the PR was merged in the meantime.
+func isRejected(err error) bool {
+	var (
+		notFound    *rpc_errors.NotFoundError
+		merged      *rpc_errors.PRMergedError
+		closed      *rpc_errors.PRClosedError
+		notAssigned *rpc_errors.NotAssignedError
+		noCandidate *rpc_errors.NoCandidateError
+	)
+	return errors.As(err, &notFound) || errors.As(err, &merged) || errors.As(err, &closed) ||
+		errors.As(err, &notAssigned) || errors.As(err, &noCandidate)
+}
@@ -0,0 +1,251 @@
+package escalate_overdue_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	"github.com/loloneme/potential-waffle/internal/usecase/escalate_overdue"
+	"github.com/loloneme/potential-waffle/internal/usecase/reassign_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: user.NewRepository(db),
+		prRepo:   pull_request.NewRepository(db),
+		teamRepo: team.NewRepository(db),
+	}
+}
+
+func (env *testEnv) newService(action string) *escalate_overdue.Service {
+	selector := reviewer_selection.New(env.teamRepo, env.prRepo)
+	return escalate_overdue.New(env.prRepo, selector, reassign_pr.New(env.userRepo, env.prRepo, selector), action)
+}
+
+// seedTeam creates a team with the given review SLA and an author with three
+// teammates.
+func (env *testEnv) seedTeam(t *testing.T, teamName string, slaHours int) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{ReviewSLAHours: &slaHours}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: teamName + "-author", Username: "author", IsActive: true, TeamName: teamName},
+			{ID: teamName + "-slow", Username: "slow", IsActive: true, TeamName: teamName},
+			{ID: teamName + "-quick", Username: "quick", IsActive: true, TeamName: teamName},
+			{ID: teamName + "-spare", Username: "spare", IsActive: true, TeamName: teamName},
+		})
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) insertPR(t *testing.T, prID, authorID string, reviewerIDs ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		reviewers := make([]models.Reviewer, len(reviewerIDs))
+		for i, id := range reviewerIDs {
+			reviewers[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
+	})
+	require.NoError(t, err)
+}
+
+// age moves the assignment back in time so that it is past a one hour SLA.
+func (env *testEnv) age(t *testing.T, prID, reviewerID string) {
+	t.Helper()
+
+	_, err := env.db.ExecContext(env.ctx,
+		"UPDATE reviewers SET assigned_at = assigned_at - INTERVAL '2 hours' WHERE pr_id = $1 AND reviewer_id = $2",
+		prID, reviewerID)
+	require.NoError(t, err)
+}
+
+func TestService_Escalate_FlagsOverdueReviews(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", 1)
+	env.seedTeam(t, "relaxed", 0)
+
+	env.insertPR(t, "pr-1", "backend-author", "backend-slow", "backend-quick")
+	env.age(t, "pr-1", "backend-slow")
+	env.age(t, "pr-1", "backend-quick")
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		return env.prRepo.SetReviewState(ctx, tx, "pr-1", "backend-quick", models.ReviewStateApproved)
+	})
+	require.NoError(t, err)
+
+	env.insertPR(t, "pr-2", "relaxed-author", "relaxed-slow")
+	env.age(t, "pr-2", "relaxed-slow")
+
+	service := env.newService(escalate_overdue.ActionNone)
+
+	overdue, err := service.ListOverdue(env.ctx, "")
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, "pr-1", overdue[0].PullRequestID)
+	assert.Equal(t, "backend-slow", overdue[0].ReviewerID)
+	assert.Equal(t, "backend", overdue[0].TeamName)
+	assert.Equal(t, 1, overdue[0].SLAHours)
+	assert.Equal(t, overdue[0].AssignedAt.Add(time.Hour), overdue[0].DueAt)
+	assert.Nil(t, overdue[0].EscalatedAt)
+
+	escalated, err := service.Escalate(env.ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, escalated)
+
+	overdue, err = service.ListOverdue(env.ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.NotNil(t, overdue[0].EscalatedAt)
+
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"backend-slow", "backend-quick"}, reviewers)
+
+	escalated, err = service.Escalate(env.ctx)
+	require.NoError(t, err)
+	assert.Zero(t, escalated)
+}
+
+func TestService_Escalate_AddsReviewer(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", 1)
+
+	// The PR is already filled up to max_reviewers.
+	env.insertPR(t, "pr-1", "backend-author", "backend-slow", "backend-quick")
+	env.age(t, "pr-1", "backend-slow")
+
+	escalated, err := env.newService(escalate_overdue.ActionAddReviewer).Escalate(env.ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, escalated)
+
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"backend-slow", "backend-quick", "backend-spare"}, reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventAssign, events[0].Type)
+	assert.Equal(t, "backend-spare", events[0].NewReviewerID)
+	assert.Equal(t, "system:escalation", events[0].Actor)
+	assert.Equal(t, "review overdue", events[0].Reason)
+}
+
+func TestService_Escalate_AddReviewerExceedsMaxReviewersOnce(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", 1)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "backend-extra", Username: "extra", IsActive: true, TeamName: "backend"},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	env.insertPR(t, "pr-1", "backend-author", "backend-slow", "backend-quick")
+	env.age(t, "pr-1", "backend-slow")
+	env.age(t, "pr-1", "backend-quick")
+
+	service := env.newService(escalate_overdue.ActionAddReviewer)
+
+	escalated, err := service.Escalate(env.ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, escalated)
+
+	// Only the first overdue review adds a reviewer, the second one is flagged.
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, reviewers, 3)
+	assert.Subset(t, reviewers, []string{"backend-slow", "backend-quick"})
+
+	overdue, err := service.ListOverdue(env.ctx, "")
+	require.NoError(t, err)
+	require.Len(t, overdue, 2)
+	for _, review := range overdue {
+		assert.NotNil(t, review.EscalatedAt)
+	}
+}
+
+func TestService_Escalate_ReassignsReviewer(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", 1)
+
+	env.insertPR(t, "pr-1", "backend-author", "backend-slow", "backend-quick")
+	env.age(t, "pr-1", "backend-slow")
+
+	service := env.newService(escalate_overdue.ActionReassign)
+
+	escalated, err := service.Escalate(env.ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, escalated)
+
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"backend-quick", "backend-spare"}, reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventReassign, events[0].Type)
+	assert.Equal(t, "backend-slow", events[0].OldReviewerID)
+	assert.Equal(t, "system:escalation", events[0].Actor)
+	assert.Equal(t, "review overdue", events[0].Reason)
+
+	overdue, err := service.ListOverdue(env.ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+}
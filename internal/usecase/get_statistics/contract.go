@@ -0,0 +1,15 @@
+package get_statistics
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+)
+
+type prRepo interface {
+	GetStatistics(ctx context.Context, filter pull_request.StatisticsFilter) (*pull_request.Statistics, error)
+}
+
+type teamRepo interface {
+	Exists(ctx context.Context, teamName string) (bool, error)
+}
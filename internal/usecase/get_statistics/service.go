@@ -0,0 +1,74 @@
+package get_statistics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+// DefaultWindow is used when the request does not set the window start.
+const DefaultWindow = 30 * 24 * time.Hour
+
+type Filter struct {
+	From     *time.Time
+	To       *time.Time
+	TeamName string
+}
+
+type Report struct {
+	From time.Time
+	To   time.Time
+	*pull_request.Statistics
+}
+
+type Service struct {
+	prRepo   prRepo
+	teamRepo teamRepo
+}
+
+func New(prRepo prRepo, teamRepo teamRepo) *Service {
+	return &Service{
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+	}
+}
+
+// GetStatistics builds statistics for [From, To). To defaults to now and From
+// to DefaultWindow before To.
+func (s *Service) GetStatistics(ctx context.Context, filter Filter) (Report, error) {
+	to := time.Now().UTC()
+	if filter.To != nil {
+		to = filter.To.UTC()
+	}
+	from := to.Add(-DefaultWindow)
+	if filter.From != nil {
+		from = filter.From.UTC()
+	}
+	if !from.Before(to) {
+		return Report{}, rpc_errors.NewBadRequest("from must be before to")
+	}
+
+	if filter.TeamName != "" {
+		exists, err := s.teamRepo.Exists(ctx, filter.TeamName)
+		if err != nil {
+			return Report{}, fmt.Errorf("check team exists: %w", err)
+		}
+		if !exists {
+			return Report{}, rpc_errors.NewNotFound("team not found")
+		}
+	}
+
+	stats, err := s.prRepo.GetStatistics(ctx, pull_request.StatisticsFilter{
+		From:     from,
+		To:       to,
+		TeamName: filter.TeamName,
+	})
+	if err != nil {
+		return Report{}, fmt.Errorf("get statistics: %w", err)
+	}
+
+	return Report{From: from, To: to, Statistics: stats}, nil
+}
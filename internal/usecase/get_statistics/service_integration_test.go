@@ -0,0 +1,181 @@
+package get_statistics_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/get_statistics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *get_statistics.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := get_statistics.New(prRepo, teamRepo)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+// seedPR inserts a PR created at createdAt and, when mergedAt is set, merged at mergedAt.
+func (env *testEnv) seedPR(t *testing.T, prID, authorID, statusName string, createdAt time.Time, mergedAt *time.Time, reviewers ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(statusName))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		}); err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE pull_requests SET created_at = $1, merged_at = $2 WHERE pr_id = $3", createdAt, mergedAt, prID); err != nil {
+			return err
+		}
+
+		assigned := make([]models.Reviewer, len(reviewers))
+		for i, id := range reviewers {
+			assigned[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, assigned)
+	})
+	require.NoError(t, err)
+}
+
+func TestService_GetStatistics(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "r1", "r2")
+	env.seedTeam(t, "frontend", "other", "r3")
+
+	// Monday 2025-10-06 .. Monday 2025-10-20: two full weeks.
+	from := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 10, 20, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	merged1 := from.Add(day + 2*time.Hour)
+	merged2 := from.Add(day + 4*time.Hour)
+	mergedOld := from.Add(-3 * day)
+	env.seedPR(t, "pr-1", "author", models.StatusMerged, from.Add(day), &merged1, "r1")
+	env.seedPR(t, "pr-2", "author", models.StatusMerged, from.Add(day), &merged2, "r1", "r2")
+	env.seedPR(t, "pr-old", "author", models.StatusMerged, from.Add(-5*day), &mergedOld, "r2")
+	env.seedPR(t, "pr-open", "author", models.StatusOpen, from.Add(8*day), nil, "r2")
+	env.seedPR(t, "pr-lonely", "author", models.StatusOpen, from.Add(9*day), nil)
+	env.seedPR(t, "pr-front", "other", models.StatusOpen, from.Add(9*day), nil, "r3")
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if err := env.prRepo.InsertAssignmentEvents(ctx, tx, []models.AssignmentEvent{
+			{PullRequestID: "pr-open", Type: models.AssignmentEventReassign, OldReviewerID: "r1", NewReviewerID: "r2"},
+		}); err != nil {
+			return err
+		}
+		_, err := tx.ExecContext(ctx, "UPDATE assignment_events SET created_at = $1", from.Add(8*day))
+		return err
+	})
+	require.NoError(t, err)
+
+	report, err := env.service.GetStatistics(env.ctx, get_statistics.Filter{From: &from, To: &to, TeamName: "backend"})
+	require.NoError(t, err)
+
+	assert.Equal(t, from, report.From)
+	assert.Equal(t, to, report.To)
+
+	assert.Equal(t, 2, report.TimeToMerge.Count)
+	require.NotNil(t, report.TimeToMerge.P50)
+	assert.InDelta(t, 3*time.Hour.Seconds(), *report.TimeToMerge.P50, 1)
+
+	require.Len(t, report.MergesPerWeek, 2)
+	assert.Equal(t, pull_request.WeeklyMerges{WeekStart: from, Count: 2}, report.MergesPerWeek[0])
+	assert.Equal(t, pull_request.WeeklyMerges{WeekStart: from.Add(7 * day), Count: 0}, report.MergesPerWeek[1])
+
+	assert.Equal(t, []pull_request.UserAssignmentStats{{UserID: "r1", Count: 2}, {UserID: "r2", Count: 2}}, report.AssignmentsByUser)
+
+	assert.ElementsMatch(t, []pull_request.UserReassignmentStats{
+		{UserID: "r1", ReassignedFrom: 1},
+		{UserID: "r2", ReassignedTo: 1},
+	}, report.ReassignmentsByUser)
+
+	assert.Equal(t, []pull_request.ReviewerLoad{{UserID: "r2", OpenReviews: 1, PendingReviews: 1}}, report.OpenReviewLoad)
+
+	require.Len(t, report.PRsWithoutReviewers, 1)
+	assert.Equal(t, "pr-lonely", report.PRsWithoutReviewers[0].PullRequestID)
+}
+
+func TestService_GetStatistics_InvalidWindow(t *testing.T) {
+	env := setupTest(t)
+
+	from := time.Date(2025, 10, 6, 0, 0, 0, 0, time.UTC)
+	_, err := env.service.GetStatistics(env.ctx, get_statistics.Filter{From: &from, To: &from})
+
+	var badRequest *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestService_GetStatistics_UnknownTeam(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.GetStatistics(env.ctx, get_statistics.Filter{TeamName: "nope"})
+
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
@@ -0,0 +1,12 @@
+package list_prs
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+)
+
+type prRepo interface {
+	ListPullRequests(ctx context.Context, spec pull_request.FindSpecification) ([]models.PullRequest, error)
+}
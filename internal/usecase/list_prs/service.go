@@ -0,0 +1,108 @@
+package list_prs
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var knownStatuses = []string{
+	models.StatusDraft,
+	models.StatusOpen,
+	models.StatusReadyForReview,
+	models.StatusReopened,
+	models.StatusClosed,
+	models.StatusMerged,
+}
+
+type Page struct {
+	PullRequests []models.PullRequest
+	// NextCursor is empty on the last page.
+	NextCursor string
+}
+
+type Service struct {
+	prRepo prRepo
+}
+
+func New(prRepo prRepo) *Service {
+	return &Service{
+		prRepo: prRepo,
+	}
+}
+
+// ListPullRequests returns up to limit PRs matching filter, newest first,
+// starting after cursor. A zero limit means DefaultLimit.
+func (s *Service) ListPullRequests(ctx context.Context, filter pr_spec.ListFilter, cursor string, limit int) (Page, error) {
+	if limit == 0 {
+		limit = DefaultLimit
+	}
+	if limit < 1 || limit > MaxLimit {
+		return Page{}, rpc_errors.NewBadRequest(fmt.Sprintf("limit must be between 1 and %d", MaxLimit))
+	}
+	for _, status := range filter.Statuses {
+		if !slices.Contains(knownStatuses, status) {
+			return Page{}, rpc_errors.NewBadRequest(fmt.Sprintf("unknown status %q", status))
+		}
+	}
+
+	var after *pr_spec.Cursor
+	if cursor != "" {
+		decoded, err := decodeCursor(cursor)
+		if err != nil {
+			return Page{}, rpc_errors.NewBadRequest("invalid cursor")
+		}
+		after = &decoded
+	}
+
+	// One extra row tells whether there is a next page.
+	pullRequests, err := s.prRepo.ListPullRequests(ctx, pr_spec.NewListSpecification(filter, after, uint64(limit)+1))
+	if err != nil {
+		return Page{}, fmt.Errorf("list pull requests: %w", err)
+	}
+
+	page := Page{PullRequests: pullRequests}
+	if len(pullRequests) > limit {
+		page.PullRequests = pullRequests[:limit]
+		last := page.PullRequests[limit-1]
+		page.NextCursor = encodeCursor(pr_spec.Cursor{CreatedAt: *last.CreatedAt, PRID: last.ID})
+	}
+
+	return page, nil
+}
+
+func encodeCursor(c pr_spec.Cursor) string {
+	raw := c.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + c.PRID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(s string) (pr_spec.Cursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return pr_spec.Cursor{}, err
+	}
+
+	createdAt, prID, ok := strings.Cut(string(raw), "|")
+	if !ok || prID == "" {
+		return pr_spec.Cursor{}, fmt.Errorf("malformed cursor")
+	}
+
+	t, err := time.Parse(time.RFC3339Nano, createdAt)
+	if err != nil {
+		return pr_spec.Cursor{}, err
+	}
+
+	return pr_spec.Cursor{CreatedAt: t, PRID: prID}, nil
+}
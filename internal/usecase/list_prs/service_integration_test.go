@@ -0,0 +1,185 @@
+package list_prs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	pr_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/pr"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/list_prs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *list_prs.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := list_prs.New(prRepo)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) seedPR(t *testing.T, prID, name, authorID, statusName string, reviewers ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(statusName))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     name,
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		}); err != nil {
+			return err
+		}
+
+		assigned := make([]models.Reviewer, len(reviewers))
+		for i, id := range reviewers {
+			assigned[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, assigned)
+	})
+	require.NoError(t, err)
+}
+
+func prIDs(prs []models.PullRequest) []string {
+	ids := make([]string, len(prs))
+	for i, pr := range prs {
+		ids[i] = pr.ID
+	}
+	return ids
+}
+
+func TestService_ListPullRequests_Pagination(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "reviewer")
+
+	for i := 1; i <= 5; i++ {
+		env.seedPR(t, fmt.Sprintf("pr-%d", i), fmt.Sprintf("PR %d", i), "author", models.StatusOpen, "reviewer")
+	}
+
+	var seen []string
+	cursor := ""
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, 3)
+
+		page, err := env.service.ListPullRequests(env.ctx, pr_spec.ListFilter{}, cursor, 2)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, len(page.PullRequests), 2)
+		for _, pr := range page.PullRequests {
+			assert.Equal(t, models.StatusOpen, pr.Status.Name)
+			assert.Equal(t, []string{"reviewer"}, pr.Reviewers)
+		}
+		seen = append(seen, prIDs(page.PullRequests)...)
+
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	assert.ElementsMatch(t, []string{"pr-1", "pr-2", "pr-3", "pr-4", "pr-5"}, seen)
+}
+
+func TestService_ListPullRequests_Filters(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "alice", "bob")
+	env.seedTeam(t, "frontend", "carol")
+
+	env.seedPR(t, "pr-1", "Add search", "alice", models.StatusOpen, "bob")
+	env.seedPR(t, "pr-2", "Fix 100% CPU", "alice", models.StatusMerged)
+	env.seedPR(t, "pr-3", "Search UI", "carol", models.StatusDraft)
+
+	tests := map[string]struct {
+		filter   pr_spec.ListFilter
+		expected []string
+	}{
+		"status":   {pr_spec.ListFilter{Statuses: []string{models.StatusOpen, models.StatusDraft}}, []string{"pr-1", "pr-3"}},
+		"author":   {pr_spec.ListFilter{AuthorID: "alice"}, []string{"pr-1", "pr-2"}},
+		"reviewer": {pr_spec.ListFilter{ReviewerID: "bob"}, []string{"pr-1"}},
+		"team":     {pr_spec.ListFilter{TeamName: "frontend"}, []string{"pr-3"}},
+		"name":     {pr_spec.ListFilter{NameContains: "search"}, []string{"pr-1", "pr-3"}},
+		"literal":  {pr_spec.ListFilter{NameContains: "0%"}, []string{"pr-2"}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			page, err := env.service.ListPullRequests(env.ctx, tt.filter, "", 0)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tt.expected, prIDs(page.PullRequests))
+			assert.Empty(t, page.NextCursor)
+		})
+	}
+}
+
+func TestService_ListPullRequests_InvalidInput(t *testing.T) {
+	env := setupTest(t)
+
+	var badRequest *rpc_errors.BadRequestError
+
+	_, err := env.service.ListPullRequests(env.ctx, pr_spec.ListFilter{}, "not-a-cursor", 0)
+	assert.ErrorAs(t, err, &badRequest)
+
+	_, err = env.service.ListPullRequests(env.ctx, pr_spec.ListFilter{}, "", list_prs.MaxLimit+1)
+	assert.ErrorAs(t, err, &badRequest)
+
+	_, err = env.service.ListPullRequests(env.ctx, pr_spec.ListFilter{Statuses: []string{"ARCHIVED"}}, "", 0)
+	assert.ErrorAs(t, err, &badRequest)
+}
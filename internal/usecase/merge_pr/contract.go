@@ -3,12 +3,22 @@ package merge_pr
 import (
 	"context"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 )
 
 type prRepo interface {
-	PullRequestExists(ctx context.Context, prID string) (bool, error)
-	SetPullRequestStatus(ctx context.Context, prID string, statusName string) error
-
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
 	GetPRByID(ctx context.Context, prID string) (models.PullRequest, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+	MarkMerged(ctx context.Context, tx *sqlx.Tx, prID string, mergedBy string) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type userRepo interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
+
+type teamRepo interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
 }
@@ -3,30 +3,82 @@ package merge_pr
 import (
 	"context"
 	"errors"
+	"fmt"
 
+	"github.com/jmoiron/sqlx"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
 )
 
 type Service struct {
-	prRepo prRepo
+	prRepo   prRepo
+	userRepo userRepo
+	teamRepo teamRepo
 }
 
-func New(prRepo prRepo) *Service {
+func New(prRepo prRepo, userRepo userRepo, teamRepo teamRepo) *Service {
 	return &Service{
-		prRepo: prRepo,
+		prRepo:   prRepo,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
 	}
 }
 
-func (s *Service) MergePullRequest(ctx context.Context, prID string, mergeStatus string) (models.PullRequest, error) {
-	if exists, err := s.prRepo.PullRequestExists(ctx, prID); err != nil {
-		return models.PullRequest{}, err
-	} else if !exists {
-		return models.PullRequest{}, rpc_errors.NewNotFound("PR not found")
+// MergePullRequest merges a PR inside a transaction that holds the PR row lock.
+// Merging an already merged PR returns it unchanged, keeping the original
+// merged_at and merged_by.
+//
+// mergedBy is a user_id named in the request and must exist. When it is empty,
+// actor - the authenticated caller, which may be a bot or admin token rather
+// than a user - is recorded as is.
+func (s *Service) MergePullRequest(ctx context.Context, prID string, mergedBy string, actor string) (models.PullRequest, error) {
+	if mergedBy != "" {
+		if _, err := s.userRepo.GetUserTeamName(ctx, mergedBy); err != nil {
+			if errors.Is(err, user.ErrNotFound) {
+				return models.PullRequest{}, rpc_errors.NewNotFound("merged_by user not found")
+			}
+			return models.PullRequest{}, fmt.Errorf("get merged_by user: %w", err)
+		}
+	} else {
+		mergedBy = actor
 	}
 
-	err := s.prRepo.SetPullRequestStatus(ctx, prID, mergeStatus)
+	err := s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		current, err := s.prRepo.GetPRByIDForUpdate(ctx, tx, prID)
+		if err != nil {
+			if errors.Is(err, pull_request.ErrPRNotFound) {
+				return rpc_errors.NewNotFound("PR not found")
+			}
+			return fmt.Errorf("lock PR: %w", err)
+		}
+
+		if current.Status.Name == models.StatusMerged {
+			return nil
+		}
+
+		if !pr_lifecycle.CanTransition(current.Status.Name, models.StatusMerged) {
+			return rpc_errors.NewInvalidTransition(
+				fmt.Sprintf("cannot move PR from %s to %s", current.Status.Name, models.StatusMerged))
+		}
+
+		if err := s.checkApprovals(ctx, current); err != nil {
+			return err
+		}
+
+		if err := s.prRepo.MarkMerged(ctx, tx, prID, mergedBy); err != nil {
+			return fmt.Errorf("mark merged: %w", err)
+		}
+
+		return s.prRepo.InsertAssignmentEvents(ctx, tx, []models.AssignmentEvent{{
+			PullRequestID: prID,
+			Type:          models.AssignmentEventMerge,
+			Actor:         mergedBy,
+			Reason:        "pull request merged",
+		}})
+	})
 	if err != nil {
 		return models.PullRequest{}, err
 	}
@@ -41,3 +93,37 @@ func (s *Service) MergePullRequest(ctx context.Context, prID string, mergeStatus
 
 	return pr, nil
 }
+
+// checkApprovals enforces the required_approvals of the author's team. A pending
+// CHANGES_REQUESTED verdict blocks the merge regardless of the approval count.
+func (s *Service) checkApprovals(ctx context.Context, pr models.PullRequest) error {
+	teamName, err := s.userRepo.GetUserTeamName(ctx, pr.AuthorID)
+	if err != nil {
+		return fmt.Errorf("get author team: %w", err)
+	}
+
+	team, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		return fmt.Errorf("find author team: %w", err)
+	}
+
+	if team.RequiredApprovals == 0 {
+		return nil
+	}
+
+	approvals := 0
+	for _, a := range pr.Assignments {
+		switch a.ReviewState {
+		case models.ReviewStateChangesRequested:
+			return rpc_errors.NewNotApproved(fmt.Sprintf("changes requested by %s", a.ReviewerID))
+		case models.ReviewStateApproved:
+			approvals++
+		}
+	}
+
+	if approvals < team.RequiredApprovals {
+		return rpc_errors.NewNotApproved(fmt.Sprintf("PR has %d of %d required approvals", approvals, team.RequiredApprovals))
+	}
+
+	return nil
+}
@@ -37,7 +37,7 @@ func setupTest(t *testing.T) *testEnv {
 	userRepo := user.NewRepository(db)
 	prRepo := pull_request.NewRepository(db)
 	teamRepo := team.NewRepository(db)
-	service := merge_pr.New(prRepo)
+	service := merge_pr.New(prRepo, userRepo, teamRepo)
 
 	t.Cleanup(func() {
 		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
@@ -59,7 +59,7 @@ func TestService_MergePullRequest_Successful(t *testing.T) {
 
 	teamName := "test-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -97,12 +97,15 @@ func TestService_MergePullRequest_Successful(t *testing.T) {
 			return err
 		}
 
-		reviewers := []string{reviewer1ID, reviewer2ID}
+		reviewers := []models.Reviewer{
+			{ReviewerID: reviewer1ID, SourceTeam: teamName},
+			{ReviewerID: reviewer2ID, SourceTeam: teamName},
+		}
 		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
 	})
 	require.NoError(t, err)
 
-	mergedPR, err := env.service.MergePullRequest(env.ctx, prID, "MERGED")
+	mergedPR, err := env.service.MergePullRequest(env.ctx, prID, "", "")
 	require.NoError(t, err)
 
 	assert.Equal(t, prID, mergedPR.ID)
@@ -121,57 +124,239 @@ func TestService_MergePullRequest_NotFound(t *testing.T) {
 
 	nonExistentPRID := "non-existent-pr"
 
-	_, err := env.service.MergePullRequest(env.ctx, nonExistentPRID, "MERGED")
+	_, err := env.service.MergePullRequest(env.ctx, nonExistentPRID, "", "")
 	require.Error(t, err)
 	var notFoundErr *rpc_errors.NotFoundError
 	assert.ErrorAs(t, err, &notFoundErr)
 }
 
-func TestService_MergePullRequest_StatusNotFound(t *testing.T) {
+func TestService_MergePullRequest_IdempotentAndRecordsMerger(t *testing.T) {
 	env := setupTest(t)
 
-	teamName := "test-team-status-not-found"
+	teamName := "test-team-idempotent"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
 
-	authorID := "author-status-not-found"
+	authorID := "author-idempotent"
+	mergerID := "merger-idempotent"
+	otherMergerID := "other-merger-idempotent"
 	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
 		users := []models.User{
-			{ID: authorID, Username: "author-status-not-found", IsActive: true, TeamName: teamName},
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+			{ID: mergerID, Username: "merger", IsActive: true, TeamName: teamName},
+			{ID: otherMergerID, Username: "other-merger", IsActive: true, TeamName: teamName},
 		}
 		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
 		return err
 	})
 	require.NoError(t, err)
 
-	prID := "pr-status-not-found"
+	prID := "pr-idempotent"
 	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		pr := &models.PullRequest{
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
 			ID:       prID,
-			Name:     "PR for status not found test",
+			Name:     "Idempotent PR",
 			AuthorID: authorID,
-			Status:   &models.Status{Name: "OPEN"},
+			StatusID: foundStatus.ID,
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	first, err := env.service.MergePullRequest(env.ctx, prID, mergerID, "")
+	require.NoError(t, err)
+	require.NotNil(t, first.MergedAt)
+	require.NotNil(t, first.MergedBy)
+	assert.Equal(t, mergerID, *first.MergedBy)
+
+	second, err := env.service.MergePullRequest(env.ctx, prID, otherMergerID, "")
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", second.Status.Name)
+	assert.Equal(t, *first.MergedAt, *second.MergedAt)
+	assert.Equal(t, mergerID, *second.MergedBy)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, prID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventMerge, events[0].Type)
+	assert.Equal(t, mergerID, events[0].Actor)
+}
+
+func TestService_MergePullRequest_RecordsNonUserActor(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "test-team-bot-merge"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
+		return err
+	})
+	require.NoError(t, err)
+
+	authorID := "author-bot-merge"
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	prID := "pr-bot-merge"
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     "Bot merged PR",
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	mergedPR, err := env.service.MergePullRequest(env.ctx, prID, "", "bot:ci-bot")
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", mergedPR.Status.Name)
+	require.NotNil(t, mergedPR.MergedBy)
+	assert.Equal(t, "bot:ci-bot", *mergedPR.MergedBy)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, prID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "bot:ci-bot", events[0].Actor)
+}
+
+func TestService_MergePullRequest_UnknownMerger(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.MergePullRequest(env.ctx, "pr-any", "missing-user", "")
+	require.Error(t, err)
+	var notFoundErr *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+}
+
+func TestService_MergePullRequest_RequiresApprovals(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "gated-team"
+	requiredApprovals := 1
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{RequiredApprovals: &requiredApprovals})
+		return err
+	})
+	require.NoError(t, err)
+
+	authorID := "gated-author"
+	reviewer1ID := "gated-reviewer-1"
+	reviewer2ID := "gated-reviewer-2"
+
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+			{ID: reviewer1ID, Username: "reviewer1", IsActive: true, TeamName: teamName},
+			{ID: reviewer2ID, Username: "reviewer2", IsActive: true, TeamName: teamName},
 		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
 
+	prID := "pr-gated"
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
 		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     "Gated PR",
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return env.prRepo.InsertReviewers(ctx, tx, prID, []models.Reviewer{
+			{ReviewerID: reviewer1ID, SourceTeam: teamName},
+			{ReviewerID: reviewer2ID, SourceTeam: teamName},
+		})
+	})
+	require.NoError(t, err)
+
+	setState := func(reviewerID, state string) {
+		err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+			return env.prRepo.SetReviewState(ctx, tx, prID, reviewerID, state)
+		})
 		require.NoError(t, err)
-		pr.StatusID = foundStatus.ID
+	}
 
-		_, err = env.prRepo.InsertPullRequest(ctx, tx, pr)
+	var notApprovedErr *rpc_errors.NotApprovedError
+
+	_, err = env.service.MergePullRequest(env.ctx, prID, "", "")
+	require.Error(t, err)
+	assert.ErrorAs(t, err, &notApprovedErr)
+
+	setState(reviewer1ID, models.ReviewStateApproved)
+	setState(reviewer2ID, models.ReviewStateChangesRequested)
+
+	_, err = env.service.MergePullRequest(env.ctx, prID, "", "")
+	require.Error(t, err)
+	assert.ErrorAs(t, err, &notApprovedErr)
+
+	setState(reviewer2ID, models.ReviewStateCommented)
+
+	mergedPR, err := env.service.MergePullRequest(env.ctx, prID, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", mergedPR.Status.Name)
+}
+
+func TestService_MergePullRequest_DraftCannotBeMerged(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "draft-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "draft-author", Username: "author", IsActive: true, TeamName: teamName},
+		})
 		return err
 	})
 	require.NoError(t, err)
 
-	exists, err := env.prRepo.PullRequestExists(env.ctx, prID)
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusDraft))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       "pr-draft",
+			Name:     "Draft PR",
+			AuthorID: "draft-author",
+			StatusID: foundStatus.ID,
+		})
+		return err
+	})
 	require.NoError(t, err)
-	require.True(t, exists, "PR should exist")
 
-	nonExistentStatus := "NONEXISTENT_STATUS"
-	_, err = env.service.MergePullRequest(env.ctx, prID, nonExistentStatus)
+	_, err = env.service.MergePullRequest(env.ctx, "pr-draft", "", "")
 	require.Error(t, err)
-
-	assert.ErrorIs(t, err, pull_request.ErrStatusNotFound, "Error should be ErrStatusNotFound")
+	var transitionErr *rpc_errors.InvalidTransitionError
+	assert.ErrorAs(t, err, &transitionErr)
 }
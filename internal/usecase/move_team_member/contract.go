@@ -0,0 +1,34 @@
+package move_team_member
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type userRepo interface {
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
+	Find(ctx context.Context, spec user.FindSpecification) ([]models.User, error)
+	UserUpdateTx(ctx context.Context, tx *sqlx.Tx, spec user.UpdateSpecification) (models.User, error)
+}
+
+type teamRepo interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	InsertTeamEvents(ctx context.Context, tx *sqlx.Tx, events []models.TeamEvent) error
+}
+
+type prRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+	GetOpenPRsWithFullInfo(ctx context.Context, deactivatedReviewerIDs []string) (map[string]pull_request.PRFullInfo, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+	BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, reassignments []pull_request.PRReassignments) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type reviewerSelector interface {
+	TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+}
@@ -0,0 +1,222 @@
+package move_team_member
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type Reassignment struct {
+	PRID          string
+	NewReviewerID string
+	SourceTeam    string
+}
+
+type Result struct {
+	User          models.User
+	Reassignments []Reassignment
+	// KeptPRIDs are PRs of the old team where no replacement was found and
+	// the moved user stays a reviewer.
+	KeptPRIDs []string
+}
+
+type Service struct {
+	userRepo userRepo
+	teamRepo teamRepo
+	prRepo   prRepo
+	selector reviewerSelector
+}
+
+func New(userRepo userRepo, teamRepo teamRepo, prRepo prRepo, selector reviewerSelector) *Service {
+	return &Service{
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		prRepo:   prRepo,
+		selector: selector,
+	}
+}
+
+// MoveTeamMember transfers the user to another team. Their reviews on open PRs
+// authored in the old team are handed over to the old team's reviewer pool in
+// the same transaction; reviews in PRs of other teams are left as they are.
+func (s *Service) MoveTeamMember(ctx context.Context, userID, teamName, actor string) (Result, error) {
+	u, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return Result{}, rpc_errors.NewNotFound("user not found")
+		}
+		return Result{}, fmt.Errorf("get user: %w", err)
+	}
+	if u.TeamName == teamName {
+		return Result{}, rpc_errors.NewBadRequest(fmt.Sprintf("user is already in team %s", teamName))
+	}
+
+	target, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return Result{}, rpc_errors.NewNotFound("team not found")
+		}
+		return Result{}, fmt.Errorf("find team: %w", err)
+	}
+	if target.ArchivedAt != nil {
+		return Result{}, rpc_errors.NewTeamArchived("cannot move users into an archived team")
+	}
+
+	oldTeam := u.TeamName
+	prsInfo, pool, err := s.loadOldTeamPRs(ctx, userID, oldTeam)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var res Result
+	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		// The PRs were read before the transaction; lock them and drop the ones
+		// that changed hands or were merged in the meantime.
+		lockedInfo, err := pr_lifecycle.LockReviewable(ctx, tx, s.prRepo, prsInfo)
+		if err != nil {
+			return err
+		}
+		var bulk []pull_request.PRReassignments
+		bulk, res = planReassignments(lockedInfo, pool, userID)
+
+		moved, err := s.userRepo.UserUpdateTx(ctx, tx, user_spec.NewSetTeamSpecification(userID, teamName))
+		if err != nil {
+			return fmt.Errorf("update user team: %w", err)
+		}
+
+		if len(bulk) > 0 {
+			if err := s.prRepo.BulkReassignReviewers(ctx, tx, bulk); err != nil {
+				return fmt.Errorf("bulk reassign reviewers: %w", err)
+			}
+		}
+
+		events := make([]models.AssignmentEvent, 0, len(res.Reassignments))
+		for _, r := range res.Reassignments {
+			events = append(events, models.AssignmentEvent{
+				PullRequestID: r.PRID,
+				Type:          models.AssignmentEventReassign,
+				OldReviewerID: userID,
+				NewReviewerID: r.NewReviewerID,
+				Actor:         actor,
+				Reason:        fmt.Sprintf("reviewer moved to team %s", teamName),
+			})
+		}
+		if err := s.prRepo.InsertAssignmentEvents(ctx, tx, events); err != nil {
+			return fmt.Errorf("insert assignment events: %w", err)
+		}
+
+		err = s.teamRepo.InsertTeamEvents(ctx, tx, []models.TeamEvent{
+			{
+				TeamName: oldTeam,
+				Type:     models.TeamEventMemberLeft,
+				UserID:   userID,
+				NewValue: teamName,
+				Actor:    actor,
+			},
+			{
+				TeamName: teamName,
+				Type:     models.TeamEventMemberJoined,
+				UserID:   userID,
+				OldValue: oldTeam,
+				Actor:    actor,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("insert team events: %w", err)
+		}
+
+		res.User = moved
+		return nil
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	metrics.Reassignments.WithLabelValues(metrics.ReasonTeamChange).Add(float64(len(res.Reassignments)))
+
+	return res, nil
+}
+
+// loadOldTeamPRs returns the open PRs authored in the old team that the user
+// reviews, together with the old team's pool to pick their replacements from.
+func (s *Service) loadOldTeamPRs(ctx context.Context, userID, oldTeam string) (map[string]pull_request.PRFullInfo, *reviewer_selection.Pool, error) {
+	prsInfo, err := s.prRepo.GetOpenPRsWithFullInfo(ctx, []string{userID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get open PRs with full info: %w", err)
+	}
+	if len(prsInfo) == 0 {
+		return nil, nil, nil
+	}
+
+	members, err := s.userRepo.Find(ctx, user_spec.NewGetUsersByTeamNameSpec(oldTeam))
+	if err != nil {
+		return nil, nil, fmt.Errorf("find team members: %w", err)
+	}
+	inOldTeam := make(map[string]bool, len(members))
+	for _, m := range members {
+		inOldTeam[m.ID] = true
+	}
+
+	for prID, info := range prsInfo {
+		if !inOldTeam[info.AuthorID] {
+			delete(prsInfo, prID)
+		}
+	}
+	if len(prsInfo) == 0 {
+		return nil, nil, nil
+	}
+
+	pool, err := s.selector.TeamPool(ctx, oldTeam, []string{userID})
+	if err != nil {
+		return nil, nil, fmt.Errorf("get team reviewer pool: %w", err)
+	}
+
+	return prsInfo, pool, nil
+}
+
+// planReassignments picks a replacement for the user on every PR of prsInfo,
+// in PR id order.
+func planReassignments(prsInfo map[string]pull_request.PRFullInfo, pool *reviewer_selection.Pool, userID string) ([]pull_request.PRReassignments, Result) {
+	var res Result
+
+	prIDs := make([]string, 0, len(prsInfo))
+	for prID := range prsInfo {
+		prIDs = append(prIDs, prID)
+	}
+	sort.Strings(prIDs)
+
+	var bulk []pull_request.PRReassignments
+	for _, prID := range prIDs {
+		info := prsInfo[prID]
+		excludeIDs := append([]string{info.AuthorID}, info.AllReviewers...)
+		picked := pool.Pick(excludeIDs, 1)
+		if len(picked) == 0 {
+			res.KeptPRIDs = append(res.KeptPRIDs, prID)
+			continue
+		}
+
+		bulk = append(bulk, pull_request.PRReassignments{
+			PRID:          prID,
+			Reassignments: map[string]models.Reviewer{userID: picked[0]},
+		})
+		res.Reassignments = append(res.Reassignments, Reassignment{
+			PRID:          prID,
+			NewReviewerID: picked[0].ReviewerID,
+			SourceTeam:    picked[0].SourceTeam,
+		})
+	}
+
+	return bulk, res
+}
@@ -0,0 +1,161 @@
+package move_team_member_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/move_team_member"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *move_team_member.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := move_team_member.New(userRepo, teamRepo, prRepo, reviewer_selection.New(teamRepo, prRepo))
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events, team_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) seedPR(t *testing.T, prID, authorID string, reviewers ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		openStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: openStatus.ID,
+		}); err != nil {
+			return err
+		}
+
+		assigned := make([]models.Reviewer, len(reviewers))
+		for i, id := range reviewers {
+			assigned[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, assigned)
+	})
+	require.NoError(t, err)
+}
+
+func TestService_MoveTeamMember(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "mover", "r2")
+	env.seedTeam(t, "platform", "p1")
+	env.seedPR(t, "pr-1", "author", "mover")
+	env.seedPR(t, "pr-2", "author", "mover", "r2")
+	env.seedPR(t, "pr-3", "p1", "mover")
+
+	result, err := env.service.MoveTeamMember(env.ctx, "mover", "platform", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, "platform", result.User.TeamName)
+	require.Len(t, result.Reassignments, 1)
+	assert.Equal(t, "pr-1", result.Reassignments[0].PRID)
+	assert.Equal(t, "r2", result.Reassignments[0].NewReviewerID)
+	assert.Equal(t, []string{"pr-2"}, result.KeptPRIDs)
+
+	pr1, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"r2"}, pr1.Reviewers)
+
+	pr3, err := env.prRepo.GetPRByID(env.ctx, "pr-3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mover"}, pr3.Reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventReassign, events[0].Type)
+	assert.Equal(t, "reviewer moved to team platform", events[0].Reason)
+
+	left, err := env.teamRepo.GetTeamEvents(env.ctx, "backend")
+	require.NoError(t, err)
+	require.Len(t, left, 1)
+	assert.Equal(t, models.TeamEventMemberLeft, left[0].Type)
+	assert.Equal(t, "mover", left[0].UserID)
+	assert.Equal(t, "platform", left[0].NewValue)
+
+	joined, err := env.teamRepo.GetTeamEvents(env.ctx, "platform")
+	require.NoError(t, err)
+	require.Len(t, joined, 1)
+	assert.Equal(t, models.TeamEventMemberJoined, joined[0].Type)
+	assert.Equal(t, "backend", joined[0].OldValue)
+}
+
+func TestService_MoveTeamMember_SameTeam(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "mover")
+
+	_, err := env.service.MoveTeamMember(env.ctx, "mover", "backend", "admin")
+	var badRequest *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequest)
+}
+
+func TestService_MoveTeamMember_TeamNotFound(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "mover")
+
+	_, err := env.service.MoveTeamMember(env.ctx, "mover", "ghost", "admin")
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
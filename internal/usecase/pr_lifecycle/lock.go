@@ -0,0 +1,60 @@
+package pr_lifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+)
+
+type PRLocker interface {
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+}
+
+// LockReviewable locks the PRs of prsInfo in id order and returns them as they
+// are under the lock, so that reviewers planned from a read made outside tx do
+// not overwrite a concurrent change. PRs that are gone or no longer reviewable
+// are dropped, AllReviewers is re-read and DeactivatedReviewers keeps only the
+// reviewers still assigned.
+func LockReviewable(ctx context.Context, tx *sqlx.Tx, repo PRLocker, prsInfo map[string]pull_request.PRFullInfo) (map[string]pull_request.PRFullInfo, error) {
+	prIDs := make([]string, 0, len(prsInfo))
+	for prID := range prsInfo {
+		prIDs = append(prIDs, prID)
+	}
+	sort.Strings(prIDs)
+
+	locked := make(map[string]pull_request.PRFullInfo, len(prIDs))
+	for _, prID := range prIDs {
+		pr, err := repo.GetPRByIDForUpdate(ctx, tx, prID)
+		if err != nil {
+			if errors.Is(err, pull_request.ErrPRNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("lock PR %s: %w", prID, err)
+		}
+		if !IsReviewable(pr.Status.Name) {
+			continue
+		}
+
+		info := pull_request.PRFullInfo{
+			AuthorID:             pr.AuthorID,
+			AllReviewers:         pr.Reviewers,
+			DeactivatedReviewers: []string{},
+		}
+		for _, reviewerID := range prsInfo[prID].DeactivatedReviewers {
+			if slices.Contains(pr.Reviewers, reviewerID) {
+				info.DeactivatedReviewers = append(info.DeactivatedReviewers, reviewerID)
+			}
+		}
+		if len(info.DeactivatedReviewers) > 0 {
+			locked[prID] = info
+		}
+	}
+
+	return locked, nil
+}
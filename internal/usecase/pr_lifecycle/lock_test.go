@@ -0,0 +1,54 @@
+package pr_lifecycle_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLocker struct {
+	prs    map[string]models.PullRequest
+	locked []string
+}
+
+func (l *fakeLocker) GetPRByIDForUpdate(_ context.Context, _ *sqlx.Tx, prID string) (models.PullRequest, error) {
+	l.locked = append(l.locked, prID)
+	pr, ok := l.prs[prID]
+	if !ok {
+		return models.PullRequest{}, pull_request.ErrPRNotFound
+	}
+	return pr, nil
+}
+
+func TestLockReviewable(t *testing.T) {
+	read := map[string]pull_request.PRFullInfo{
+		"pr-3": {AuthorID: "a", AllReviewers: []string{"u1", "u2"}, DeactivatedReviewers: []string{"u1"}},
+		"pr-1": {AuthorID: "a", AllReviewers: []string{"u1", "u2"}, DeactivatedReviewers: []string{"u1", "u2"}},
+		"pr-2": {AuthorID: "a", AllReviewers: []string{"u1"}, DeactivatedReviewers: []string{"u1"}},
+		"pr-4": {AuthorID: "a", AllReviewers: []string{"u1"}, DeactivatedReviewers: []string{"u1"}},
+		"pr-5": {AuthorID: "a", AllReviewers: []string{"u1"}, DeactivatedReviewers: []string{"u1"}},
+	}
+	locker := &fakeLocker{prs: map[string]models.PullRequest{
+		// u2 was reassigned to u3 meanwhile.
+		"pr-1": {AuthorID: "a", Status: &models.Status{Name: models.StatusOpen}, Reviewers: []string{"u1", "u3"}},
+		"pr-2": {AuthorID: "a", Status: &models.Status{Name: models.StatusMerged}, Reviewers: []string{"u1"}},
+		"pr-3": {AuthorID: "a", Status: &models.Status{Name: models.StatusReopened}, Reviewers: []string{"u1", "u2"}},
+		// u1 is no longer assigned.
+		"pr-5": {AuthorID: "a", Status: &models.Status{Name: models.StatusOpen}, Reviewers: []string{"u4"}},
+	}}
+
+	locked, err := pr_lifecycle.LockReviewable(context.Background(), nil, locker, read)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"pr-1", "pr-2", "pr-3", "pr-4", "pr-5"}, locker.locked)
+	assert.Equal(t, map[string]pull_request.PRFullInfo{
+		"pr-1": {AuthorID: "a", AllReviewers: []string{"u1", "u3"}, DeactivatedReviewers: []string{"u1"}},
+		"pr-3": {AuthorID: "a", AllReviewers: []string{"u1", "u2"}, DeactivatedReviewers: []string{"u1"}},
+	}, locked)
+}
@@ -0,0 +1,26 @@
+package pr_lifecycle
+
+import (
+	"slices"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+// transitions lists the statuses a PR may move to from each status. MERGED is
+// final; a merge of an already merged PR is handled as a no-op by merge_pr.
+var transitions = map[string][]string{
+	models.StatusDraft:          {models.StatusReadyForReview, models.StatusClosed},
+	models.StatusOpen:           {models.StatusDraft, models.StatusClosed, models.StatusMerged},
+	models.StatusReadyForReview: {models.StatusDraft, models.StatusClosed, models.StatusMerged},
+	models.StatusReopened:       {models.StatusDraft, models.StatusClosed, models.StatusMerged},
+	models.StatusClosed:         {models.StatusReopened},
+}
+
+func CanTransition(from, to string) bool {
+	return slices.Contains(transitions[from], to)
+}
+
+// IsReviewable reports whether reviewers are expected to act on a PR in the given status.
+func IsReviewable(status string) bool {
+	return slices.Contains(models.ReviewableStatuses, status)
+}
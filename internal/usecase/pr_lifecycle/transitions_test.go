@@ -0,0 +1,45 @@
+package pr_lifecycle_test
+
+import (
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanTransition(t *testing.T) {
+	tests := []struct {
+		from, to string
+		allowed  bool
+	}{
+		{models.StatusDraft, models.StatusReadyForReview, true},
+		{models.StatusDraft, models.StatusClosed, true},
+		{models.StatusDraft, models.StatusMerged, false},
+		{models.StatusOpen, models.StatusMerged, true},
+		{models.StatusOpen, models.StatusDraft, true},
+		{models.StatusReadyForReview, models.StatusClosed, true},
+		{models.StatusReopened, models.StatusMerged, true},
+		{models.StatusClosed, models.StatusReopened, true},
+		{models.StatusClosed, models.StatusMerged, false},
+		{models.StatusClosed, models.StatusReadyForReview, false},
+		{models.StatusMerged, models.StatusReopened, false},
+		{models.StatusMerged, models.StatusClosed, false},
+		{"UNKNOWN", models.StatusOpen, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			assert.Equal(t, tt.allowed, pr_lifecycle.CanTransition(tt.from, tt.to))
+		})
+	}
+}
+
+func TestIsReviewable(t *testing.T) {
+	assert.True(t, pr_lifecycle.IsReviewable(models.StatusOpen))
+	assert.True(t, pr_lifecycle.IsReviewable(models.StatusReadyForReview))
+	assert.True(t, pr_lifecycle.IsReviewable(models.StatusReopened))
+	assert.False(t, pr_lifecycle.IsReviewable(models.StatusDraft))
+	assert.False(t, pr_lifecycle.IsReviewable(models.StatusClosed))
+	assert.False(t, pr_lifecycle.IsReviewable(models.StatusMerged))
+}
@@ -15,7 +15,13 @@ type prRepo interface {
 	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
 
 	GetPRByID(ctx context.Context, prID string) (models.PullRequest, error)
-	GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string, limit int) ([]string, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
 	GetPullRequestReviewers(ctx context.Context, prID string) ([]string, error)
-	ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID, newReviewerID string) error
+	ReassignReviewer(ctx context.Context, tx *sqlx.Tx, prID, oldReviewerID string, newReviewer models.Reviewer) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type reviewerSelector interface {
+	SelectReviewers(ctx context.Context, teamName string, excludeIDs []string, n int) ([]models.Reviewer, error)
+	AtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
 }
@@ -4,31 +4,49 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
 	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 )
 
 const (
 	numberOfReviewers = 1
+
+	manualReason  = "manual reassign"
+	overdueReason = "review overdue"
 )
 
 type Service struct {
 	userRepo userRepo
 	prRepo   prRepo
+	selector reviewerSelector
 }
 
-func New(userRepo userRepo, prRepo prRepo) *Service {
+func New(userRepo userRepo, prRepo prRepo, selector reviewerSelector) *Service {
 	return &Service{
 		userRepo: userRepo,
 		prRepo:   prRepo,
+		selector: selector,
 	}
 }
 
-func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID string) (models.PullRequest, string, error) {
+func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID, actor string) (models.PullRequest, string, error) {
+	return s.reassign(ctx, prID, oldReviewerID, actor, manualReason, metrics.ReasonManual)
+}
+
+// ReassignOverdue replaces a reviewer who missed the review SLA, the same way
+// as ReassignReviewer but recorded as an overdue escalation.
+func (s *Service) ReassignOverdue(ctx context.Context, prID, oldReviewerID, actor string) (models.PullRequest, string, error) {
+	return s.reassign(ctx, prID, oldReviewerID, actor, overdueReason, metrics.ReasonOverdue)
+}
+
+func (s *Service) reassign(ctx context.Context, prID, oldReviewerID, actor, reason, metricReason string) (models.PullRequest, string, error) {
 	pr, err := s.prRepo.GetPRByID(ctx, prID)
 	if err != nil {
 		if errors.Is(err, pull_request.ErrPRNotFound) {
@@ -40,6 +58,9 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 	if pr.Status.Name == "MERGED" {
 		return models.PullRequest{}, "", rpc_errors.NewPRMerged("cannot reassign on merged PR")
 	}
+	if pr.Status.Name == models.StatusClosed {
+		return models.PullRequest{}, "", rpc_errors.NewPRClosed("cannot reassign on closed PR")
+	}
 
 	teamName, err := s.userRepo.GetUserTeamName(ctx, oldReviewerID)
 	if err != nil {
@@ -68,33 +89,67 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 	excludeIDs := []string{oldReviewerID, pr.AuthorID}
 	excludeIDs = append(excludeIDs, currentReviewers...)
 
-	availableReviewers, err := s.prRepo.GetAvailableReviewers(ctx, teamName, excludeIDs, numberOfReviewers)
+	availableReviewers, err := s.selector.SelectReviewers(ctx, teamName, excludeIDs, numberOfReviewers)
 	if err != nil {
-		if errors.Is(err, pull_request.ErrReviewersNotFound) {
-			return models.PullRequest{}, "", rpc_errors.NewNoCandidate("no available reviewers in team")
+		if errors.Is(err, team.ErrNotFound) {
+			metrics.NoCandidate.WithLabelValues(metrics.OperationReassign).Inc()
+			return models.PullRequest{}, "", rpc_errors.NewNoCandidate("reviewer team not found")
 		}
-		return models.PullRequest{}, "", fmt.Errorf("get available reviewers: %w", err)
+		return models.PullRequest{}, "", fmt.Errorf("select reviewers: %w", err)
 	}
 
 	if len(availableReviewers) == 0 {
+		metrics.NoCandidate.WithLabelValues(metrics.OperationReassign).Inc()
+
+		atCapacity, err := s.selector.AtCapacity(ctx, teamName, excludeIDs)
+		if err != nil {
+			return models.PullRequest{}, "", fmt.Errorf("get reviewers at capacity: %w", err)
+		}
+		if len(atCapacity) > 0 {
+			return models.PullRequest{}, "", rpc_errors.NewNoCandidate(capacityMessage(atCapacity))
+		}
 		return models.PullRequest{}, "", rpc_errors.NewNoCandidate("no available reviewers in team")
 	}
 
-	newReviewerID := availableReviewers[0]
+	newReviewer := availableReviewers[0]
 
 	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		err := s.prRepo.ReassignReviewer(ctx, tx, prID, oldReviewerID, newReviewerID)
+		// Re-check the status under the row lock so a concurrent merge or close wins.
+		locked, err := s.prRepo.GetPRByIDForUpdate(ctx, tx, prID)
+		if err != nil {
+			if errors.Is(err, pull_request.ErrPRNotFound) {
+				return rpc_errors.NewNotFound("PR not found")
+			}
+			return fmt.Errorf("lock PR: %w", err)
+		}
+		switch locked.Status.Name {
+		case models.StatusMerged:
+			return rpc_errors.NewPRMerged("cannot reassign on merged PR")
+		case models.StatusClosed:
+			return rpc_errors.NewPRClosed("cannot reassign on closed PR")
+		}
+
+		err = s.prRepo.ReassignReviewer(ctx, tx, prID, oldReviewerID, newReviewer)
 		if err != nil {
 			if errors.Is(err, pull_request.ErrReviewerNotAssigned) {
 				return rpc_errors.NewNotAssigned("reviewer is not assigned to this PR")
 			}
 			return fmt.Errorf("reassign reviewer: %w", err)
 		}
-		return nil
+
+		return s.prRepo.InsertAssignmentEvents(ctx, tx, []models.AssignmentEvent{{
+			PullRequestID: prID,
+			Type:          models.AssignmentEventReassign,
+			OldReviewerID: oldReviewerID,
+			NewReviewerID: newReviewer.ReviewerID,
+			Actor:         actor,
+			Reason:        reason,
+		}})
 	})
 	if err != nil {
 		return models.PullRequest{}, "", err
 	}
+	metrics.Reassignments.WithLabelValues(metricReason).Inc()
 
 	var reassignedPR models.PullRequest
 	reassignedPR, err = s.prRepo.GetPRByID(ctx, prID)
@@ -105,5 +160,15 @@ func (s *Service) ReassignReviewer(ctx context.Context, prID, oldReviewerID stri
 		return models.PullRequest{}, "", fmt.Errorf("get updated PR: %w", err)
 	}
 
-	return reassignedPR, newReviewerID, nil
+	return reassignedPR, newReviewer.ReviewerID, nil
+}
+
+// capacityMessage explains that candidates exist but have all reached their
+// open review limit.
+func capacityMessage(atCapacity []models.Candidate) string {
+	limits := make([]string, len(atCapacity))
+	for i, c := range atCapacity {
+		limits[i] = fmt.Sprintf("%s %d/%d", c.UserID, c.OpenReviews, c.MaxOpenReviews)
+	}
+	return fmt.Sprintf("no available reviewers in team: all candidates are at their open review limit (%s)", strings.Join(limits, ", "))
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
 	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
 	"github.com/loloneme/potential-waffle/internal/usecase/reassign_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -37,7 +38,7 @@ func setupTest(t *testing.T) *testEnv {
 	userRepo := user.NewRepository(db)
 	prRepo := pull_request.NewRepository(db)
 	teamRepo := team.NewRepository(db)
-	service := reassign_pr.New(userRepo, prRepo)
+	service := reassign_pr.New(userRepo, prRepo, reviewer_selection.New(teamRepo, prRepo))
 
 	t.Cleanup(func() {
 		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
@@ -59,7 +60,7 @@ func TestService_ReassignReviewer_Successful(t *testing.T) {
 
 	teamName := "test-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -99,12 +100,12 @@ func TestService_ReassignReviewer_Successful(t *testing.T) {
 			return err
 		}
 
-		reviewers := []string{reviewer1ID}
+		reviewers := []models.Reviewer{{ReviewerID: reviewer1ID, SourceTeam: teamName}}
 		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
 	})
 	require.NoError(t, err)
 
-	reassignedPR, newReviewerID, err := env.service.ReassignReviewer(env.ctx, prID, reviewer1ID)
+	reassignedPR, newReviewerID, err := env.service.ReassignReviewer(env.ctx, prID, reviewer1ID, "lead")
 	require.NoError(t, err)
 
 	assert.Equal(t, prID, reassignedPR.ID)
@@ -118,6 +119,18 @@ func TestService_ReassignReviewer_Successful(t *testing.T) {
 	assert.Len(t, reviewers, 1)
 	assert.NotContains(t, reviewers, reviewer1ID)
 	assert.Contains(t, reviewers, newReviewerID)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, prID)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventReassign, events[0].Type)
+	assert.Equal(t, reviewer1ID, events[0].OldReviewerID)
+	assert.Equal(t, newReviewerID, events[0].NewReviewerID)
+	assert.Equal(t, "lead", events[0].Actor)
+
+	userEvents, err := env.prRepo.GetAssignmentEventsByUser(env.ctx, reviewer1ID)
+	require.NoError(t, err)
+	assert.Len(t, userEvents, 1)
 }
 
 func TestService_ReassignReviewer_PRNotFound(t *testing.T) {
@@ -126,7 +139,7 @@ func TestService_ReassignReviewer_PRNotFound(t *testing.T) {
 	nonExistentPRID := "non-existent-pr"
 	reviewerID := "reviewer-1"
 
-	_, _, err := env.service.ReassignReviewer(env.ctx, nonExistentPRID, reviewerID)
+	_, _, err := env.service.ReassignReviewer(env.ctx, nonExistentPRID, reviewerID, "lead")
 	require.Error(t, err)
 	var notFoundErr *rpc_errors.NotFoundError
 	assert.ErrorAs(t, err, &notFoundErr)
@@ -137,7 +150,7 @@ func TestService_ReassignReviewer_PRAlreadyMerged(t *testing.T) {
 
 	teamName := "test-team-2"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -173,12 +186,12 @@ func TestService_ReassignReviewer_PRAlreadyMerged(t *testing.T) {
 			return err
 		}
 
-		reviewers := []string{reviewer1ID}
+		reviewers := []models.Reviewer{{ReviewerID: reviewer1ID, SourceTeam: teamName}}
 		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
 	})
 	require.NoError(t, err)
 
-	_, _, err = env.service.ReassignReviewer(env.ctx, prID, reviewer1ID)
+	_, _, err = env.service.ReassignReviewer(env.ctx, prID, reviewer1ID, "lead")
 	require.Error(t, err)
 	var mergedErr *rpc_errors.PRMergedError
 	assert.ErrorAs(t, err, &mergedErr)
@@ -189,7 +202,7 @@ func TestService_ReassignReviewer_ReviewerNotFound(t *testing.T) {
 
 	teamName := "test-team-3"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -223,7 +236,7 @@ func TestService_ReassignReviewer_ReviewerNotFound(t *testing.T) {
 	require.NoError(t, err)
 
 	nonExistentReviewerID := "non-existent-reviewer"
-	_, _, err = env.service.ReassignReviewer(env.ctx, prID, nonExistentReviewerID)
+	_, _, err = env.service.ReassignReviewer(env.ctx, prID, nonExistentReviewerID, "lead")
 	require.Error(t, err)
 	var notFoundErr *rpc_errors.NotFoundError
 	assert.ErrorAs(t, err, &notFoundErr)
@@ -234,7 +247,7 @@ func TestService_ReassignReviewer_ReviewerNotAssigned(t *testing.T) {
 
 	teamName := "test-team-4"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -272,12 +285,12 @@ func TestService_ReassignReviewer_ReviewerNotAssigned(t *testing.T) {
 			return err
 		}
 
-		reviewers := []string{reviewer1ID}
+		reviewers := []models.Reviewer{{ReviewerID: reviewer1ID, SourceTeam: teamName}}
 		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
 	})
 	require.NoError(t, err)
 
-	_, _, err = env.service.ReassignReviewer(env.ctx, prID, reviewer2ID)
+	_, _, err = env.service.ReassignReviewer(env.ctx, prID, reviewer2ID, "lead")
 	require.Error(t, err)
 	var notAssignedErr *rpc_errors.NotAssignedError
 	assert.ErrorAs(t, err, &notAssignedErr)
@@ -288,7 +301,7 @@ func TestService_ReassignReviewer_NoAvailableCandidates(t *testing.T) {
 
 	teamName := "lonely-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -324,23 +337,67 @@ func TestService_ReassignReviewer_NoAvailableCandidates(t *testing.T) {
 			return err
 		}
 
-		reviewers := []string{reviewer1ID}
+		reviewers := []models.Reviewer{{ReviewerID: reviewer1ID, SourceTeam: teamName}}
 		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
 	})
 	require.NoError(t, err)
 
-	_, _, err = env.service.ReassignReviewer(env.ctx, prID, reviewer1ID)
+	_, _, err = env.service.ReassignReviewer(env.ctx, prID, reviewer1ID, "lead")
 	require.Error(t, err)
 	var noCandidateErr *rpc_errors.NoCandidateError
 	assert.ErrorAs(t, err, &noCandidateErr)
 }
 
+func TestService_ReassignReviewer_CandidatesAtCapacity(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "busy-team"
+	maxOpenReviews := 1
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{MaxOpenReviews: &maxOpenReviews}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author-6", Username: "author6", IsActive: true, TeamName: teamName},
+			{ID: "reviewer-8", Username: "reviewer8", IsActive: true, TeamName: teamName},
+			{ID: "senior-1", Username: "senior1", IsActive: true, TeamName: teamName},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
+		if err != nil {
+			return err
+		}
+
+		for prID, reviewerID := range map[string]string{"pr-6": "reviewer-8", "pr-7": "senior-1"} {
+			pr := &models.PullRequest{ID: prID, Name: prID, AuthorID: "author-6", StatusID: foundStatus.ID}
+			if _, err := env.prRepo.InsertPullRequest(ctx, tx, pr); err != nil {
+				return err
+			}
+			if err := env.prRepo.InsertReviewers(ctx, tx, prID, []models.Reviewer{{ReviewerID: reviewerID, SourceTeam: teamName}}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	_, _, err = env.service.ReassignReviewer(env.ctx, "pr-6", "reviewer-8", "lead")
+	var noCandidateErr *rpc_errors.NoCandidateError
+	require.ErrorAs(t, err, &noCandidateErr)
+	assert.Contains(t, noCandidateErr.Message, "open review limit")
+	assert.Contains(t, noCandidateErr.Message, "senior-1 1/1")
+}
+
 func TestService_ReassignReviewer_ExcludesInactiveUsers(t *testing.T) {
 	env := setupTest(t)
 
 	teamName := "mixed-team"
 	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
-		_, err := env.teamRepo.CreateTeam(ctx, tx, models.Team{TeamName: teamName})
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
 		return err
 	})
 	require.NoError(t, err)
@@ -380,15 +437,75 @@ func TestService_ReassignReviewer_ExcludesInactiveUsers(t *testing.T) {
 			return err
 		}
 
-		reviewers := []string{reviewer1ID}
+		reviewers := []models.Reviewer{{ReviewerID: reviewer1ID, SourceTeam: teamName}}
 		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
 	})
 	require.NoError(t, err)
 
-	reassignedPR, newReviewerID, err := env.service.ReassignReviewer(env.ctx, prID, reviewer1ID)
+	reassignedPR, newReviewerID, err := env.service.ReassignReviewer(env.ctx, prID, reviewer1ID, "lead")
 	require.NoError(t, err)
 
 	assert.Equal(t, activeReviewerID, newReviewerID)
 	assert.Contains(t, reassignedPR.Reviewers, activeReviewerID)
 	assert.NotContains(t, reassignedPR.Reviewers, inactiveReviewerID)
 }
+
+func TestService_ReassignReviewer_FallsBackToBuddyTeam(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "pair-team"
+	buddyTeamName := "buddy-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, buddyTeamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		return env.teamRepo.SetFallbackTeams(ctx, tx, teamName, []string{buddyTeamName})
+	})
+	require.NoError(t, err)
+
+	authorID := "pair-author"
+	reviewer1ID := "pair-reviewer"
+	buddyID := "buddy-reviewer"
+
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: authorID, Username: "author", IsActive: true, TeamName: teamName},
+			{ID: reviewer1ID, Username: "reviewer", IsActive: true, TeamName: teamName},
+			{ID: buddyID, Username: "buddy", IsActive: true, TeamName: buddyTeamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	prID := "pr-pair"
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification("OPEN"))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     "Pair PR",
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return env.prRepo.InsertReviewers(ctx, tx, prID, []models.Reviewer{{ReviewerID: reviewer1ID, SourceTeam: teamName}})
+	})
+	require.NoError(t, err)
+
+	reassignedPR, newReviewerID, err := env.service.ReassignReviewer(env.ctx, prID, reviewer1ID, "lead")
+	require.NoError(t, err)
+	assert.Equal(t, buddyID, newReviewerID)
+	assert.Equal(t, []models.Reviewer{
+		{PullRequestID: prID, ReviewerID: buddyID, SourceTeam: buddyTeamName},
+	}, reassignedPR.Assignments)
+}
@@ -0,0 +1,28 @@
+package reassign_unavailable
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type userRepo interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+	GetStartedUnavailability(ctx context.Context) ([]models.Unavailability, error)
+	MarkUnavailabilityReassigned(ctx context.Context, tx *sqlx.Tx, unavailabilityID int64) (bool, error)
+}
+
+type prRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+	GetOpenPRsWithFullInfo(ctx context.Context, deactivatedReviewerIDs []string) (map[string]pull_request.PRFullInfo, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+	BulkReassignReviewers(ctx context.Context, tx *sqlx.Tx, reassignments []pull_request.PRReassignments) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type reviewerSelector interface {
+	TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+}
@@ -0,0 +1,30 @@
+package reassign_unavailable
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/worker"
+)
+
+type Config struct {
+	Interval time.Duration `env:"UNAVAILABILITY_REASSIGN_INTERVAL" envDefault:"1m"`
+}
+
+func LoadConfig() (*Config, error) {
+	cfg := &Config{}
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Job reassigns the reviews of users whose unavailability has started.
+func (s *Service) Job() worker.Job {
+	return worker.Job{
+		Name:   "reassign reviews of unavailable users",
+		Report: "reassigned %d reviews of unavailable users",
+		Run:    s.ReassignStarted,
+	}
+}
@@ -0,0 +1,134 @@
+package reassign_unavailable
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+)
+
+const (
+	reassignReason = "reviewer unavailable"
+	// systemActor is recorded as the actor of the reassignments.
+	systemActor = "system:unavailability"
+)
+
+type Service struct {
+	userRepo userRepo
+	prRepo   prRepo
+	selector reviewerSelector
+}
+
+func New(userRepo userRepo, prRepo prRepo, selector reviewerSelector) *Service {
+	return &Service{
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		selector: selector,
+	}
+}
+
+// ReassignStarted hands the open reviews of users whose unavailability period
+// has started over to their teammates, once per period. Reviews nobody can
+// take over stay with the user. A failing period does not stop the others, the
+// failures are returned together with the number of reassigned reviews.
+func (s *Service) ReassignStarted(ctx context.Context) (int, error) {
+	periods, err := s.userRepo.GetStartedUnavailability(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get started unavailability: %w", err)
+	}
+
+	total := 0
+	var errs []error
+	for _, period := range periods {
+		reassigned, err := s.reassignPeriod(ctx, period)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("reassign reviews of %s: %w", period.UserID, err))
+			continue
+		}
+		total += reassigned
+	}
+
+	return total, errors.Join(errs...)
+}
+
+func (s *Service) reassignPeriod(ctx context.Context, period models.Unavailability) (int, error) {
+	teamName, err := s.userRepo.GetUserTeamName(ctx, period.UserID)
+	if err != nil {
+		return 0, fmt.Errorf("get user team: %w", err)
+	}
+
+	prsInfo, err := s.prRepo.GetOpenPRsWithFullInfo(ctx, []string{period.UserID})
+	if err != nil {
+		return 0, fmt.Errorf("get open PRs: %w", err)
+	}
+
+	pool, err := s.selector.TeamPool(ctx, teamName, []string{period.UserID})
+	if err != nil {
+		return 0, fmt.Errorf("get team reviewer pool: %w", err)
+	}
+
+	var events []models.AssignmentEvent
+	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		claimed, err := s.userRepo.MarkUnavailabilityReassigned(ctx, tx, period.ID)
+		if err != nil {
+			return fmt.Errorf("mark reassigned: %w", err)
+		}
+		if !claimed {
+			return nil
+		}
+
+		// Re-read the PRs under the row lock so a concurrent status or reviewer
+		// change wins.
+		lockedInfo, err := pr_lifecycle.LockReviewable(ctx, tx, s.prRepo, prsInfo)
+		if err != nil {
+			return err
+		}
+		prIDs := make([]string, 0, len(lockedInfo))
+		for prID := range lockedInfo {
+			prIDs = append(prIDs, prID)
+		}
+		sort.Strings(prIDs)
+
+		var reassignments []pull_request.PRReassignments
+		for _, prID := range prIDs {
+			info := lockedInfo[prID]
+
+			excludeIDs := append([]string{info.AuthorID}, info.AllReviewers...)
+			picked := pool.Pick(excludeIDs, 1)
+			if len(picked) == 0 {
+				continue
+			}
+
+			reassignments = append(reassignments, pull_request.PRReassignments{
+				PRID:          prID,
+				Reassignments: map[string]models.Reviewer{period.UserID: picked[0]},
+			})
+			events = append(events, models.AssignmentEvent{
+				PullRequestID: prID,
+				Type:          models.AssignmentEventReassign,
+				OldReviewerID: period.UserID,
+				NewReviewerID: picked[0].ReviewerID,
+				Actor:         systemActor,
+				Reason:        reassignReason,
+			})
+		}
+
+		if err := s.prRepo.BulkReassignReviewers(ctx, tx, reassignments); err != nil {
+			return fmt.Errorf("bulk reassign reviewers: %w", err)
+		}
+
+		return s.prRepo.InsertAssignmentEvents(ctx, tx, events)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	metrics.Reassignments.WithLabelValues(metrics.ReasonUnavailability).Add(float64(len(events)))
+	return len(events), nil
+}
@@ -0,0 +1,203 @@
+package reassign_unavailable_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	"github.com/loloneme/potential-waffle/internal/usecase/reassign_unavailable"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *reassign_unavailable.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := reassign_unavailable.New(userRepo, prRepo, reviewer_selection.New(teamRepo, prRepo))
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events, user_unavailability RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) seedPR(t *testing.T, prID, authorID string, reviewers ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		openStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: openStatus.ID,
+		}); err != nil {
+			return err
+		}
+
+		assigned := make([]models.Reviewer, len(reviewers))
+		for i, id := range reviewers {
+			assigned[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, assigned)
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) seedUnavailability(t *testing.T, userID string, startsAt, endsAt time.Time, reassign bool) models.Unavailability {
+	t.Helper()
+
+	created, err := env.userRepo.CreateUnavailability(env.ctx, models.Unavailability{
+		UserID:          userID,
+		StartsAt:        startsAt.UTC(),
+		EndsAt:          endsAt.UTC(),
+		Reason:          "vacation",
+		ReassignReviews: reassign,
+	})
+	require.NoError(t, err)
+	return created
+}
+
+func TestService_ReassignStarted_HandsOverOpenReviews(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "away", "r2")
+	env.seedPR(t, "pr-1", "author", "away")
+
+	now := time.Now()
+	period := env.seedUnavailability(t, "away", now.Add(-time.Hour), now.Add(24*time.Hour), true)
+
+	reassigned, err := env.service.ReassignStarted(env.ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reassigned)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"r2"}, pr.Reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventReassign, events[0].Type)
+	assert.Equal(t, "away", events[0].OldReviewerID)
+	assert.Equal(t, "r2", events[0].NewReviewerID)
+	assert.Equal(t, "system:unavailability", events[0].Actor)
+
+	stored, err := env.userRepo.GetUnavailability(env.ctx, period.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.ReassignedAt)
+
+	reassigned, err = env.service.ReassignStarted(env.ctx)
+	require.NoError(t, err)
+	assert.Zero(t, reassigned)
+}
+
+func TestService_ReassignStarted_SkipsFutureAndOptedOutPeriods(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "later", "stays", "r3")
+	env.seedPR(t, "pr-1", "author", "later", "stays")
+
+	now := time.Now()
+	env.seedUnavailability(t, "later", now.Add(24*time.Hour), now.Add(48*time.Hour), true)
+	env.seedUnavailability(t, "stays", now.Add(-time.Hour), now.Add(24*time.Hour), false)
+
+	reassigned, err := env.service.ReassignStarted(env.ctx)
+	require.NoError(t, err)
+	assert.Zero(t, reassigned)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"later", "stays"}, pr.Reviewers)
+}
+
+func TestService_ReassignStarted_NoReplacementKeepsReviewer(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "away")
+	env.seedPR(t, "pr-1", "author", "away")
+
+	now := time.Now()
+	period := env.seedUnavailability(t, "away", now.Add(-time.Hour), now.Add(24*time.Hour), true)
+
+	reassigned, err := env.service.ReassignStarted(env.ctx)
+	require.NoError(t, err)
+	assert.Zero(t, reassigned)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"away"}, pr.Reviewers)
+
+	stored, err := env.userRepo.GetUnavailability(env.ctx, period.ID)
+	require.NoError(t, err)
+	assert.NotNil(t, stored.ReassignedAt)
+}
+
+func TestGetAvailableReviewers_ExcludesUnavailableUsers(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "away", "later", "r3")
+
+	now := time.Now()
+	env.seedUnavailability(t, "away", now.Add(-time.Hour), now.Add(24*time.Hour), false)
+	env.seedUnavailability(t, "later", now.Add(24*time.Hour), now.Add(48*time.Hour), false)
+
+	candidates, err := env.prRepo.GetAvailableReviewers(env.ctx, "backend", nil)
+	require.NoError(t, err)
+
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserID
+	}
+	assert.ElementsMatch(t, []string{"later", "r3"}, ids)
+}
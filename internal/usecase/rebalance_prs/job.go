@@ -0,0 +1,37 @@
+package rebalance_prs
+
+import (
+	"context"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/worker"
+)
+
+// systemActor is recorded as the actor of the reconciler's assignments.
+const systemActor = "system:rebalance"
+
+type Config struct {
+	Interval time.Duration `env:"REBALANCE_INTERVAL" envDefault:"1m"`
+}
+
+func LoadConfig() (*Config, error) {
+	cfg := &Config{}
+	if err := env.Parse(cfg); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+// Job rebalances the pull requests of all teams.
+func (s *Service) Job() worker.Job {
+	return worker.Job{
+		Name:   "rebalance pull requests",
+		Report: "rebalanced %d pull requests",
+		Run: func(ctx context.Context) (int, error) {
+			rebalanced, err := s.Rebalance(ctx, "", systemActor)
+			return len(rebalanced), err
+		},
+	}
+}
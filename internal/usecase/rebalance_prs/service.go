@@ -0,0 +1,106 @@
+package rebalance_prs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type RebalancedPR struct {
+	PRID  string
+	Added []models.Reviewer
+}
+
+type Service struct {
+	prRepo   prRepo
+	selector reviewerSelector
+}
+
+func New(prRepo prRepo, selector reviewerSelector) *Service {
+	return &Service{
+		prRepo:   prRepo,
+		selector: selector,
+	}
+}
+
+// Rebalance tops up reviewable pull requests that have fewer reviewers than the
+// max_reviewers of the author's team. An empty teamName covers all teams. A PR
+// that fails to be topped up does not stop the others: the rebalanced PRs are
+// returned together with the joined errors.
+func (s *Service) Rebalance(ctx context.Context, teamName, actor string) ([]RebalancedPR, error) {
+	prs, err := s.prRepo.GetUnderstaffedOpenPRs(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get understaffed PRs: %w", err)
+	}
+
+	res := make([]RebalancedPR, 0)
+	pools := make(map[string]*reviewer_selection.Pool)
+	var errs []error
+
+	for _, pr := range prs {
+		pool, ok := pools[pr.TeamName]
+		if !ok {
+			pool, err = s.selector.TeamPool(ctx, pr.TeamName, nil)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("get reviewer pool of %s: %w", pr.TeamName, err))
+				continue
+			}
+			pools[pr.TeamName] = pool
+		}
+
+		added, err := s.rebalancePR(ctx, pool, pr, actor)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("rebalance %s: %w", pr.PRID, err))
+			continue
+		}
+		if len(added) == 0 {
+			continue
+		}
+
+		res = append(res, RebalancedPR{
+			PRID:  pr.PRID,
+			Added: added,
+		})
+	}
+
+	return res, errors.Join(errs...)
+}
+
+// rebalancePR re-reads the PR under its row lock, so that a concurrent status
+// change or reviewer change wins, and adds the missing reviewers.
+func (s *Service) rebalancePR(ctx context.Context, pool *reviewer_selection.Pool, pr pull_request.UnderstaffedPR, actor string) ([]models.Reviewer, error) {
+	var added []models.Reviewer
+	err := s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		locked, err := s.prRepo.GetPRByIDForUpdate(ctx, tx, pr.PRID)
+		if err != nil {
+			if errors.Is(err, pull_request.ErrPRNotFound) {
+				return nil
+			}
+			return fmt.Errorf("lock PR: %w", err)
+		}
+		if !pr_lifecycle.IsReviewable(locked.Status.Name) {
+			return nil
+		}
+
+		excludeIDs := append([]string{locked.AuthorID}, locked.Reviewers...)
+		added = pool.Pick(excludeIDs, pr.TargetReviewers-len(locked.Reviewers))
+		if len(added) == 0 {
+			return nil
+		}
+
+		if err := s.prRepo.InsertReviewers(ctx, tx, pr.PRID, added); err != nil {
+			return fmt.Errorf("insert reviewers: %w", err)
+		}
+		return s.prRepo.InsertAssignmentEvents(ctx, tx, reviewer_selection.AssignEvents(pr.PRID, added, actor, "rebalance"))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return added, nil
+}
@@ -0,0 +1,176 @@
+package rebalance_prs_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	"github.com/loloneme/potential-waffle/internal/usecase/rebalance_prs"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *rebalance_prs.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := rebalance_prs.New(prRepo, reviewer_selection.New(teamRepo, prRepo))
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) insertPR(t *testing.T, prID, authorID, statusName string, reviewers []models.Reviewer) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(statusName))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: foundStatus.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return env.prRepo.InsertReviewers(ctx, tx, prID, reviewers)
+	})
+	require.NoError(t, err)
+}
+
+func TestService_Rebalance_TopsUpUnderstaffedOpenPRs(t *testing.T) {
+	env := setupTest(t)
+
+	teamName := "growing-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		_, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{})
+		return err
+	})
+	require.NoError(t, err)
+
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: teamName},
+			{ID: "reviewer-1", Username: "reviewer1", IsActive: true, TeamName: teamName},
+			{ID: "reviewer-2", Username: "reviewer2", IsActive: true, TeamName: teamName},
+			{ID: "newcomer", Username: "newcomer", IsActive: true, TeamName: teamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	env.insertPR(t, "pr-single", "author", "OPEN", []models.Reviewer{
+		{ReviewerID: "reviewer-1", SourceTeam: teamName},
+	})
+	env.insertPR(t, "pr-full", "author", "OPEN", []models.Reviewer{
+		{ReviewerID: "reviewer-1", SourceTeam: teamName},
+		{ReviewerID: "reviewer-2", SourceTeam: teamName},
+	})
+	env.insertPR(t, "pr-merged", "author", "MERGED", nil)
+
+	rebalanced, err := env.service.Rebalance(env.ctx, "", "admin")
+	require.NoError(t, err)
+	require.Len(t, rebalanced, 1)
+	assert.Equal(t, "pr-single", rebalanced[0].PRID)
+	require.Len(t, rebalanced[0].Added, 1)
+	assert.NotEqual(t, "author", rebalanced[0].Added[0].ReviewerID)
+	assert.NotEqual(t, "reviewer-1", rebalanced[0].Added[0].ReviewerID)
+	assert.Equal(t, teamName, rebalanced[0].Added[0].SourceTeam)
+
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-single")
+	require.NoError(t, err)
+	assert.Len(t, reviewers, 2)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-single")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "admin", events[0].Actor)
+
+	reviewers, err = env.prRepo.GetPullRequestReviewers(env.ctx, "pr-merged")
+	require.NoError(t, err)
+	assert.Empty(t, reviewers)
+
+	rebalanced, err = env.service.Rebalance(env.ctx, "", "admin")
+	require.NoError(t, err)
+	assert.Empty(t, rebalanced)
+}
+
+func TestService_Rebalance_FiltersByTeam(t *testing.T) {
+	env := setupTest(t)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		for _, name := range []string{"team-a", "team-b"} {
+			if _, err := env.teamRepo.CreateTeam(ctx, tx, name, models.TeamSettings{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		users := []models.User{
+			{ID: "a-author", Username: "a-author", IsActive: true, TeamName: "team-a"},
+			{ID: "a-reviewer", Username: "a-reviewer", IsActive: true, TeamName: "team-a"},
+			{ID: "b-author", Username: "b-author", IsActive: true, TeamName: "team-b"},
+			{ID: "b-reviewer", Username: "b-reviewer", IsActive: true, TeamName: "team-b"},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	env.insertPR(t, "pr-a", "a-author", "OPEN", nil)
+	env.insertPR(t, "pr-b", "b-author", "OPEN", nil)
+
+	rebalanced, err := env.service.Rebalance(env.ctx, "team-b", "admin")
+	require.NoError(t, err)
+	require.Len(t, rebalanced, 1)
+	assert.Equal(t, "pr-b", rebalanced[0].PRID)
+
+	reviewers, err := env.prRepo.GetPullRequestReviewers(env.ctx, "pr-a")
+	require.NoError(t, err)
+	assert.Empty(t, reviewers)
+}
@@ -0,0 +1,84 @@
+package rename_team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	teamRepo teamRepo
+	userRepo userRepo
+}
+
+func New(teamRepo teamRepo, userRepo userRepo) *Service {
+	return &Service{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+	}
+}
+
+// RenameTeam moves the team to a new name. Members, fallback links, webhook
+// subscriptions and the team history follow the rename, so nothing refers to
+// the old name afterwards.
+func (s *Service) RenameTeam(ctx context.Context, teamName, newTeamName, actor string) (models.Team, error) {
+	if newTeamName == "" {
+		return models.Team{}, rpc_errors.NewBadRequest("new_team_name is required")
+	}
+	if newTeamName == teamName {
+		return models.Team{}, rpc_errors.NewBadRequest("new_team_name must differ from team_name")
+	}
+
+	current, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return models.Team{}, rpc_errors.NewNotFound("team not found")
+		}
+		return models.Team{}, fmt.Errorf("find team: %w", err)
+	}
+	if current.ArchivedAt != nil {
+		return models.Team{}, rpc_errors.NewTeamArchived("archived teams cannot be renamed")
+	}
+
+	err = s.teamRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if err := s.teamRepo.RenameTeam(ctx, tx, teamName, newTeamName); err != nil {
+			return err
+		}
+
+		return s.teamRepo.InsertTeamEvents(ctx, tx, []models.TeamEvent{{
+			TeamName: newTeamName,
+			Type:     models.TeamEventRenamed,
+			OldValue: teamName,
+			NewValue: newTeamName,
+			Actor:    actor,
+		}})
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, team.ErrAlreadyExists):
+			return models.Team{}, rpc_errors.NewTeamExists(fmt.Sprintf("team %s already exists", newTeamName))
+		case errors.Is(err, team.ErrNotFound):
+			return models.Team{}, rpc_errors.NewNotFound("team not found")
+		}
+		return models.Team{}, fmt.Errorf("rename team: %w", err)
+	}
+
+	renamed, err := s.teamRepo.FindTeamByID(ctx, newTeamName)
+	if err != nil {
+		return models.Team{}, fmt.Errorf("find renamed team: %w", err)
+	}
+
+	members, err := s.userRepo.Find(ctx, user_spec.NewGetUsersByTeamNameSpec(newTeamName))
+	if err != nil {
+		return models.Team{}, fmt.Errorf("find team members: %w", err)
+	}
+	renamed.Members = members
+
+	return renamed, nil
+}
@@ -0,0 +1,125 @@
+package rename_team_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/rename_team"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	teamRepo *team.Repository
+	service  *rename_team.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, team_fallbacks, team_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		service:  rename_team.New(teamRepo, userRepo),
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, fallbackTeams []string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		if len(fallbackTeams) > 0 {
+			if err := env.teamRepo.SetFallbackTeams(ctx, tx, teamName, fallbackTeams); err != nil {
+				return err
+			}
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_RenameTeam(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "platform", nil, "p1")
+	env.seedTeam(t, "backend", []string{"platform"}, "u1", "u2")
+	env.seedTeam(t, "frontend", []string{"backend"}, "f1")
+
+	renamed, err := env.service.RenameTeam(env.ctx, "backend", "core", "admin")
+	require.NoError(t, err)
+	assert.Equal(t, "core", renamed.TeamName)
+	assert.Equal(t, []string{"platform"}, renamed.FallbackTeams)
+	assert.Len(t, renamed.Members, 2)
+
+	stored, err := env.userRepo.GetUserByID(env.ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "core", stored.TeamName)
+
+	fallbacks, err := env.teamRepo.GetFallbackTeams(env.ctx, "frontend")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"core"}, fallbacks)
+
+	_, err = env.teamRepo.FindTeamByID(env.ctx, "backend")
+	assert.ErrorIs(t, err, team.ErrNotFound)
+
+	events, err := env.teamRepo.GetTeamEvents(env.ctx, "core")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.TeamEventRenamed, events[0].Type)
+	assert.Equal(t, "backend", events[0].OldValue)
+	assert.Equal(t, "core", events[0].NewValue)
+	assert.Equal(t, "admin", events[0].Actor)
+}
+
+func TestService_RenameTeam_NameTaken(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", nil, "u1")
+	env.seedTeam(t, "frontend", nil, "f1")
+
+	_, err := env.service.RenameTeam(env.ctx, "backend", "frontend", "admin")
+	var exists *rpc_errors.TeamExistsError
+	assert.ErrorAs(t, err, &exists)
+
+	stored, err := env.userRepo.GetUserByID(env.ctx, "u1")
+	require.NoError(t, err)
+	assert.Equal(t, "backend", stored.TeamName)
+}
+
+func TestService_RenameTeam_NotFound(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.RenameTeam(env.ctx, "ghost", "core", "admin")
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
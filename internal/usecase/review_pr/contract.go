@@ -0,0 +1,17 @@
+package review_pr
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type prRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	GetPRByID(ctx context.Context, prID string) (models.PullRequest, error)
+	SetReviewState(ctx context.Context, tx *sqlx.Tx, prID, reviewerID, state string) error
+	InsertReviewEvent(ctx context.Context, tx *sqlx.Tx, event models.ReviewEvent) error
+	GetReviewEvents(ctx context.Context, prID string) ([]models.ReviewEvent, error)
+}
@@ -0,0 +1,96 @@
+package review_pr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	prRepo prRepo
+}
+
+func New(prRepo prRepo) *Service {
+	return &Service{
+		prRepo: prRepo,
+	}
+}
+
+// SubmitReview records a reviewer's verdict. APPROVED and CHANGES_REQUESTED replace
+// the reviewer's current state, COMMENTED only moves it out of PENDING; every
+// verdict is appended to the PR's review history.
+func (s *Service) SubmitReview(ctx context.Context, prID, reviewerID, state, comment string) (models.PullRequest, []models.ReviewEvent, error) {
+	switch state {
+	case models.ReviewStateApproved, models.ReviewStateChangesRequested, models.ReviewStateCommented:
+	default:
+		return models.PullRequest{}, nil, rpc_errors.NewBadRequest("unknown review state")
+	}
+
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pull_request.ErrPRNotFound) {
+			return models.PullRequest{}, nil, rpc_errors.NewNotFound("PR not found")
+		}
+		return models.PullRequest{}, nil, fmt.Errorf("get PR: %w", err)
+	}
+
+	if pr.Status.Name == "MERGED" {
+		return models.PullRequest{}, nil, rpc_errors.NewPRMerged("cannot review merged PR")
+	}
+	if pr.Status.Name == models.StatusClosed {
+		return models.PullRequest{}, nil, rpc_errors.NewPRClosed("cannot review closed PR")
+	}
+
+	var assignment *models.Reviewer
+	for i := range pr.Assignments {
+		if pr.Assignments[i].ReviewerID == reviewerID {
+			assignment = &pr.Assignments[i]
+			break
+		}
+	}
+	if assignment == nil {
+		return models.PullRequest{}, nil, rpc_errors.NewNotAssigned("reviewer is not assigned to this PR")
+	}
+
+	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if state != models.ReviewStateCommented || assignment.ReviewState == models.ReviewStatePending {
+			if err := s.prRepo.SetReviewState(ctx, tx, prID, reviewerID, state); err != nil {
+				if errors.Is(err, pull_request.ErrReviewerNotAssigned) {
+					return rpc_errors.NewNotAssigned("reviewer is not assigned to this PR")
+				}
+				return fmt.Errorf("set review state: %w", err)
+			}
+		}
+
+		err := s.prRepo.InsertReviewEvent(ctx, tx, models.ReviewEvent{
+			PullRequestID: prID,
+			ReviewerID:    reviewerID,
+			State:         state,
+			Comment:       comment,
+		})
+		if err != nil {
+			return fmt.Errorf("insert review event: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, nil, err
+	}
+
+	reviewedPR, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		return models.PullRequest{}, nil, fmt.Errorf("get reviewed PR: %w", err)
+	}
+
+	events, err := s.prRepo.GetReviewEvents(ctx, prID)
+	if err != nil {
+		return models.PullRequest{}, nil, fmt.Errorf("get review events: %w", err)
+	}
+
+	return reviewedPR, events, nil
+}
@@ -0,0 +1,152 @@
+package review_pr_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/review_pr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *review_pr.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := review_pr.New(prRepo)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedPR(t *testing.T, prID, statusName string) {
+	t.Helper()
+
+	teamName := "review-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+
+		users := []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: teamName},
+			{ID: "reviewer", Username: "reviewer", IsActive: true, TeamName: teamName},
+			{ID: "outsider", Username: "outsider", IsActive: true, TeamName: teamName},
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(statusName))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     "Review PR",
+			AuthorID: "author",
+			StatusID: foundStatus.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return env.prRepo.InsertReviewers(ctx, tx, prID, []models.Reviewer{{ReviewerID: "reviewer", SourceTeam: teamName}})
+	})
+	require.NoError(t, err)
+}
+
+func TestService_SubmitReview_RecordsStateAndHistory(t *testing.T) {
+	env := setupTest(t)
+	env.seedPR(t, "pr-review", "OPEN")
+
+	pr, events, err := env.service.SubmitReview(env.ctx, "pr-review", "reviewer", models.ReviewStateChangesRequested, "please add tests")
+	require.NoError(t, err)
+	require.Len(t, pr.Assignments, 1)
+	assert.Equal(t, models.ReviewStateChangesRequested, pr.Assignments[0].ReviewState)
+	assert.NotNil(t, pr.Assignments[0].ReviewedAt)
+	require.Len(t, events, 1)
+	assert.Equal(t, "please add tests", events[0].Comment)
+
+	pr, _, err = env.service.SubmitReview(env.ctx, "pr-review", "reviewer", models.ReviewStateCommented, "working on it?")
+	require.NoError(t, err)
+	assert.Equal(t, models.ReviewStateChangesRequested, pr.Assignments[0].ReviewState)
+
+	pr, events, err = env.service.SubmitReview(env.ctx, "pr-review", "reviewer", models.ReviewStateApproved, "")
+	require.NoError(t, err)
+	assert.Equal(t, models.ReviewStateApproved, pr.Assignments[0].ReviewState)
+	require.Len(t, events, 3)
+	assert.Equal(t, []string{
+		models.ReviewStateChangesRequested,
+		models.ReviewStateCommented,
+		models.ReviewStateApproved,
+	}, []string{events[0].State, events[1].State, events[2].State})
+}
+
+func TestService_SubmitReview_ReviewerNotAssigned(t *testing.T) {
+	env := setupTest(t)
+	env.seedPR(t, "pr-outsider", "OPEN")
+
+	_, _, err := env.service.SubmitReview(env.ctx, "pr-outsider", "outsider", models.ReviewStateApproved, "")
+	require.Error(t, err)
+	var notAssignedErr *rpc_errors.NotAssignedError
+	assert.ErrorAs(t, err, &notAssignedErr)
+}
+
+func TestService_SubmitReview_MergedPR(t *testing.T) {
+	env := setupTest(t)
+	env.seedPR(t, "pr-merged", "MERGED")
+
+	_, _, err := env.service.SubmitReview(env.ctx, "pr-merged", "reviewer", models.ReviewStateApproved, "")
+	require.Error(t, err)
+	var mergedErr *rpc_errors.PRMergedError
+	assert.ErrorAs(t, err, &mergedErr)
+}
+
+func TestService_SubmitReview_UnknownState(t *testing.T) {
+	env := setupTest(t)
+
+	_, _, err := env.service.SubmitReview(env.ctx, "pr-any", "reviewer", models.ReviewStatePending, "")
+	require.Error(t, err)
+	var badRequestErr *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
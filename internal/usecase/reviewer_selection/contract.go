@@ -0,0 +1,17 @@
+package reviewer_selection
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type teamRepo interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	GetCodeOwners(ctx context.Context, teamName string) (models.CodeOwners, error)
+}
+
+type prRepo interface {
+	GetAvailableReviewers(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
+	GetReviewersAtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error)
+}
@@ -0,0 +1,42 @@
+package reviewer_selection
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type teamFinder interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+}
+
+// ValidateFallbackTeams checks that fallbackTeams are existing, not archived
+// teams other than teamName itself, each listed once.
+func ValidateFallbackTeams(ctx context.Context, teams teamFinder, teamName string, fallbackTeams []string) error {
+	seen := make(map[string]bool, len(fallbackTeams))
+	for _, fallback := range fallbackTeams {
+		if fallback == teamName {
+			return rpc_errors.NewBadRequest("team cannot be its own fallback")
+		}
+		if seen[fallback] {
+			return rpc_errors.NewBadRequest(fmt.Sprintf("duplicate fallback team %s", fallback))
+		}
+		seen[fallback] = true
+
+		found, err := teams.FindTeamByID(ctx, fallback)
+		if err != nil {
+			if errors.Is(err, team.ErrNotFound) {
+				return rpc_errors.NewBadRequest(fmt.Sprintf("fallback team %s not found", fallback))
+			}
+			return fmt.Errorf("find fallback team: %w", err)
+		}
+		if found.ArchivedAt != nil {
+			return rpc_errors.NewTeamArchived(fmt.Sprintf("fallback team %s is archived", fallback))
+		}
+	}
+	return nil
+}
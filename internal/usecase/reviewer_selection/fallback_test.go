@@ -0,0 +1,56 @@
+package reviewer_selection
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+type stubTeamFinder map[string]models.Team
+
+func (f stubTeamFinder) FindTeamByID(_ context.Context, teamName string) (models.Team, error) {
+	found, ok := f[teamName]
+	if !ok {
+		return models.Team{}, team.ErrNotFound
+	}
+	return found, nil
+}
+
+func TestValidateFallbackTeams(t *testing.T) {
+	archivedAt := time.Now()
+	teams := stubTeamFinder{
+		"backend":  {TeamName: "backend"},
+		"frontend": {TeamName: "frontend"},
+		"platform": {TeamName: "platform"},
+		"legacy":   {TeamName: "legacy", ArchivedAt: &archivedAt},
+	}
+
+	tests := []struct {
+		name      string
+		fallbacks []string
+		wantErr   error
+	}{
+		{name: "valid", fallbacks: []string{"frontend", "platform"}},
+		{name: "empty", fallbacks: nil},
+		{name: "self", fallbacks: []string{"backend"}, wantErr: &rpc_errors.BadRequestError{}},
+		{name: "duplicate", fallbacks: []string{"frontend", "frontend"}, wantErr: &rpc_errors.BadRequestError{}},
+		{name: "unknown", fallbacks: []string{"mobile"}, wantErr: &rpc_errors.BadRequestError{}},
+		{name: "archived", fallbacks: []string{"frontend", "legacy"}, wantErr: &rpc_errors.TeamArchivedError{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFallbackTeams(context.Background(), teams, "backend", tt.fallbacks)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.IsType(t, tt.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package reviewer_selection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+// Fill holds the reviewers picked by FillReviewers, grouped by why they were
+// picked.
+type Fill struct {
+	Owners  []models.Reviewer
+	Skilled []models.Reviewer
+	Pooled  []models.Reviewer
+	// MissingSkills are the required skills that neither the assigned nor the
+	// picked reviewers cover.
+	MissingSkills []string
+}
+
+// Reviewers returns all picked reviewers: owners, then skilled, then pooled.
+func (f Fill) Reviewers() []models.Reviewer {
+	res := make([]models.Reviewer, 0, len(f.Owners)+len(f.Skilled)+len(f.Pooled))
+	res = append(res, f.Owners...)
+	res = append(res, f.Skilled...)
+	return append(res, f.Pooled...)
+}
+
+// Events returns the assignment events of the picked reviewers. Owners and
+// skilled reviewers get their own reasons, the pooled ones get reason.
+func (f Fill) Events(prID, actor, reason string) []models.AssignmentEvent {
+	events := AssignEvents(prID, f.Owners, actor, "code owner")
+	for _, r := range f.Skilled {
+		events = append(events, AssignEvents(prID, []models.Reviewer{r}, actor, "required skills: "+strings.Join(r.MatchedSkills, ", "))...)
+	}
+	return append(events, AssignEvents(prID, f.Pooled, actor, reason)...)
+}
+
+// FillReviewers tops a pull request of the pool's team up to max_reviewers on
+// top of the already assigned reviewers: code owners of the changed paths come
+// first, then reviewers covering the required skills the others lack, then the
+// pool fills the remaining slots. Neither the assigned reviewers nor
+// excludeIDs are picked.
+func (s *Service) FillReviewers(ctx context.Context, pool *Pool, paths, requiredSkills []string, assigned []models.Reviewer, excludeIDs []string) (Fill, error) {
+	var fill Fill
+
+	_, maxReviewers := pool.Quota()
+	free := maxReviewers - len(assigned)
+	if free <= 0 {
+		return fill, nil
+	}
+
+	exclude := append(append([]string{}, excludeIDs...), ReviewerIDs(assigned)...)
+	owners, err := s.PickOwners(ctx, pool.team.TeamName, paths, exclude)
+	if err != nil {
+		return fill, fmt.Errorf("pick code owners: %w", err)
+	}
+	fill.Owners = owners[:min(len(owners), free)]
+
+	picked := append(append([]models.Reviewer{}, assigned...), fill.Owners...)
+	skilled, missing := pool.PickSkilled(picked, requiredSkills, maxReviewers-len(picked))
+	fill.Skilled = pool.MatchSkills(skilled, requiredSkills)
+	fill.MissingSkills = missing
+
+	picked = append(picked, fill.Skilled...)
+	fill.Pooled = pool.Pick(append(exclude, ReviewerIDs(picked)...), maxReviewers-len(picked))
+
+	return fill, nil
+}
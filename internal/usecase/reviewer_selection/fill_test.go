@@ -0,0 +1,70 @@
+package reviewer_selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_FillReviewers(t *testing.T) {
+	newService := func() *Service {
+		return New(
+			stubTeamRepo{
+				team:       models.Team{TeamName: "backend", MaxReviewers: 3, AssignmentStrategy: LeastLoadedStrategy},
+				codeOwners: "/payments/ @acme/payments\n",
+			},
+			stubPRRepo{candidates: []models.Candidate{
+				{UserID: "b1", TeamName: "backend", OpenReviews: 0},
+				{UserID: "b2", TeamName: "backend", OpenReviews: 1},
+				{UserID: "dba", TeamName: "backend", OpenReviews: 2, Skills: []string{"sql"}},
+				{UserID: "payer", TeamName: "payments"},
+			}},
+		)
+	}
+
+	t.Run("owners, then skills, then pool", func(t *testing.T) {
+		service := newService()
+		pool, err := service.TeamPool(context.Background(), "backend", nil)
+		require.NoError(t, err)
+
+		fill, err := service.FillReviewers(context.Background(), pool, []string{"payments/ledger.go"}, []string{"sql"}, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "payer", SourceTeam: "payments"}}, fill.Owners)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "dba", SourceTeam: "backend", MatchedSkills: []string{"sql"}}}, fill.Skilled)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "b1", SourceTeam: "backend"}}, fill.Pooled)
+		assert.Empty(t, fill.MissingSkills)
+
+		events := fill.Events("pr-1", "author", "pull request created")
+		require.Len(t, events, 3)
+		assert.Equal(t, "code owner", events[0].Reason)
+		assert.Equal(t, "required skills: sql", events[1].Reason)
+		assert.Equal(t, "pull request created", events[2].Reason)
+	})
+
+	t.Run("tops up around assigned reviewers", func(t *testing.T) {
+		service := newService()
+		pool, err := service.TeamPool(context.Background(), "backend", nil)
+		require.NoError(t, err)
+
+		assigned := []models.Reviewer{{ReviewerID: "b1", SourceTeam: "backend"}, {ReviewerID: "b2", SourceTeam: "backend"}}
+		fill, err := service.FillReviewers(context.Background(), pool, nil, []string{"sql", "rust"}, assigned, nil)
+		require.NoError(t, err)
+		assert.Empty(t, fill.Owners)
+		assert.Equal(t, []string{"dba"}, ReviewerIDs(fill.Reviewers()))
+		assert.Equal(t, []string{"rust"}, fill.MissingSkills)
+	})
+
+	t.Run("full PR", func(t *testing.T) {
+		service := newService()
+		pool, err := service.TeamPool(context.Background(), "backend", nil)
+		require.NoError(t, err)
+
+		assigned := []models.Reviewer{{ReviewerID: "b1"}, {ReviewerID: "b2"}, {ReviewerID: "dba"}}
+		fill, err := service.FillReviewers(context.Background(), pool, []string{"payments/ledger.go"}, nil, assigned, nil)
+		require.NoError(t, err)
+		assert.Empty(t, fill.Reviewers())
+	})
+}
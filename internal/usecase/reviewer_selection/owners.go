@@ -0,0 +1,84 @@
+package reviewer_selection
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+// PickOwners picks one code owner for every rule of the team's CODEOWNERS
+// document that matches the changed paths. Owners may come from any team. A
+// rule already covered by an owner picked for an earlier rule needs no extra
+// reviewer; a rule without an available owner is skipped.
+func (s *Service) PickOwners(ctx context.Context, teamName string, paths []string, excludeIDs []string) ([]models.Reviewer, error) {
+	if len(paths) == 0 {
+		return nil, nil
+	}
+
+	doc, err := s.teamRepo.GetCodeOwners(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("get code owners: %w", err)
+	}
+	if doc.Document == "" {
+		return nil, nil
+	}
+
+	rules, err := codeowners.Parse(doc.Document)
+	if err != nil {
+		return nil, fmt.Errorf("parse code owners of %s: %w", teamName, err)
+	}
+	matched := codeowners.Match(rules, paths)
+	if len(matched) == 0 {
+		return nil, nil
+	}
+
+	team, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("find team: %w", err)
+	}
+	strategy := s.strategy(team.AssignmentStrategy)
+
+	candidates, err := s.prRepo.GetAvailableReviewers(ctx, "", excludeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get available reviewers: %w", err)
+	}
+
+	var picked []models.Reviewer
+	pickedSet := make(map[string]bool)
+
+	for _, rule := range matched {
+		covered := false
+		for _, r := range picked {
+			if rule.Owns(r.ReviewerID, r.SourceTeam) {
+				covered = true
+				break
+			}
+		}
+		if covered {
+			continue
+		}
+
+		var eligible []models.Candidate
+		for _, c := range candidates {
+			if !pickedSet[c.UserID] && rule.Owns(c.UserID, c.TeamName) {
+				eligible = append(eligible, c)
+			}
+		}
+
+		ids := strategy.Pick(teamName+":"+rule.Pattern, eligible, 1)
+		if len(ids) == 0 {
+			continue
+		}
+		for _, c := range eligible {
+			if c.UserID == ids[0] {
+				picked = append(picked, models.Reviewer{ReviewerID: c.UserID, SourceTeam: c.TeamName})
+				pickedSet[c.UserID] = true
+				break
+			}
+		}
+	}
+
+	return picked, nil
+}
@@ -0,0 +1,54 @@
+package reviewer_selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PickOwners(t *testing.T) {
+	service := New(
+		stubTeamRepo{
+			team: models.Team{TeamName: "backend", AssignmentStrategy: LeastLoadedStrategy},
+			codeOwners: `
+*             @acme/backend
+/payments/    @alice @bob
+/payments/api @acme/payments
+/docs/        @nobody
+`,
+		},
+		stubPRRepo{candidates: []models.Candidate{
+			{UserID: "u1", TeamName: "backend", OpenReviews: 0},
+			{UserID: "alice", TeamName: "backend", OpenReviews: 3},
+			{UserID: "bob", TeamName: "payments", OpenReviews: 1},
+			{UserID: "p2", TeamName: "payments", OpenReviews: 0},
+		}},
+	)
+
+	t.Run("one owner per matched rule", func(t *testing.T) {
+		owners, err := service.PickOwners(context.Background(), "backend", []string{"payments/ledger.go", "docs/readme.md"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "bob", SourceTeam: "payments"}}, owners)
+	})
+
+	t.Run("owner picked for an earlier rule covers a later one", func(t *testing.T) {
+		owners, err := service.PickOwners(context.Background(), "backend", []string{"payments/ledger.go", "payments/api/handler.go"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "bob", SourceTeam: "payments"}}, owners)
+	})
+
+	t.Run("catch-all team rule", func(t *testing.T) {
+		owners, err := service.PickOwners(context.Background(), "backend", []string{"cmd/main.go"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "u1", SourceTeam: "backend"}}, owners)
+	})
+
+	t.Run("no paths", func(t *testing.T) {
+		owners, err := service.PickOwners(context.Background(), "backend", nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, owners)
+	})
+}
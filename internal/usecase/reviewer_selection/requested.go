@@ -0,0 +1,39 @@
+package reviewer_selection
+
+import "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+
+// PickRequested takes the reviewers requested by the author from the pool, in
+// the given order. It returns them together with the requested users the pool
+// cannot offer: the ones outside the team and its fallback teams, inactive,
+// unavailable or at their open review limit.
+func (p *Pool) PickRequested(ids []string) ([]models.Reviewer, []string) {
+	var picked []models.Reviewer
+	var rejected []string
+	for _, id := range ids {
+		found := false
+		for _, c := range p.candidates {
+			if c.UserID == id && !AtLimit(c) {
+				picked = append(picked, models.Reviewer{ReviewerID: id, SourceTeam: c.TeamName})
+				found = true
+				break
+			}
+		}
+		if !found {
+			rejected = append(rejected, id)
+		}
+	}
+
+	p.bump(picked)
+	return picked, rejected
+}
+
+// HasTier reports whether members of teamName may review for the pool's team,
+// i.e. whether it is the team itself or one of its fallback teams.
+func (p *Pool) HasTier(teamName string) bool {
+	for _, tier := range p.tiers {
+		if tier == teamName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,39 @@
+package reviewer_selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_PickRequested(t *testing.T) {
+	service := New(
+		stubTeamRepo{team: models.Team{TeamName: "backend", AssignmentStrategy: LeastLoadedStrategy, FallbackTeams: []string{"platform"}}},
+		stubPRRepo{candidates: []models.Candidate{
+			{UserID: "b1", TeamName: "backend", OpenReviews: 0},
+			{UserID: "b2", TeamName: "backend", OpenReviews: 1},
+			{UserID: "busy", TeamName: "backend", OpenReviews: 2, MaxOpenReviews: 2},
+			{UserID: "p1", TeamName: "platform", OpenReviews: 0},
+		}},
+	)
+
+	pool, err := service.TeamPool(context.Background(), "backend", nil)
+	require.NoError(t, err)
+
+	picked, rejected := pool.PickRequested([]string{"p1", "busy", "b2", "ghost"})
+	assert.Equal(t, []models.Reviewer{
+		{ReviewerID: "p1", SourceTeam: "platform"},
+		{ReviewerID: "b2", SourceTeam: "backend"},
+	}, picked)
+	assert.Equal(t, []string{"busy", "ghost"}, rejected)
+
+	rest := pool.Pick(ReviewerIDs(picked), 2)
+	assert.Equal(t, []models.Reviewer{{ReviewerID: "b1", SourceTeam: "backend"}}, rest)
+
+	assert.True(t, pool.HasTier("backend"))
+	assert.True(t, pool.HasTier("platform"))
+	assert.False(t, pool.HasTier("frontend"))
+}
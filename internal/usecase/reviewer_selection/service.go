@@ -0,0 +1,254 @@
+package reviewer_selection
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type Service struct {
+	teamRepo   teamRepo
+	prRepo     prRepo
+	strategies map[string]Strategy
+}
+
+func New(teamRepo teamRepo, prRepo prRepo) *Service {
+	return &Service{
+		teamRepo:   teamRepo,
+		prRepo:     prRepo,
+		strategies: NewStrategies(),
+	}
+}
+
+// Pool holds the active candidates of a team and of its fallback teams together
+// with the team's strategy. Picks from a pool bump the candidates' load so that
+// several picks made before a commit stay balanced.
+type Pool struct {
+	team       models.Team
+	strategy   Strategy
+	tiers      []string
+	candidates []models.Candidate
+}
+
+func (s *Service) SelectReviewers(ctx context.Context, teamName string, excludeIDs []string, n int) ([]models.Reviewer, error) {
+	pool, err := s.TeamPool(ctx, teamName, excludeIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return pool.Pick(nil, n), nil
+}
+
+func (s *Service) TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*Pool, error) {
+	team, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("find team: %w", err)
+	}
+
+	tiers := append([]string{teamName}, team.FallbackTeams...)
+
+	var candidates []models.Candidate
+	for _, tier := range tiers {
+		tierCandidates, err := s.prRepo.GetAvailableReviewers(ctx, tier, excludeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("get available reviewers of %s: %w", tier, err)
+		}
+		candidates = append(candidates, tierCandidates...)
+	}
+
+	return &Pool{
+		team:       team,
+		strategy:   s.strategy(team.AssignmentStrategy),
+		tiers:      tiers,
+		candidates: candidates,
+	}, nil
+}
+
+// CrossTeamPool is like TeamPool but falls back to every other team, in name
+// order, once the team and its fallback teams are exhausted.
+func (s *Service) CrossTeamPool(ctx context.Context, teamName string, excludeIDs []string) (*Pool, error) {
+	team, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("find team: %w", err)
+	}
+
+	candidates, err := s.prRepo.GetAvailableReviewers(ctx, "", excludeIDs)
+	if err != nil {
+		return nil, fmt.Errorf("get available reviewers: %w", err)
+	}
+
+	tiers := append([]string{teamName}, team.FallbackTeams...)
+	seen := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		seen[tier] = true
+	}
+
+	var others []string
+	for _, c := range candidates {
+		if !seen[c.TeamName] {
+			seen[c.TeamName] = true
+			others = append(others, c.TeamName)
+		}
+	}
+	sort.Strings(others)
+
+	return &Pool{
+		team:       team,
+		strategy:   s.strategy(team.AssignmentStrategy),
+		tiers:      append(tiers, others...),
+		candidates: candidates,
+	}, nil
+}
+
+// AtCapacity returns the candidates of the team and its fallback teams that are
+// skipped only because they have reached their open review limit.
+func (s *Service) AtCapacity(ctx context.Context, teamName string, excludeIDs []string) ([]models.Candidate, error) {
+	team, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		return nil, fmt.Errorf("find team: %w", err)
+	}
+
+	var res []models.Candidate
+	for _, tier := range append([]string{teamName}, team.FallbackTeams...) {
+		tierCandidates, err := s.prRepo.GetReviewersAtCapacity(ctx, tier, excludeIDs)
+		if err != nil {
+			return nil, fmt.Errorf("get reviewers at capacity of %s: %w", tier, err)
+		}
+		res = append(res, tierCandidates...)
+	}
+	return res, nil
+}
+
+func (s *Service) strategy(name string) Strategy {
+	if strategy, ok := s.strategies[name]; ok {
+		return strategy
+	}
+	return s.strategies[DefaultStrategy]
+}
+
+// Quota returns how many reviewers a pull request of the pool's team must have
+// and how many it may have at most.
+func (p *Pool) Quota() (required, maxReviewers int) {
+	required, maxReviewers = p.team.RequiredReviewers, p.team.MaxReviewers
+	if maxReviewers <= 0 {
+		maxReviewers = DefaultMaxReviewers
+	}
+	return min(required, maxReviewers), maxReviewers
+}
+
+// Preview returns a copy of the pool for dry runs: its picks change neither the
+// pool's load nor the strategy state, such as the round_robin cursor. Only
+// round_robin previews are binding; random and weighted picks, and least_loaded
+// ties, are drawn anew by the pool.
+func (p *Pool) Preview() *Pool {
+	strategy := p.strategy
+	if stateful, ok := strategy.(statefulStrategy); ok {
+		strategy = stateful.snapshot()
+	}
+
+	return &Pool{
+		team:       p.team,
+		strategy:   strategy,
+		tiers:      p.tiers,
+		candidates: append([]models.Candidate(nil), p.candidates...),
+	}
+}
+
+// Empty reports whether the pool has no candidates at all.
+func (p *Pool) Empty() bool {
+	return len(p.candidates) == 0
+}
+
+// LimitedOut reports whether some candidate outside excludeIDs is left out by
+// Pick only because they have reached their open review limit.
+func (p *Pool) LimitedOut(excludeIDs []string) bool {
+	skip := make(map[string]bool, len(excludeIDs))
+	for _, id := range excludeIDs {
+		skip[id] = true
+	}
+	for _, c := range p.candidates {
+		if !skip[c.UserID] && AtLimit(c) {
+			return true
+		}
+	}
+	return false
+}
+
+// Pick selects up to n reviewers, taking them from the team itself first and
+// falling back to the fallback teams in order while slots remain unfilled.
+func (p *Pool) Pick(excludeIDs []string, n int) []models.Reviewer {
+	if n <= 0 {
+		return nil
+	}
+
+	skip := make(map[string]bool, len(excludeIDs))
+	for _, id := range excludeIDs {
+		skip[id] = true
+	}
+
+	var picked []models.Reviewer
+	for _, tier := range p.tiers {
+		if len(picked) >= n {
+			break
+		}
+
+		eligible := make([]models.Candidate, 0, len(p.candidates))
+		for _, c := range p.candidates {
+			if c.TeamName == tier && !skip[c.UserID] && !AtLimit(c) {
+				eligible = append(eligible, c)
+			}
+		}
+
+		for _, id := range p.strategy.Pick(tier, eligible, n-len(picked)) {
+			skip[id] = true
+			picked = append(picked, models.Reviewer{ReviewerID: id, SourceTeam: tier})
+		}
+	}
+
+	p.bump(picked)
+	return picked
+}
+
+// bump accounts for the new assignments of picked in the candidates' load.
+func (p *Pool) bump(picked []models.Reviewer) {
+	pickedSet := make(map[string]bool, len(picked))
+	for _, r := range picked {
+		pickedSet[r.ReviewerID] = true
+	}
+	for i := range p.candidates {
+		if pickedSet[p.candidates[i].UserID] {
+			p.candidates[i].OpenReviews++
+		}
+	}
+}
+
+// AtLimit reports whether the candidate has reached their open review limit,
+// for example after picks made from the same pool.
+func AtLimit(c models.Candidate) bool {
+	return c.MaxOpenReviews > 0 && c.OpenReviews >= c.MaxOpenReviews
+}
+
+func ReviewerIDs(reviewers []models.Reviewer) []string {
+	ids := make([]string, len(reviewers))
+	for i, r := range reviewers {
+		ids[i] = r.ReviewerID
+	}
+	return ids
+}
+
+// AssignEvents builds ASSIGN audit events for reviewers newly added to a PR.
+func AssignEvents(prID string, reviewers []models.Reviewer, actor, reason string) []models.AssignmentEvent {
+	events := make([]models.AssignmentEvent, len(reviewers))
+	for i, r := range reviewers {
+		events[i] = models.AssignmentEvent{
+			PullRequestID: prID,
+			Type:          models.AssignmentEventAssign,
+			NewReviewerID: r.ReviewerID,
+			Actor:         actor,
+			Reason:        reason,
+		}
+	}
+	return events
+}
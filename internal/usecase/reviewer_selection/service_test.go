@@ -0,0 +1,100 @@
+package reviewer_selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTeamRepo struct {
+	team       models.Team
+	codeOwners string
+}
+
+func (r stubTeamRepo) FindTeamByID(context.Context, string) (models.Team, error) {
+	return r.team, nil
+}
+
+func (r stubTeamRepo) GetCodeOwners(_ context.Context, teamName string) (models.CodeOwners, error) {
+	return models.CodeOwners{TeamName: teamName, Document: r.codeOwners}, nil
+}
+
+type stubPRRepo struct {
+	candidates []models.Candidate
+}
+
+func (r stubPRRepo) GetAvailableReviewers(_ context.Context, teamName string, _ []string) ([]models.Candidate, error) {
+	var res []models.Candidate
+	for _, c := range r.candidates {
+		if (teamName == "" || c.TeamName == teamName) && !AtLimit(c) {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func (r stubPRRepo) GetReviewersAtCapacity(_ context.Context, teamName string, _ []string) ([]models.Candidate, error) {
+	var res []models.Candidate
+	for _, c := range r.candidates {
+		if (teamName == "" || c.TeamName == teamName) && AtLimit(c) {
+			res = append(res, c)
+		}
+	}
+	return res, nil
+}
+
+func TestService_CrossTeamPool(t *testing.T) {
+	service := New(
+		stubTeamRepo{team: models.Team{TeamName: "team-1", FallbackTeams: []string{"team-3"}}},
+		stubPRRepo{candidates: []models.Candidate{
+			{UserID: "u1", TeamName: "team-1"},
+			{UserID: "u4", TeamName: "team-4"},
+			{UserID: "u2", TeamName: "team-2"},
+			{UserID: "u3", TeamName: "team-3"},
+		}},
+	)
+
+	pool, err := service.CrossTeamPool(context.Background(), "team-1", nil)
+	require.NoError(t, err)
+	assert.False(t, pool.Empty())
+	assert.Equal(t, []string{"team-1", "team-3", "team-2", "team-4"}, pool.tiers)
+
+	assert.Equal(t, []models.Reviewer{
+		{ReviewerID: "u1", SourceTeam: "team-1"},
+		{ReviewerID: "u3", SourceTeam: "team-3"},
+		{ReviewerID: "u2", SourceTeam: "team-2"},
+	}, pool.Pick(nil, 3))
+
+	teamPool, err := service.TeamPool(context.Background(), "team-1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Reviewer{
+		{ReviewerID: "u1", SourceTeam: "team-1"},
+		{ReviewerID: "u3", SourceTeam: "team-3"},
+	}, teamPool.Pick(nil, 3))
+}
+
+func TestPool_PickRespectsOpenReviewLimit(t *testing.T) {
+	service := New(
+		stubTeamRepo{team: models.Team{TeamName: "team-1", AssignmentStrategy: LeastLoadedStrategy}},
+		stubPRRepo{candidates: []models.Candidate{
+			{UserID: "senior", TeamName: "team-1", OpenReviews: 3, MaxOpenReviews: 4},
+			{UserID: "full", TeamName: "team-1", OpenReviews: 2, MaxOpenReviews: 2},
+		}},
+	)
+
+	pool, err := service.TeamPool(context.Background(), "team-1", nil)
+	require.NoError(t, err)
+	assert.False(t, pool.LimitedOut(nil), "full is not in the pool")
+	assert.Equal(t, []models.Reviewer{{ReviewerID: "senior", SourceTeam: "team-1"}}, pool.Pick(nil, 2))
+	assert.Empty(t, pool.Pick(nil, 1), "the pick above used the last free slot")
+	assert.True(t, pool.LimitedOut([]string{"author"}))
+	assert.False(t, pool.LimitedOut([]string{"senior"}))
+
+	atCapacity, err := service.AtCapacity(context.Background(), "team-1", nil)
+	require.NoError(t, err)
+	require.Len(t, atCapacity, 1)
+	assert.Equal(t, "full", atCapacity[0].UserID)
+}
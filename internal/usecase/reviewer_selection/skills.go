@@ -0,0 +1,103 @@
+package reviewer_selection
+
+import (
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/skills"
+)
+
+// PickSkilled picks up to n reviewers for the required skills that the already
+// picked reviewers do not cover. Skills are handled in order: for each one still
+// uncovered the first tier with a candidate having it is used, and among its
+// candidates those covering the most uncovered skills are handed to the
+// strategy. It returns the new reviewers and the skills left uncovered.
+func (p *Pool) PickSkilled(picked []models.Reviewer, required []string, n int) ([]models.Reviewer, []string) {
+	if len(required) == 0 {
+		return nil, nil
+	}
+
+	skip := make(map[string]bool, len(picked))
+	covered := make(map[string]bool, len(required))
+	for _, r := range picked {
+		skip[r.ReviewerID] = true
+		for _, tag := range skills.Covered(p.skillsOf(r.ReviewerID), required) {
+			covered[tag] = true
+		}
+	}
+
+	var added []models.Reviewer
+	for _, tag := range required {
+		if covered[tag] || len(added) >= n {
+			continue
+		}
+
+		for _, tier := range p.tiers {
+			var eligible []models.Candidate
+			best := 0
+			for _, c := range p.candidates {
+				if c.TeamName != tier || skip[c.UserID] || AtLimit(c) || len(skills.Covered(c.Skills, []string{tag})) == 0 {
+					continue
+				}
+
+				gain := 0
+				for _, t := range skills.Covered(c.Skills, required) {
+					if !covered[t] {
+						gain++
+					}
+				}
+				switch {
+				case gain > best:
+					best = gain
+					eligible = []models.Candidate{c}
+				case gain == best:
+					eligible = append(eligible, c)
+				}
+			}
+
+			ids := p.strategy.Pick(tier+":"+tag, eligible, 1)
+			if len(ids) == 0 {
+				continue
+			}
+
+			skip[ids[0]] = true
+			added = append(added, models.Reviewer{ReviewerID: ids[0], SourceTeam: tier})
+			for _, t := range skills.Covered(p.skillsOf(ids[0]), required) {
+				covered[t] = true
+			}
+			break
+		}
+	}
+
+	var missing []string
+	for _, tag := range required {
+		if !covered[tag] {
+			missing = append(missing, tag)
+		}
+	}
+
+	p.bump(added)
+	return added, missing
+}
+
+// MatchSkills returns the reviewers with MatchedSkills set to the required
+// skills each of them has. Reviewers outside the pool match nothing.
+func (p *Pool) MatchSkills(reviewers []models.Reviewer, required []string) []models.Reviewer {
+	if len(required) == 0 {
+		return reviewers
+	}
+
+	res := make([]models.Reviewer, len(reviewers))
+	for i, r := range reviewers {
+		r.MatchedSkills = skills.Covered(p.skillsOf(r.ReviewerID), required)
+		res[i] = r
+	}
+	return res
+}
+
+func (p *Pool) skillsOf(userID string) []string {
+	for _, c := range p.candidates {
+		if c.UserID == userID {
+			return c.Skills
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package reviewer_selection
+
+import (
+	"context"
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPool_PickSkilled(t *testing.T) {
+	service := New(
+		stubTeamRepo{team: models.Team{TeamName: "backend", AssignmentStrategy: LeastLoadedStrategy, FallbackTeams: []string{"platform"}}},
+		stubPRRepo{candidates: []models.Candidate{
+			{UserID: "fe1", TeamName: "backend", OpenReviews: 0, Skills: []string{"frontend"}},
+			{UserID: "db1", TeamName: "backend", OpenReviews: 1, Skills: []string{"sql"}},
+			{UserID: "gs", TeamName: "backend", OpenReviews: 3, Skills: []string{"go", "sql"}},
+			{UserID: "k1", TeamName: "platform", OpenReviews: 0, Skills: []string{"k8s"}},
+		}},
+	)
+
+	newPool := func(t *testing.T) *Pool {
+		pool, err := service.TeamPool(context.Background(), "backend", nil)
+		require.NoError(t, err)
+		return pool
+	}
+
+	t.Run("candidate covering most skills wins, fallback tier covers the rest", func(t *testing.T) {
+		added, missing := newPool(t).PickSkilled(nil, []string{"sql", "go", "k8s"}, 3)
+		assert.Equal(t, []models.Reviewer{
+			{ReviewerID: "gs", SourceTeam: "backend"},
+			{ReviewerID: "k1", SourceTeam: "platform"},
+		}, added)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("slots run out", func(t *testing.T) {
+		added, missing := newPool(t).PickSkilled(nil, []string{"sql", "go", "k8s"}, 1)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "gs", SourceTeam: "backend"}}, added)
+		assert.Equal(t, []string{"k8s"}, missing)
+	})
+
+	t.Run("skills of already picked reviewers count", func(t *testing.T) {
+		added, missing := newPool(t).PickSkilled([]models.Reviewer{{ReviewerID: "db1"}}, []string{"sql"}, 2)
+		assert.Empty(t, added)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("nobody has the skill", func(t *testing.T) {
+		added, missing := newPool(t).PickSkilled(nil, []string{"rust"}, 2)
+		assert.Empty(t, added)
+		assert.Equal(t, []string{"rust"}, missing)
+	})
+
+	t.Run("ties go to the strategy and picked reviewers are not picked again", func(t *testing.T) {
+		pool := newPool(t)
+		added, _ := pool.PickSkilled(nil, []string{"sql"}, 2)
+		assert.Equal(t, []models.Reviewer{{ReviewerID: "db1", SourceTeam: "backend"}}, added)
+
+		rest := pool.Pick(ReviewerIDs(added), 2)
+		assert.Equal(t, []models.Reviewer{
+			{ReviewerID: "fe1", SourceTeam: "backend"},
+			{ReviewerID: "gs", SourceTeam: "backend"},
+		}, rest)
+	})
+}
+
+func TestPool_MatchSkills(t *testing.T) {
+	pool := &Pool{candidates: []models.Candidate{
+		{UserID: "u1", Skills: []string{"go", "sql"}},
+		{UserID: "u2", Skills: []string{"frontend"}},
+	}}
+
+	matched := pool.MatchSkills([]models.Reviewer{{ReviewerID: "u1"}, {ReviewerID: "u2"}, {ReviewerID: "owner"}}, []string{"sql", "go"})
+	assert.Equal(t, []string{"sql", "go"}, matched[0].MatchedSkills)
+	assert.Empty(t, matched[1].MatchedSkills)
+	assert.Empty(t, matched[2].MatchedSkills)
+}
@@ -0,0 +1,166 @@
+package reviewer_selection
+
+import (
+	"math/rand/v2"
+	"sort"
+	"sync"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+const (
+	RandomStrategy      = "random"
+	RoundRobinStrategy  = "round_robin"
+	LeastLoadedStrategy = "least_loaded"
+	WeightedStrategy    = "weighted"
+
+	DefaultStrategy = LeastLoadedStrategy
+)
+
+const (
+	DefaultRequiredReviewers = 1
+	DefaultMaxReviewers      = 2
+)
+
+type Strategy interface {
+	Pick(teamName string, candidates []models.Candidate, n int) []string
+}
+
+// statefulStrategy is a strategy whose picks depend on the earlier ones.
+type statefulStrategy interface {
+	Strategy
+	// snapshot returns a copy of the strategy whose picks leave the original
+	// state untouched.
+	snapshot() Strategy
+}
+
+func IsKnownStrategy(name string) bool {
+	switch name {
+	case RandomStrategy, RoundRobinStrategy, LeastLoadedStrategy, WeightedStrategy:
+		return true
+	default:
+		return false
+	}
+}
+
+func NewStrategies() map[string]Strategy {
+	return map[string]Strategy{
+		RandomStrategy:      &randomStrategy{},
+		RoundRobinStrategy:  &roundRobinStrategy{cursors: make(map[string]string)},
+		LeastLoadedStrategy: &leastLoadedStrategy{},
+		WeightedStrategy:    &weightedStrategy{},
+	}
+}
+
+type randomStrategy struct{}
+
+func (s *randomStrategy) Pick(_ string, candidates []models.Candidate, n int) []string {
+	shuffled := shuffle(candidates)
+	return userIDs(shuffled[:min(n, len(shuffled))])
+}
+
+type leastLoadedStrategy struct{}
+
+func (s *leastLoadedStrategy) Pick(_ string, candidates []models.Candidate, n int) []string {
+	shuffled := shuffle(candidates)
+	sort.SliceStable(shuffled, func(i, j int) bool {
+		return shuffled[i].OpenReviews < shuffled[j].OpenReviews
+	})
+	return userIDs(shuffled[:min(n, len(shuffled))])
+}
+
+// weightedStrategy draws reviewers at random, giving each candidate a weight
+// inversely proportional to the number of OPEN reviews they already hold.
+type weightedStrategy struct{}
+
+func (s *weightedStrategy) Pick(_ string, candidates []models.Candidate, n int) []string {
+	pool := append([]models.Candidate(nil), candidates...)
+	res := make([]string, 0, min(n, len(pool)))
+
+	for len(res) < n && len(pool) > 0 {
+		total := 0.0
+		for _, c := range pool {
+			total += weight(c)
+		}
+
+		target := rand.Float64() * total
+		idx := len(pool) - 1
+		for i, c := range pool {
+			target -= weight(c)
+			if target < 0 {
+				idx = i
+				break
+			}
+		}
+
+		res = append(res, pool[idx].UserID)
+		pool = append(pool[:idx], pool[idx+1:]...)
+	}
+
+	return res
+}
+
+func weight(c models.Candidate) float64 {
+	return 1 / float64(1+c.OpenReviews)
+}
+
+// roundRobinStrategy walks team members in user_id order, continuing after the
+// last reviewer it handed out for the team. Cursors live in memory only.
+type roundRobinStrategy struct {
+	mu      sync.Mutex
+	cursors map[string]string
+}
+
+func (s *roundRobinStrategy) Pick(teamName string, candidates []models.Candidate, n int) []string {
+	if len(candidates) == 0 || n <= 0 {
+		return nil
+	}
+
+	ordered := append([]models.Candidate(nil), candidates...)
+	sort.Slice(ordered, func(i, j int) bool {
+		return ordered[i].UserID < ordered[j].UserID
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := sort.Search(len(ordered), func(i int) bool {
+		return ordered[i].UserID > s.cursors[teamName]
+	})
+
+	count := min(n, len(ordered))
+	res := make([]string, 0, count)
+	for i := 0; i < count; i++ {
+		res = append(res, ordered[(start+i)%len(ordered)].UserID)
+	}
+
+	s.cursors[teamName] = res[len(res)-1]
+	return res
+}
+
+func (s *roundRobinStrategy) snapshot() Strategy {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cursors := make(map[string]string, len(s.cursors))
+	for team, cursor := range s.cursors {
+		cursors[team] = cursor
+	}
+	return &roundRobinStrategy{cursors: cursors}
+}
+
+func shuffle(candidates []models.Candidate) []models.Candidate {
+	shuffled := append([]models.Candidate(nil), candidates...)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+func userIDs(candidates []models.Candidate) []string {
+	res := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		res = append(res, c.UserID)
+	}
+	return res
+}
@@ -0,0 +1,144 @@
+package reviewer_selection
+
+import (
+	"testing"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/stretchr/testify/assert"
+)
+
+var testCandidates = []models.Candidate{
+	{UserID: "u1", OpenReviews: 3},
+	{UserID: "u2", OpenReviews: 0},
+	{UserID: "u3", OpenReviews: 1},
+	{UserID: "u4", OpenReviews: 0},
+}
+
+func TestStrategies_PickRespectsLimit(t *testing.T) {
+	for name, strategy := range NewStrategies() {
+		t.Run(name, func(t *testing.T) {
+			picked := strategy.Pick("team-1", testCandidates, 2)
+			assert.Len(t, picked, 2)
+			assert.NotEqual(t, picked[0], picked[1])
+
+			picked = strategy.Pick("team-1", testCandidates, 10)
+			assert.Len(t, picked, len(testCandidates))
+
+			assert.Empty(t, strategy.Pick("team-1", nil, 2))
+		})
+	}
+}
+
+func TestLeastLoadedStrategy_Pick(t *testing.T) {
+	strategy := &leastLoadedStrategy{}
+
+	picked := strategy.Pick("team-1", testCandidates, 2)
+	assert.ElementsMatch(t, []string{"u2", "u4"}, picked)
+
+	picked = strategy.Pick("team-1", testCandidates, 3)
+	assert.Equal(t, "u3", picked[2])
+}
+
+func TestRoundRobinStrategy_Pick(t *testing.T) {
+	strategy := &roundRobinStrategy{cursors: make(map[string]string)}
+
+	assert.Equal(t, []string{"u1", "u2"}, strategy.Pick("team-1", testCandidates, 2))
+	assert.Equal(t, []string{"u3", "u4"}, strategy.Pick("team-1", testCandidates, 2))
+	assert.Equal(t, []string{"u1"}, strategy.Pick("team-1", testCandidates, 1))
+	assert.Equal(t, []string{"u1"}, strategy.Pick("team-2", testCandidates, 1))
+}
+
+func TestRoundRobinStrategy_PickSkipsMissingCursor(t *testing.T) {
+	strategy := &roundRobinStrategy{cursors: map[string]string{"team-1": "u2"}}
+
+	candidates := []models.Candidate{{UserID: "u1"}, {UserID: "u4"}}
+	assert.Equal(t, []string{"u4", "u1"}, strategy.Pick("team-1", candidates, 2))
+}
+
+func TestWeightedStrategy_PickPrefersIdleReviewers(t *testing.T) {
+	strategy := &weightedStrategy{}
+	candidates := []models.Candidate{
+		{UserID: "idle", OpenReviews: 0},
+		{UserID: "busy", OpenReviews: 99},
+	}
+
+	idle := 0
+	for i := 0; i < 200; i++ {
+		if strategy.Pick("team-1", candidates, 1)[0] == "idle" {
+			idle++
+		}
+	}
+	assert.Greater(t, idle, 150)
+}
+
+func TestPool_PickExcludesAndTracksLoad(t *testing.T) {
+	pool := &Pool{
+		team:     models.Team{TeamName: "team-1"},
+		strategy: &leastLoadedStrategy{},
+		tiers:    []string{"team-1"},
+		candidates: []models.Candidate{
+			{UserID: "u1", TeamName: "team-1", OpenReviews: 0},
+			{UserID: "u2", TeamName: "team-1", OpenReviews: 1},
+		},
+	}
+
+	assert.Equal(t, []string{"u2"}, ReviewerIDs(pool.Pick([]string{"u1"}, 1)))
+	assert.Equal(t, []string{"u1"}, ReviewerIDs(pool.Pick(nil, 1)))
+	assert.Equal(t, 1, pool.candidates[0].OpenReviews)
+	assert.Equal(t, 2, pool.candidates[1].OpenReviews)
+	assert.Empty(t, pool.Pick(nil, 0))
+}
+
+func TestPool_PreviewLeavesStateUntouched(t *testing.T) {
+	pool := &Pool{
+		team:     models.Team{TeamName: "team-1"},
+		strategy: &roundRobinStrategy{cursors: make(map[string]string)},
+		tiers:    []string{"team-1"},
+		candidates: []models.Candidate{
+			{UserID: "u1", TeamName: "team-1"},
+			{UserID: "u2", TeamName: "team-1"},
+			{UserID: "u3", TeamName: "team-1"},
+		},
+	}
+
+	preview := pool.Preview()
+	assert.Equal(t, []string{"u1"}, ReviewerIDs(preview.Pick(nil, 1)))
+	assert.Equal(t, []string{"u2"}, ReviewerIDs(preview.Pick(nil, 1)))
+	assert.Zero(t, pool.candidates[0].OpenReviews)
+
+	assert.Equal(t, []string{"u1", "u2"}, ReviewerIDs(pool.Pick(nil, 2)))
+}
+
+func TestPool_PickFallsBackToBuddyTeams(t *testing.T) {
+	pool := &Pool{
+		team:     models.Team{TeamName: "team-1", FallbackTeams: []string{"team-2", "team-3"}},
+		strategy: &leastLoadedStrategy{},
+		tiers:    []string{"team-1", "team-2", "team-3"},
+		candidates: []models.Candidate{
+			{UserID: "u1", TeamName: "team-1", OpenReviews: 5},
+			{UserID: "u2", TeamName: "team-2", OpenReviews: 0},
+			{UserID: "u3", TeamName: "team-3", OpenReviews: 0},
+		},
+	}
+
+	assert.Equal(t, []models.Reviewer{
+		{ReviewerID: "u1", SourceTeam: "team-1"},
+		{ReviewerID: "u2", SourceTeam: "team-2"},
+	}, pool.Pick(nil, 2))
+
+	assert.Equal(t, []models.Reviewer{
+		{ReviewerID: "u3", SourceTeam: "team-3"},
+	}, pool.Pick([]string{"u1", "u2"}, 2))
+}
+
+func TestPool_Quota(t *testing.T) {
+	pool := &Pool{team: models.Team{RequiredReviewers: 3, MaxReviewers: 4}}
+	required, maxReviewers := pool.Quota()
+	assert.Equal(t, 3, required)
+	assert.Equal(t, 4, maxReviewers)
+
+	pool = &Pool{team: models.Team{}}
+	required, maxReviewers = pool.Quota()
+	assert.Equal(t, 0, required)
+	assert.Equal(t, DefaultMaxReviewers, maxReviewers)
+}
@@ -0,0 +1,17 @@
+package set_code_owners
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type teamRepo interface {
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	Exists(ctx context.Context, teamName string) (bool, error)
+	SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, error)
+}
+
+type userRepo interface {
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
+}
@@ -0,0 +1,94 @@
+package set_code_owners
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/codeowners"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	teamRepo teamRepo
+	userRepo userRepo
+}
+
+func New(teamRepo teamRepo, userRepo userRepo) *Service {
+	return &Service{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+	}
+}
+
+// SetCodeOwners replaces the team's CODEOWNERS document. The document is
+// rejected as a whole if a line cannot be parsed or names an unknown user or
+// team; an empty document removes all rules.
+func (s *Service) SetCodeOwners(ctx context.Context, teamName, document string) (models.CodeOwners, []codeowners.Rule, error) {
+	current, err := s.teamRepo.FindTeamByID(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return models.CodeOwners{}, nil, rpc_errors.NewNotFound("team not found")
+		}
+		return models.CodeOwners{}, nil, fmt.Errorf("find team: %w", err)
+	}
+	if current.ArchivedAt != nil {
+		return models.CodeOwners{}, nil, rpc_errors.NewTeamArchived("archived teams cannot have code owners")
+	}
+
+	rules, err := codeowners.Parse(document)
+	if err != nil {
+		return models.CodeOwners{}, nil, rpc_errors.NewBadRequest(err.Error())
+	}
+	if err := s.validateOwners(ctx, rules); err != nil {
+		return models.CodeOwners{}, nil, err
+	}
+
+	stored, err := s.teamRepo.SetCodeOwners(ctx, teamName, document)
+	if err != nil {
+		return models.CodeOwners{}, nil, fmt.Errorf("set code owners: %w", err)
+	}
+
+	return stored, rules, nil
+}
+
+func (s *Service) validateOwners(ctx context.Context, rules []codeowners.Rule) error {
+	checkedUsers := make(map[string]bool)
+	checkedTeams := make(map[string]bool)
+
+	for _, rule := range rules {
+		for _, userID := range rule.Users {
+			if checkedUsers[userID] {
+				continue
+			}
+			checkedUsers[userID] = true
+
+			if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+				if errors.Is(err, user.ErrNotFound) {
+					return rpc_errors.NewBadRequest(fmt.Sprintf("line %d: user %s not found", rule.Line, userID))
+				}
+				return fmt.Errorf("get user: %w", err)
+			}
+		}
+
+		for _, teamName := range rule.Teams {
+			if checkedTeams[teamName] {
+				continue
+			}
+			checkedTeams[teamName] = true
+
+			exists, err := s.teamRepo.Exists(ctx, teamName)
+			if err != nil {
+				return fmt.Errorf("check team: %w", err)
+			}
+			if !exists {
+				return rpc_errors.NewBadRequest(fmt.Sprintf("line %d: team %s not found", rule.Line, teamName))
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package set_code_owners_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_code_owners"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	teamRepo *team.Repository
+	service  *set_code_owners.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, team_code_owners RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		service:  set_code_owners.New(teamRepo, userRepo),
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_SetCodeOwners(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+	env.seedTeam(t, "payments", "u7")
+
+	doc, rules, err := env.service.SetCodeOwners(env.ctx, "backend", "*.go @acme/backend\n/payments/ @u7\n")
+	require.NoError(t, err)
+	assert.NotNil(t, doc.UpdatedAt)
+	require.Len(t, rules, 2)
+	assert.Equal(t, []string{"u7"}, rules[1].Users)
+
+	stored, err := env.teamRepo.GetCodeOwners(env.ctx, "backend")
+	require.NoError(t, err)
+	assert.Equal(t, "*.go @acme/backend\n/payments/ @u7\n", stored.Document)
+
+	_, _, err = env.service.SetCodeOwners(env.ctx, "backend", "")
+	require.NoError(t, err)
+
+	stored, err = env.teamRepo.GetCodeOwners(env.ctx, "backend")
+	require.NoError(t, err)
+	assert.Empty(t, stored.Document)
+}
+
+func TestService_SetCodeOwners_UnknownOwner(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	_, _, err := env.service.SetCodeOwners(env.ctx, "backend", "*.go @u1\n/payments/ @acme/payments\n")
+	var badRequest *rpc_errors.BadRequestError
+	require.ErrorAs(t, err, &badRequest)
+	assert.Equal(t, "line 2: team payments not found", badRequest.Message)
+
+	stored, err := env.teamRepo.GetCodeOwners(env.ctx, "backend")
+	require.NoError(t, err)
+	assert.Empty(t, stored.Document)
+}
+
+func TestService_SetCodeOwners_TeamNotFound(t *testing.T) {
+	env := setupTest(t)
+
+	_, _, err := env.service.SetCodeOwners(env.ctx, "ghost", "*.go @u1\n")
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
@@ -0,0 +1,18 @@
+package set_is_active
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+)
+
+type userRepo interface {
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
+	UserUpdate(ctx context.Context, spec user.UpdateSpecification) (models.User, error)
+}
+
+type deactivator interface {
+	BulkDeactivateTeamUsers(ctx context.Context, teamName string, userIDs []string, opts bulk_deactivate_team.Options, actor string) (bulk_deactivate_team.BulkDeactivateResult, error)
+}
@@ -0,0 +1,69 @@
+package set_is_active
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+)
+
+type Result struct {
+	User          models.User
+	Reassignments []bulk_deactivate_team.ReassignmentResult
+	// Unresolved are the user's reviews nobody could take over; the user stays
+	// assigned to them.
+	Unresolved []bulk_deactivate_team.UnresolvedSlot
+}
+
+type Service struct {
+	userRepo    userRepo
+	deactivator deactivator
+}
+
+func New(userRepo userRepo, deactivator deactivator) *Service {
+	return &Service{
+		userRepo:    userRepo,
+		deactivator: deactivator,
+	}
+}
+
+// SetIsActive updates the user's activity flag. Deactivating an active user
+// goes through the team deactivation flow, so their open reviews are handed
+// over to teammates the same way as in a bulk deactivation. A dry run returns
+// the user, the reassignments and the unresolved reviews as they would be
+// without changing anything.
+func (s *Service) SetIsActive(ctx context.Context, userID string, isActive, dryRun bool, actor string) (Result, error) {
+	u, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return Result{}, rpc_errors.NewNotFound("user not found")
+		}
+		return Result{}, fmt.Errorf("get user: %w", err)
+	}
+
+	if isActive || !u.IsActive {
+		if dryRun {
+			u.IsActive = isActive
+			return Result{User: u}, nil
+		}
+
+		updated, err := s.userRepo.UserUpdate(ctx, user_spec.NewSetIsActiveSpecification(userID, isActive))
+		if err != nil {
+			return Result{}, fmt.Errorf("update user: %w", err)
+		}
+		return Result{User: updated}, nil
+	}
+
+	res, err := s.deactivator.BulkDeactivateTeamUsers(ctx, u.TeamName, []string{userID}, bulk_deactivate_team.Options{DryRun: dryRun}, actor)
+	if err != nil {
+		return Result{}, err
+	}
+
+	u.IsActive = false
+	return Result{User: u, Reassignments: res.Reassignments, Unresolved: res.Unresolved}, nil
+}
@@ -0,0 +1,194 @@
+package set_is_active_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/bulk_deactivate_team"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_is_active"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *set_is_active.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	deactivator := bulk_deactivate_team.New(userRepo, prRepo, reviewer_selection.New(teamRepo, prRepo))
+	service := set_is_active.New(userRepo, deactivator)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func (env *testEnv) seedPR(t *testing.T, prID, authorID string, reviewers ...string) {
+	t.Helper()
+
+	err := env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		openStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     prID,
+			AuthorID: authorID,
+			StatusID: openStatus.ID,
+		}); err != nil {
+			return err
+		}
+
+		assigned := make([]models.Reviewer, len(reviewers))
+		for i, id := range reviewers {
+			assigned[i] = models.Reviewer{ReviewerID: id}
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, prID, assigned)
+	})
+	require.NoError(t, err)
+}
+
+func TestService_SetIsActive_DeactivationReassignsReviews(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "leaving", "r2")
+	env.seedPR(t, "pr-1", "author", "leaving")
+
+	result, err := env.service.SetIsActive(env.ctx, "leaving", false, false, "admin")
+	require.NoError(t, err)
+	assert.False(t, result.User.IsActive)
+	require.Len(t, result.Reassignments, 1)
+	assert.Equal(t, "pr-1", result.Reassignments[0].PRID)
+	assert.Equal(t, "leaving", result.Reassignments[0].OldReviewerID)
+	assert.Equal(t, "r2", result.Reassignments[0].NewReviewerID)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"r2"}, pr.Reviewers)
+
+	stored, err := env.userRepo.GetUserByID(env.ctx, "leaving")
+	require.NoError(t, err)
+	assert.False(t, stored.IsActive)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-1")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, models.AssignmentEventDeactivationReassign, events[0].Type)
+	assert.Equal(t, "admin", events[0].Actor)
+}
+
+func TestService_SetIsActive_DeactivationWithoutReplacement(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "leaving")
+	env.seedPR(t, "pr-1", "author", "leaving")
+
+	result, err := env.service.SetIsActive(env.ctx, "leaving", false, false, "admin")
+	require.NoError(t, err)
+	assert.False(t, result.User.IsActive)
+	assert.Empty(t, result.Reassignments)
+	require.Len(t, result.Unresolved, 1)
+	assert.Equal(t, "pr-1", result.Unresolved[0].PRID)
+	assert.Equal(t, "leaving", result.Unresolved[0].ReviewerID)
+	assert.Equal(t, bulk_deactivate_team.UnresolvedAllCandidatesOnPR, result.Unresolved[0].Reason)
+	assert.False(t, result.Unresolved[0].Removed)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"leaving"}, pr.Reviewers)
+}
+
+func TestService_SetIsActive_DryRunChangesNothing(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "author", "leaving", "r2")
+	env.seedPR(t, "pr-1", "author", "leaving")
+
+	result, err := env.service.SetIsActive(env.ctx, "leaving", false, true, "admin")
+	require.NoError(t, err)
+	assert.False(t, result.User.IsActive)
+	require.Len(t, result.Reassignments, 1)
+	assert.Equal(t, "r2", result.Reassignments[0].NewReviewerID)
+
+	pr, err := env.prRepo.GetPRByID(env.ctx, "pr-1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"leaving"}, pr.Reviewers)
+
+	stored, err := env.userRepo.GetUserByID(env.ctx, "leaving")
+	require.NoError(t, err)
+	assert.True(t, stored.IsActive)
+}
+
+func TestService_SetIsActive_Activation(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	_, err := env.service.SetIsActive(env.ctx, "u1", false, false, "admin")
+	require.NoError(t, err)
+
+	result, err := env.service.SetIsActive(env.ctx, "u1", true, false, "admin")
+	require.NoError(t, err)
+	assert.True(t, result.User.IsActive)
+	assert.Empty(t, result.Reassignments)
+
+	stored, err := env.userRepo.GetUserByID(env.ctx, "u1")
+	require.NoError(t, err)
+	assert.True(t, stored.IsActive)
+}
+
+func TestService_SetIsActive_UserNotFound(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.SetIsActive(env.ctx, "ghost", false, false, "admin")
+	var notFound *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFound)
+}
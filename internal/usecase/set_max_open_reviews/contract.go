@@ -0,0 +1,12 @@
+package set_max_open_reviews
+
+import (
+	"context"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+)
+
+type userRepo interface {
+	UserUpdate(ctx context.Context, spec user.UpdateSpecification) (models.User, error)
+}
@@ -0,0 +1,41 @@
+package set_max_open_reviews
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	userRepo userRepo
+}
+
+func New(userRepo userRepo) *Service {
+	return &Service{
+		userRepo: userRepo,
+	}
+}
+
+// SetMaxOpenReviews sets the user's own limit of open reviews, 0 meaning no
+// limit. A nil limit removes the override and the team default applies again.
+// Reviews already assigned above the limit are kept.
+func (s *Service) SetMaxOpenReviews(ctx context.Context, userID string, maxOpenReviews *int) (models.User, error) {
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return models.User{}, rpc_errors.NewBadRequest("max_open_reviews cannot be negative")
+	}
+
+	updated, err := s.userRepo.UserUpdate(ctx, user_spec.NewSetMaxOpenReviewsSpecification(userID, maxOpenReviews))
+	if err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return models.User{}, rpc_errors.NewNotFound("user not found")
+		}
+		return models.User{}, fmt.Errorf("update user: %w", err)
+	}
+
+	return updated, nil
+}
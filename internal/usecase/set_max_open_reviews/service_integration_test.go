@@ -0,0 +1,126 @@
+package set_max_open_reviews_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/ptr"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_max_open_reviews"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *set_max_open_reviews.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  set_max_open_reviews.New(userRepo),
+	}
+}
+
+func (env *testEnv) availableIDs(t *testing.T, teamName string) []string {
+	t.Helper()
+
+	candidates, err := env.prRepo.GetAvailableReviewers(env.ctx, teamName, nil)
+	require.NoError(t, err)
+
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserID
+	}
+	return ids
+}
+
+func TestService_SetMaxOpenReviews(t *testing.T) {
+	env := setupTest(t)
+
+	maxOpenReviews := 2
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "backend", models.TeamSettings{MaxOpenReviews: &maxOpenReviews}); err != nil {
+			return err
+		}
+		if _, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: "backend"},
+			{ID: "senior", Username: "senior", IsActive: true, TeamName: "backend"},
+		}); err != nil {
+			return err
+		}
+
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(models.StatusOpen))
+		if err != nil {
+			return err
+		}
+		pr := &models.PullRequest{ID: "pr-1", Name: "pr-1", AuthorID: "author", StatusID: foundStatus.ID}
+		if _, err := env.prRepo.InsertPullRequest(ctx, tx, pr); err != nil {
+			return err
+		}
+		return env.prRepo.InsertReviewers(ctx, tx, "pr-1", []models.Reviewer{{ReviewerID: "senior", SourceTeam: "backend"}})
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"author", "senior"}, env.availableIDs(t, "backend"))
+
+	updated, err := env.service.SetMaxOpenReviews(env.ctx, "senior", ptr.To(1))
+	require.NoError(t, err)
+	require.NotNil(t, updated.MaxOpenReviews)
+	assert.Equal(t, 1, *updated.MaxOpenReviews)
+	assert.Equal(t, []string{"author"}, env.availableIDs(t, "backend"))
+
+	atCapacity, err := env.prRepo.GetReviewersAtCapacity(env.ctx, "backend", nil)
+	require.NoError(t, err)
+	require.Len(t, atCapacity, 1)
+	assert.Equal(t, models.Candidate{UserID: "senior", TeamName: "backend", OpenReviews: 1, MaxOpenReviews: 1}, atCapacity[0])
+
+	updated, err = env.service.SetMaxOpenReviews(env.ctx, "senior", nil)
+	require.NoError(t, err)
+	assert.Nil(t, updated.MaxOpenReviews)
+	assert.Equal(t, []string{"author", "senior"}, env.availableIDs(t, "backend"))
+}
+
+func TestService_SetMaxOpenReviews_Errors(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.SetMaxOpenReviews(env.ctx, "ghost", ptr.To(3))
+	var notFoundErr *rpc_errors.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+
+	_, err = env.service.SetMaxOpenReviews(env.ctx, "ghost", ptr.To(-1))
+	var badRequestErr *rpc_errors.BadRequestError
+	require.ErrorAs(t, err, &badRequestErr)
+}
@@ -0,0 +1,30 @@
+package set_pr_status
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type prRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	GetPRByID(ctx context.Context, prID string) (models.PullRequest, error)
+	GetPRByIDForUpdate(ctx context.Context, tx *sqlx.Tx, prID string) (models.PullRequest, error)
+	SetPullRequestStatus(ctx context.Context, tx *sqlx.Tx, prID string, statusName string) error
+	GetChangedPaths(ctx context.Context, prID string) ([]string, error)
+	GetRequiredSkills(ctx context.Context, prID string) ([]string, error)
+	InsertReviewers(ctx context.Context, tx *sqlx.Tx, prID string, reviewers []models.Reviewer) error
+	InsertAssignmentEvents(ctx context.Context, tx *sqlx.Tx, events []models.AssignmentEvent) error
+}
+
+type userRepo interface {
+	GetUserTeamName(ctx context.Context, userID string) (string, error)
+}
+
+type reviewerSelector interface {
+	TeamPool(ctx context.Context, teamName string, excludeIDs []string) (*reviewer_selection.Pool, error)
+	FillReviewers(ctx context.Context, pool *reviewer_selection.Pool, paths, requiredSkills []string, assigned []models.Reviewer, excludeIDs []string) (reviewer_selection.Fill, error)
+}
@@ -0,0 +1,139 @@
+package set_pr_status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/metrics"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/pr_lifecycle"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type Service struct {
+	prRepo   prRepo
+	userRepo userRepo
+	selector reviewerSelector
+}
+
+func New(prRepo prRepo, userRepo userRepo, selector reviewerSelector) *Service {
+	return &Service{
+		prRepo:   prRepo,
+		userRepo: userRepo,
+		selector: selector,
+	}
+}
+
+// SetStatus moves a PR along its lifecycle. Merging goes through merge_pr so that
+// the approval rules are applied. A PR entering review (READY_FOR_REVIEW or
+// REOPENED) is topped up to the max_reviewers of the author's team.
+func (s *Service) SetStatus(ctx context.Context, prID, statusName, actor string) (models.PullRequest, error) {
+	if statusName == models.StatusMerged {
+		return models.PullRequest{}, rpc_errors.NewBadRequest("use /pullRequest/merge to merge a PR")
+	}
+
+	pr, err := s.prRepo.GetPRByID(ctx, prID)
+	if err != nil {
+		if errors.Is(err, pull_request.ErrPRNotFound) {
+			return models.PullRequest{}, rpc_errors.NewNotFound("PR not found")
+		}
+		return models.PullRequest{}, fmt.Errorf("get PR: %w", err)
+	}
+
+	if pr.Status.Name == statusName {
+		return pr, nil
+	}
+
+	if !pr_lifecycle.CanTransition(pr.Status.Name, statusName) {
+		return models.PullRequest{}, rpc_errors.NewInvalidTransition(
+			fmt.Sprintf("cannot move PR from %s to %s", pr.Status.Name, statusName))
+	}
+
+	var fill reviewer_selection.Fill
+	if pr_lifecycle.IsReviewable(statusName) {
+		fill, err = s.pickMissingReviewers(ctx, pr)
+		if err != nil {
+			return models.PullRequest{}, err
+		}
+	}
+
+	err = s.prRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		locked, err := s.prRepo.GetPRByIDForUpdate(ctx, tx, prID)
+		if err != nil {
+			return fmt.Errorf("lock PR: %w", err)
+		}
+		if locked.Status.Name != pr.Status.Name {
+			return rpc_errors.NewInvalidTransition(
+				fmt.Sprintf("PR status changed concurrently from %s to %s", pr.Status.Name, locked.Status.Name))
+		}
+
+		if err := s.prRepo.SetPullRequestStatus(ctx, tx, prID, statusName); err != nil {
+			return fmt.Errorf("set status: %w", err)
+		}
+		if err := s.prRepo.InsertReviewers(ctx, tx, prID, fill.Reviewers()); err != nil {
+			return fmt.Errorf("insert reviewers: %w", err)
+		}
+
+		events := fill.Events(prID, actor, "pull request moved to "+statusName)
+		if err := s.prRepo.InsertAssignmentEvents(ctx, tx, events); err != nil {
+			return fmt.Errorf("insert assignment events: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+
+	return s.prRepo.GetPRByID(ctx, prID)
+}
+
+// pickMissingReviewers tops the PR up like a new one: code owners of its
+// changed paths, then reviewers covering the required skills, then the pool.
+// In the require skill mode the PR cannot enter review while a required skill
+// stays uncovered.
+func (s *Service) pickMissingReviewers(ctx context.Context, pr models.PullRequest) (reviewer_selection.Fill, error) {
+	teamName, err := s.userRepo.GetUserTeamName(ctx, pr.AuthorID)
+	if err != nil {
+		return reviewer_selection.Fill{}, fmt.Errorf("get author team: %w", err)
+	}
+
+	pool, err := s.selector.TeamPool(ctx, teamName, []string{pr.AuthorID})
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return reviewer_selection.Fill{}, rpc_errors.NewNotFound("author team not found")
+		}
+		return reviewer_selection.Fill{}, fmt.Errorf("get team reviewer pool: %w", err)
+	}
+
+	paths, err := s.prRepo.GetChangedPaths(ctx, pr.ID)
+	if err != nil {
+		return reviewer_selection.Fill{}, fmt.Errorf("get changed paths: %w", err)
+	}
+
+	requiredSkills, err := s.prRepo.GetRequiredSkills(ctx, pr.ID)
+	if err != nil {
+		return reviewer_selection.Fill{}, fmt.Errorf("get required skills: %w", err)
+	}
+
+	fill, err := s.selector.FillReviewers(ctx, pool, paths, requiredSkills, pr.Assignments, []string{pr.AuthorID})
+	if err != nil {
+		return reviewer_selection.Fill{}, err
+	}
+	if len(fill.MissingSkills) > 0 && pr.SkillMode == models.SkillModeRequire {
+		metrics.NoCandidate.WithLabelValues(metrics.OperationSetStatus).Inc()
+		return reviewer_selection.Fill{}, rpc_errors.NewNoCandidate(fmt.Sprintf("no available reviewer with skills: %s", strings.Join(fill.MissingSkills, ", ")))
+	}
+
+	required, _ := pool.Quota()
+	if total := len(pr.Reviewers) + len(fill.Reviewers()); total < required {
+		return reviewer_selection.Fill{}, rpc_errors.NewNotFound(fmt.Sprintf("not enough available reviewers: team requires %d, found %d", required, total))
+	}
+
+	return fill, nil
+}
@@ -0,0 +1,253 @@
+package set_pr_status_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/pull_request"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/status"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/create_pr"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_pr_status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx context.Context
+	db  *sqlx.DB
+
+	userRepo *user.Repository
+	prRepo   *pull_request.Repository
+	teamRepo *team.Repository
+	service  *set_pr_status.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	prRepo := pull_request.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+	service := set_pr_status.New(prRepo, userRepo, reviewer_selection.New(teamRepo, prRepo))
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, pull_requests, reviewers, assignment_events RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		prRepo:   prRepo,
+		teamRepo: teamRepo,
+		service:  service,
+	}
+}
+
+// seedPR creates a team with an author and two reviewers and a PR without reviewers.
+func (env *testEnv) seedPR(t *testing.T, prID, statusName string) {
+	t.Helper()
+
+	teamName := "lifecycle-team"
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: teamName},
+			{ID: "reviewer-1", Username: "reviewer1", IsActive: true, TeamName: teamName},
+			{ID: "reviewer-2", Username: "reviewer2", IsActive: true, TeamName: teamName},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	err = env.prRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		foundStatus, err := env.prRepo.FindStatus(ctx, status.NewGetStatusByNameSpecification(statusName))
+		if err != nil {
+			return err
+		}
+
+		_, err = env.prRepo.InsertPullRequest(ctx, tx, &models.PullRequest{
+			ID:       prID,
+			Name:     "Lifecycle PR",
+			AuthorID: "author",
+			StatusID: foundStatus.ID,
+		})
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_SetStatus_ReadyForReviewAssignsReviewers(t *testing.T) {
+	env := setupTest(t)
+	env.seedPR(t, "pr-draft", models.StatusDraft)
+
+	pr, err := env.service.SetStatus(env.ctx, "pr-draft", models.StatusReadyForReview, "lead")
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusReadyForReview, pr.Status.Name)
+	assert.ElementsMatch(t, []string{"reviewer-1", "reviewer-2"}, pr.Reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-draft")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	for _, e := range events {
+		assert.Equal(t, "lead", e.Actor)
+	}
+}
+
+func TestService_SetStatus_ReadyForReviewPicksCodeOwners(t *testing.T) {
+	env := setupTest(t)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "backend", models.TeamSettings{}); err != nil {
+			return err
+		}
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "payments", models.TeamSettings{}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: "backend"},
+			{ID: "b1", Username: "b1", IsActive: true, TeamName: "backend"},
+			{ID: "b2", Username: "b2", IsActive: true, TeamName: "backend"},
+			{ID: "payer", Username: "payer", IsActive: true, TeamName: "payments"},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	_, err = env.teamRepo.SetCodeOwners(env.ctx, "backend", "/payments/ @acme/payments\n")
+	require.NoError(t, err)
+
+	createService := create_pr.New(env.userRepo, env.prRepo, reviewer_selection.New(env.teamRepo, env.prRepo))
+	draft, err := createService.CreatePR(env.ctx, &models.PullRequest{
+		ID:           "pr-pay",
+		Name:         "Payments PR",
+		AuthorID:     "author",
+		Status:       &models.Status{Name: models.StatusDraft},
+		ChangedPaths: []string{"payments/ledger.go"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, draft.Reviewers)
+
+	pr, err := env.service.SetStatus(env.ctx, "pr-pay", models.StatusReadyForReview, "lead")
+	require.NoError(t, err)
+	require.Len(t, pr.Reviewers, 2)
+	assert.Contains(t, pr.Reviewers, "payer")
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-pay")
+	require.NoError(t, err)
+	reasons := map[string]string{}
+	for _, e := range events {
+		reasons[e.NewReviewerID] = e.Reason
+	}
+	assert.Equal(t, "code owner", reasons["payer"])
+}
+
+func TestService_SetStatus_ReadyForReviewRequiresSkills(t *testing.T) {
+	env := setupTest(t)
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, "backend", models.TeamSettings{}); err != nil {
+			return err
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, []models.User{
+			{ID: "author", Username: "author", IsActive: true, TeamName: "backend"},
+			{ID: "b1", Username: "b1", IsActive: true, TeamName: "backend"},
+			{ID: "dba", Username: "dba", IsActive: true, TeamName: "backend"},
+		})
+		return err
+	})
+	require.NoError(t, err)
+
+	createService := create_pr.New(env.userRepo, env.prRepo, reviewer_selection.New(env.teamRepo, env.prRepo))
+	_, err = createService.CreatePR(env.ctx, &models.PullRequest{
+		ID:             "pr-sql",
+		Name:           "SQL migration",
+		AuthorID:       "author",
+		Status:         &models.Status{Name: models.StatusDraft},
+		RequiredSkills: []string{"sql"},
+		SkillMode:      models.SkillModeRequire,
+	})
+	require.NoError(t, err)
+
+	_, err = env.service.SetStatus(env.ctx, "pr-sql", models.StatusReadyForReview, "lead")
+	require.Error(t, err)
+	var noCandidateErr *rpc_errors.NoCandidateError
+	assert.ErrorAs(t, err, &noCandidateErr)
+
+	err = env.userRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		return env.userRepo.SetUserSkills(ctx, tx, "dba", []string{"sql"})
+	})
+	require.NoError(t, err)
+
+	pr, err := env.service.SetStatus(env.ctx, "pr-sql", models.StatusReadyForReview, "lead")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"b1", "dba"}, pr.Reviewers)
+
+	events, err := env.prRepo.GetAssignmentEventsByPR(env.ctx, "pr-sql")
+	require.NoError(t, err)
+	reasons := map[string]string{}
+	for _, e := range events {
+		reasons[e.NewReviewerID] = e.Reason
+	}
+	assert.Equal(t, "required skills: sql", reasons["dba"])
+}
+
+func TestService_SetStatus_CloseAndReopen(t *testing.T) {
+	env := setupTest(t)
+	env.seedPR(t, "pr-close", models.StatusOpen)
+
+	pr, err := env.service.SetStatus(env.ctx, "pr-close", models.StatusClosed, "lead")
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusClosed, pr.Status.Name)
+	assert.NotNil(t, pr.ClosedAt)
+	assert.Empty(t, pr.Reviewers)
+
+	pr, err = env.service.SetStatus(env.ctx, "pr-close", models.StatusReopened, "lead")
+	require.NoError(t, err)
+	assert.Equal(t, models.StatusReopened, pr.Status.Name)
+	assert.Nil(t, pr.ClosedAt)
+	assert.Len(t, pr.Reviewers, 2)
+}
+
+func TestService_SetStatus_InvalidTransition(t *testing.T) {
+	env := setupTest(t)
+	env.seedPR(t, "pr-merged", models.StatusMerged)
+
+	_, err := env.service.SetStatus(env.ctx, "pr-merged", models.StatusReopened, "lead")
+	require.Error(t, err)
+	var transitionErr *rpc_errors.InvalidTransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+}
+
+func TestService_SetStatus_MergeIsRejected(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.SetStatus(env.ctx, "pr-any", models.StatusMerged, "lead")
+	require.Error(t, err)
+	var badRequestErr *rpc_errors.BadRequestError
+	assert.ErrorAs(t, err, &badRequestErr)
+}
+
+func TestService_SetStatus_NotFound(t *testing.T) {
+	env := setupTest(t)
+
+	_, err := env.service.SetStatus(env.ctx, "missing-pr", models.StatusClosed, "lead")
+	require.Error(t, err)
+	var notFoundErr *rpc_errors.NotFoundError
+	assert.ErrorAs(t, err, &notFoundErr)
+}
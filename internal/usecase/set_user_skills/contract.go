@@ -0,0 +1,16 @@
+package set_user_skills
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+)
+
+type userRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	GetUserByID(ctx context.Context, userID string) (models.User, error)
+	GetUserSkills(ctx context.Context, userID string) ([]string, error)
+	SetUserSkills(ctx context.Context, tx *sqlx.Tx, userID string, tags []string) error
+}
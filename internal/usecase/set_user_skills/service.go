@@ -0,0 +1,52 @@
+package set_user_skills
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/skills"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+)
+
+type Service struct {
+	userRepo userRepo
+}
+
+func New(userRepo userRepo) *Service {
+	return &Service{
+		userRepo: userRepo,
+	}
+}
+
+// SetUserSkills replaces the user's skill tags and returns the stored ones in
+// name order. Tags are normalized; an empty list removes all skills.
+func (s *Service) SetUserSkills(ctx context.Context, userID string, tags []string) ([]string, error) {
+	normalized, err := skills.Normalize(tags)
+	if err != nil {
+		return nil, rpc_errors.NewBadRequest(err.Error())
+	}
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		if errors.Is(err, user.ErrNotFound) {
+			return nil, rpc_errors.NewNotFound("user not found")
+		}
+		return nil, fmt.Errorf("get user: %w", err)
+	}
+
+	err = s.userRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		return s.userRepo.SetUserSkills(ctx, tx, userID, normalized)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("set user skills: %w", err)
+	}
+
+	stored, err := s.userRepo.GetUserSkills(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("get user skills: %w", err)
+	}
+
+	return stored, nil
+}
@@ -0,0 +1,96 @@
+package set_user_skills_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/utils/test_env"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/set_user_skills"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEnv struct {
+	ctx      context.Context
+	db       *sqlx.DB
+	userRepo *user.Repository
+	teamRepo *team.Repository
+	service  *set_user_skills.Service
+}
+
+func setupTest(t *testing.T) *testEnv {
+	t.Helper()
+
+	ctx := context.Background()
+
+	db, err := test_env.NewTestDatabaseConnection(ctx)
+	require.NoError(t, err)
+
+	userRepo := user.NewRepository(db)
+	teamRepo := team.NewRepository(db)
+
+	t.Cleanup(func() {
+		_, _ = db.ExecContext(ctx, "TRUNCATE users, teams, user_skills RESTART IDENTITY CASCADE")
+		_ = db.Close()
+	})
+
+	return &testEnv{
+		ctx:      ctx,
+		db:       db,
+		userRepo: userRepo,
+		teamRepo: teamRepo,
+		service:  set_user_skills.New(userRepo),
+	}
+}
+
+func (env *testEnv) seedTeam(t *testing.T, teamName string, userIDs ...string) {
+	t.Helper()
+
+	err := env.teamRepo.WithTx(env.ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		if _, err := env.teamRepo.CreateTeam(ctx, tx, teamName, models.TeamSettings{}); err != nil {
+			return err
+		}
+		users := make([]models.User, len(userIDs))
+		for i, id := range userIDs {
+			users[i] = models.User{ID: id, Username: id, IsActive: true, TeamName: teamName}
+		}
+		_, err := env.userRepo.UpsertUsers(ctx, tx, users)
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestService_SetUserSkills(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	stored, err := env.service.SetUserSkills(env.ctx, "u1", []string{"SQL", "go", "sql"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"go", "sql"}, stored)
+
+	stored, err = env.service.SetUserSkills(env.ctx, "u1", []string{"go", "frontend"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"frontend", "go"}, stored)
+
+	stored, err = env.service.SetUserSkills(env.ctx, "u1", nil)
+	require.NoError(t, err)
+	assert.Empty(t, stored)
+}
+
+func TestService_SetUserSkills_Errors(t *testing.T) {
+	env := setupTest(t)
+	env.seedTeam(t, "backend", "u1")
+
+	_, err := env.service.SetUserSkills(env.ctx, "ghost", []string{"go"})
+	var notFoundErr *rpc_errors.NotFoundError
+	require.ErrorAs(t, err, &notFoundErr)
+
+	_, err = env.service.SetUserSkills(env.ctx, "u1", []string{"front end"})
+	var badRequestErr *rpc_errors.BadRequestError
+	require.ErrorAs(t, err, &badRequestErr)
+}
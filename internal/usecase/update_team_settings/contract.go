@@ -0,0 +1,23 @@
+package update_team_settings
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/user"
+)
+
+type teamRepo interface {
+	WithTx(ctx context.Context, fn func(ctx context.Context, tx *sqlx.Tx) error) error
+
+	FindTeamByID(ctx context.Context, teamName string) (models.Team, error)
+	FindTeamByIDForUpdate(ctx context.Context, tx *sqlx.Tx, teamName string) (models.Team, error)
+	UpdateTeam(ctx context.Context, tx *sqlx.Tx, spec team.UpdateSpecification) (models.Team, error)
+	SetFallbackTeams(ctx context.Context, tx *sqlx.Tx, teamName string, fallbackTeams []string) error
+}
+
+type userRepo interface {
+	Find(ctx context.Context, spec user.FindSpecification) ([]models.User, error)
+}
@@ -0,0 +1,137 @@
+package update_team_settings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/models"
+	"github.com/loloneme/potential-waffle/internal/infrastructure/persistence/repository/team"
+	team_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/team"
+	user_spec "github.com/loloneme/potential-waffle/internal/infrastructure/persistence/specification/user"
+	rpc_errors "github.com/loloneme/potential-waffle/internal/rpc/errors"
+	"github.com/loloneme/potential-waffle/internal/usecase/reviewer_selection"
+)
+
+type Settings struct {
+	AssignmentStrategy *string
+	RequiredReviewers  *int
+	MaxReviewers       *int
+	RequiredApprovals  *int
+	MaxOpenReviews     *int
+	ReviewSLAHours     *int
+	FallbackTeams      *[]string
+}
+
+type Service struct {
+	teamRepo teamRepo
+	userRepo userRepo
+}
+
+func New(teamRepo teamRepo, userRepo userRepo) *Service {
+	return &Service{
+		teamRepo: teamRepo,
+		userRepo: userRepo,
+	}
+}
+
+// UpdateTeamSettings applies the given settings in one transaction that holds
+// the team row lock, so the new values are validated against the settings they
+// actually replace.
+func (s *Service) UpdateTeamSettings(ctx context.Context, teamName string, settings Settings) (models.Team, error) {
+	var updated models.Team
+	err := s.teamRepo.WithTx(ctx, func(ctx context.Context, tx *sqlx.Tx) error {
+		current, err := s.teamRepo.FindTeamByIDForUpdate(ctx, tx, teamName)
+		if err != nil {
+			if errors.Is(err, team.ErrNotFound) {
+				return rpc_errors.NewNotFound("team not found")
+			}
+			return fmt.Errorf("lock team: %w", err)
+		}
+
+		if err := validate(current, settings); err != nil {
+			return err
+		}
+		if settings.FallbackTeams != nil {
+			if err := reviewer_selection.ValidateFallbackTeams(ctx, s.teamRepo, teamName, *settings.FallbackTeams); err != nil {
+				return err
+			}
+		}
+
+		updated = current
+		spec := team_spec.NewUpdateSettingsSpecification(
+			teamName,
+			settings.AssignmentStrategy,
+			settings.RequiredReviewers,
+			settings.MaxReviewers,
+			settings.RequiredApprovals,
+			settings.MaxOpenReviews,
+			settings.ReviewSLAHours,
+		)
+		if len(spec.GetSetValues()) > 0 {
+			updated, err = s.teamRepo.UpdateTeam(ctx, tx, spec)
+			if err != nil {
+				return fmt.Errorf("update team settings: %w", err)
+			}
+		}
+
+		updated.FallbackTeams = current.FallbackTeams
+		if settings.FallbackTeams != nil {
+			if err := s.teamRepo.SetFallbackTeams(ctx, tx, teamName, *settings.FallbackTeams); err != nil {
+				return fmt.Errorf("set fallback teams: %w", err)
+			}
+			updated.FallbackTeams = *settings.FallbackTeams
+		}
+		return nil
+	})
+	if err != nil {
+		return models.Team{}, err
+	}
+
+	members, err := s.userRepo.Find(ctx, user_spec.NewGetUsersByTeamNameSpec(teamName))
+	if err != nil {
+		return models.Team{}, fmt.Errorf("find team members: %w", err)
+	}
+	updated.Members = members
+
+	return updated, nil
+}
+
+func validate(current models.Team, settings Settings) error {
+	if settings.AssignmentStrategy != nil && !reviewer_selection.IsKnownStrategy(*settings.AssignmentStrategy) {
+		return rpc_errors.NewBadRequest("unknown assignment strategy")
+	}
+
+	required, maxReviewers := current.RequiredReviewers, current.MaxReviewers
+	if settings.RequiredReviewers != nil {
+		required = *settings.RequiredReviewers
+	}
+	if settings.MaxReviewers != nil {
+		maxReviewers = *settings.MaxReviewers
+	}
+
+	if required < 0 || maxReviewers < 1 {
+		return rpc_errors.NewBadRequest("reviewer counts must be positive")
+	}
+	if required > maxReviewers {
+		return rpc_errors.NewBadRequest("required_reviewers cannot exceed max_reviewers")
+	}
+
+	approvals := current.RequiredApprovals
+	if settings.RequiredApprovals != nil {
+		approvals = *settings.RequiredApprovals
+	}
+	if approvals < 0 || approvals > maxReviewers {
+		return rpc_errors.NewBadRequest("required_approvals must be between 0 and max_reviewers")
+	}
+
+	if settings.MaxOpenReviews != nil && *settings.MaxOpenReviews < 0 {
+		return rpc_errors.NewBadRequest("max_open_reviews cannot be negative")
+	}
+	if settings.ReviewSLAHours != nil && *settings.ReviewSLAHours < 0 {
+		return rpc_errors.NewBadRequest("review_sla_hours cannot be negative")
+	}
+
+	return nil
+}
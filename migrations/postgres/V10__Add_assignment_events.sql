@@ -0,0 +1,18 @@
+CREATE TABLE assignment_events(
+    event_id BIGSERIAL NOT NULL,
+    pr_id VARCHAR(255) NOT NULL,
+    event_type VARCHAR(32) NOT NULL,
+    old_reviewer_id VARCHAR(255),
+    new_reviewer_id VARCHAR(255),
+    actor VARCHAR(255),
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (event_id),
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(pr_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_assignment_events_pr_id ON assignment_events(pr_id);
+CREATE INDEX idx_assignment_events_old_reviewer_id ON assignment_events(old_reviewer_id);
+CREATE INDEX idx_assignment_events_new_reviewer_id ON assignment_events(new_reviewer_id);
+CREATE INDEX idx_assignment_events_actor ON assignment_events(actor);
@@ -0,0 +1,35 @@
+CREATE TABLE webhook_subscriptions(
+    subscription_id SERIAL NOT NULL,
+    url TEXT NOT NULL,
+    secret TEXT NOT NULL,
+    team_name VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (subscription_id),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE
+);
+
+CREATE TABLE webhook_subscription_events(
+    subscription_id INTEGER NOT NULL,
+    event_type VARCHAR(32) NOT NULL,
+
+    PRIMARY KEY (subscription_id, event_type),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(subscription_id) ON DELETE CASCADE
+);
+
+CREATE TABLE webhook_outbox(
+    delivery_id BIGSERIAL NOT NULL,
+    subscription_id INTEGER NOT NULL,
+    event_id BIGINT NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    delivered_at TIMESTAMP,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (delivery_id),
+    FOREIGN KEY (subscription_id) REFERENCES webhook_subscriptions(subscription_id) ON DELETE CASCADE,
+    FOREIGN KEY (event_id) REFERENCES assignment_events(event_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_webhook_outbox_pending ON webhook_outbox(next_attempt_at) WHERE delivered_at IS NULL;
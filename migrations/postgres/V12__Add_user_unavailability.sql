@@ -0,0 +1,18 @@
+CREATE TABLE user_unavailability(
+    unavailability_id SERIAL NOT NULL,
+    user_id VARCHAR(255) NOT NULL,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    reassign_reviews BOOLEAN NOT NULL DEFAULT FALSE,
+    reassigned_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (unavailability_id),
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE,
+    CONSTRAINT chk_user_unavailability_window CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_user_unavailability_user_window ON user_unavailability(user_id, starts_at, ends_at);
+CREATE INDEX idx_user_unavailability_pending_reassign ON user_unavailability(starts_at)
+    WHERE reassign_reviews AND reassigned_at IS NULL;
@@ -0,0 +1,37 @@
+ALTER TABLE teams ADD COLUMN archived_at TIMESTAMP;
+
+-- Team names are referenced by key, so renames must cascade. Members are no
+-- longer deleted with their team: teams are archived instead.
+ALTER TABLE users
+    DROP CONSTRAINT users_team_name_fkey,
+    ADD CONSTRAINT users_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE RESTRICT;
+
+ALTER TABLE team_fallbacks
+    DROP CONSTRAINT team_fallbacks_team_name_fkey,
+    DROP CONSTRAINT team_fallbacks_fallback_team_name_fkey,
+    ADD CONSTRAINT team_fallbacks_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE CASCADE,
+    ADD CONSTRAINT team_fallbacks_fallback_team_name_fkey
+        FOREIGN KEY (fallback_team_name) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE webhook_subscriptions
+    DROP CONSTRAINT webhook_subscriptions_team_name_fkey,
+    ADD CONSTRAINT webhook_subscriptions_team_name_fkey
+        FOREIGN KEY (team_name) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE CASCADE;
+
+CREATE TABLE team_events(
+    event_id BIGSERIAL NOT NULL,
+    team_name VARCHAR(255) NOT NULL,
+    event_type VARCHAR(32) NOT NULL,
+    user_id VARCHAR(255),
+    old_value VARCHAR(255),
+    new_value VARCHAR(255),
+    actor VARCHAR(255),
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (event_id),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX idx_team_events_team_name ON team_events(team_name);
@@ -0,0 +1,8 @@
+CREATE TABLE team_code_owners(
+    team_name VARCHAR(255) NOT NULL,
+    document TEXT NOT NULL,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (team_name),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON UPDATE CASCADE ON DELETE CASCADE
+);
@@ -0,0 +1,9 @@
+CREATE TABLE user_skills(
+    user_id VARCHAR(255) NOT NULL,
+    tag VARCHAR(32) NOT NULL,
+
+    PRIMARY KEY (user_id, tag),
+    FOREIGN KEY (user_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_user_skills_tag ON user_skills(tag);
@@ -0,0 +1,7 @@
+ALTER TABLE teams
+    ADD COLUMN max_open_reviews INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_teams_max_open_reviews CHECK (max_open_reviews >= 0);
+
+ALTER TABLE users
+    ADD COLUMN max_open_reviews INTEGER,
+    ADD CONSTRAINT chk_users_max_open_reviews CHECK (max_open_reviews >= 0);
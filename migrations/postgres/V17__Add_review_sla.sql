@@ -0,0 +1,16 @@
+ALTER TABLE teams
+    ADD COLUMN review_sla_hours INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_teams_review_sla_hours CHECK (review_sla_hours >= 0);
+
+ALTER TABLE reviewers
+    ADD COLUMN assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN escalated_at TIMESTAMP;
+
+UPDATE reviewers r
+SET assigned_at = COALESCE(
+    (SELECT MAX(e.created_at) FROM assignment_events e
+     WHERE e.pr_id = r.pr_id AND e.new_reviewer_id = r.reviewer_id),
+    (SELECT pr.created_at FROM pull_requests pr WHERE pr.pr_id = r.pr_id)
+);
+
+CREATE INDEX idx_reviewers_pending_assigned_at ON reviewers(assigned_at) WHERE review_state = 'PENDING';
@@ -0,0 +1,7 @@
+CREATE TABLE pull_request_changed_paths(
+    pr_id VARCHAR(255) NOT NULL,
+    path TEXT NOT NULL,
+
+    PRIMARY KEY (pr_id, path),
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(pr_id) ON DELETE CASCADE
+);
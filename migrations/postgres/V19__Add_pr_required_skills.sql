@@ -0,0 +1,11 @@
+CREATE TABLE pull_request_required_skills(
+    pr_id VARCHAR(255) NOT NULL,
+    tag VARCHAR(32) NOT NULL,
+    position INTEGER NOT NULL,
+
+    PRIMARY KEY (pr_id, tag),
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(pr_id) ON DELETE CASCADE
+);
+
+ALTER TABLE pull_requests
+    ADD COLUMN skill_mode VARCHAR(16) NOT NULL DEFAULT 'prefer' CHECK (skill_mode IN ('prefer', 'require'));
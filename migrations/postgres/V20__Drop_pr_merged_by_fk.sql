@@ -0,0 +1,2 @@
+-- merged_by also records token subjects such as bot:ci-bot, which are not users.
+ALTER TABLE pull_requests DROP CONSTRAINT fk_pull_requests_merged_by;
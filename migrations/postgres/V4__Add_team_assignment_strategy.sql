@@ -0,0 +1 @@
+ALTER TABLE teams ADD COLUMN assignment_strategy VARCHAR(32) NOT NULL DEFAULT 'least_loaded';
@@ -0,0 +1,5 @@
+ALTER TABLE teams
+    ADD COLUMN required_reviewers INTEGER NOT NULL DEFAULT 1,
+    ADD COLUMN max_reviewers INTEGER NOT NULL DEFAULT 2,
+    ADD CONSTRAINT chk_teams_reviewer_counts
+        CHECK (required_reviewers >= 0 AND max_reviewers >= 1 AND max_reviewers >= required_reviewers);
@@ -0,0 +1,17 @@
+CREATE TABLE team_fallbacks(
+    team_name VARCHAR(255) NOT NULL,
+    fallback_team_name VARCHAR(255) NOT NULL,
+    position INTEGER NOT NULL,
+
+    PRIMARY KEY (team_name, fallback_team_name),
+    FOREIGN KEY (team_name) REFERENCES teams(team_name) ON DELETE CASCADE,
+    FOREIGN KEY (fallback_team_name) REFERENCES teams(team_name) ON DELETE CASCADE,
+    CHECK (team_name <> fallback_team_name)
+);
+
+ALTER TABLE reviewers ADD COLUMN source_team VARCHAR(255);
+
+UPDATE reviewers r
+SET source_team = u.team_name
+FROM users u
+WHERE u.user_id = r.reviewer_id;
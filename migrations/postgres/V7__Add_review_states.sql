@@ -0,0 +1,22 @@
+ALTER TABLE reviewers
+    ADD COLUMN review_state VARCHAR(32) NOT NULL DEFAULT 'PENDING',
+    ADD COLUMN reviewed_at TIMESTAMP;
+
+CREATE TABLE review_events(
+    event_id SERIAL NOT NULL,
+    pr_id VARCHAR(255) NOT NULL,
+    reviewer_id VARCHAR(255) NOT NULL,
+    review_state VARCHAR(32) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (event_id),
+    FOREIGN KEY (pr_id) REFERENCES pull_requests(pr_id) ON DELETE CASCADE,
+    FOREIGN KEY (reviewer_id) REFERENCES users(user_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_review_events_pr_id ON review_events(pr_id);
+
+ALTER TABLE teams
+    ADD COLUMN required_approvals INTEGER NOT NULL DEFAULT 0,
+    ADD CONSTRAINT chk_teams_required_approvals CHECK (required_approvals >= 0);
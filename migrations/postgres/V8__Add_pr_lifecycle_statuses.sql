@@ -0,0 +1,7 @@
+INSERT INTO statuses (status_name) VALUES ('DRAFT');
+INSERT INTO statuses (status_name) VALUES ('READY_FOR_REVIEW');
+INSERT INTO statuses (status_name) VALUES ('CLOSED');
+INSERT INTO statuses (status_name) VALUES ('REOPENED');
+
+ALTER TABLE pull_requests
+    ADD COLUMN closed_at TIMESTAMP;
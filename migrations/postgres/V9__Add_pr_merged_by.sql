@@ -0,0 +1,3 @@
+ALTER TABLE pull_requests
+    ADD COLUMN merged_by VARCHAR(255),
+    ADD CONSTRAINT fk_pull_requests_merged_by FOREIGN KEY (merged_by) REFERENCES users(user_id) ON DELETE SET NULL;